@@ -163,6 +163,7 @@ func Build(ctx context.Context, ioCtrl *io.Controller, at, insights buildTracker
 	cmd.Flags().StringArrayVar(&options.Secrets, "secret", nil, "secret exposed to the build. Formats: id=mysecret,src=/local/secret (file) or id=mysecret,env=MY_ENV_VAR (env var)")
 	cmd.Flags().StringVar(&options.Platform, "platform", "", "specify which platform to build the container image for (optional)")
 	cmd.Flags().StringVarP(&options.Namespace, "namespace", "n", "", "overwrite the current Okteto Namespace")
+	cmd.Flags().IntVar(&options.Parallelism, "parallel", 0, "maximum number of services to build at the same time. Defaults to 1, building the services one at a time. Services are built once all their dependencies are built")
 	return cmd
 }
 
@@ -73,6 +73,10 @@ type OktetoBuilder struct {
 
 	tagger imageTagger
 
+	// newRunner returns a build runner for a build running concurrently with others.
+	// Services are built one at a time when it is nil
+	newRunner func(ioCtrl *io.Controller) basic.BuildRunner
+
 	smartBuildCtrl        *smartbuild.Ctrl
 	serviceEnvVarsHandler *environment.ServiceEnvVarsHandler
 
@@ -122,6 +126,11 @@ func NewBuilder(builder buildCmd.OktetoBuilderInterface, registryCtrl oktetoRegi
 		serviceEnvVarsHandler: serviceEnvVarsHandler,
 		tagger:                tagger,
 	}
+	if runner, ok := builder.(*buildCmd.OktetoBuilder); ok {
+		ob.newRunner = func(ioCtrl *io.Controller) basic.BuildRunner {
+			return runner.Fork(ioCtrl)
+		}
+	}
 
 	return ob
 
@@ -176,6 +185,9 @@ func NewBuilderFromScratch(ioCtrl *io.Controller, onBuildFinish []OnBuildFinish,
 		onBuildFinish:         onBuildFinish,
 		serviceEnvVarsHandler: serviceEnvVarsHandler,
 		tagger:                tagger,
+		newRunner: func(ioCtrl *io.Controller) basic.BuildRunner {
+			return builder.Fork(ioCtrl)
+		},
 	}
 }
 
@@ -275,48 +287,21 @@ func (ob *OktetoBuilder) Build(ctx context.Context, options *types.BuildOptions)
 		ob.ioCtrl.Logger().Infof("Images not cached: %v", notCachedSvcs)
 	}
 
-	for _, svcToBuild := range notCachedSvcs {
-		if options.EnableStages {
-			ob.ioCtrl.SetStage(fmt.Sprintf("Building service %s", svcToBuild.Name()))
-		}
-		buildSvcInfo := options.Manifest.Build[svcToBuild.Name()]
-		if !ob.oktetoContext.IsOktetoCluster() && buildSvcInfo.Image == "" {
-			return fmt.Errorf("'build.%s.image' is required if your context doesn't have Okteto installed", svcToBuild)
+	parallelism := getBuildParallelism(options)
+	if parallelism > 1 && ob.newRunner != nil && len(notCachedSvcs) > 1 {
+		ob.ioCtrl.Logger().Infof("building up to %d services in parallel", parallelism)
+		if err := ob.buildServicesInParallel(ctx, options, notCachedSvcs, parallelism); err != nil {
+			return err
 		}
-
-		buildDurationStart := time.Now()
-		imageTag, err := ob.buildServiceImages(ctx, options.Manifest, svcToBuild, options)
-
-		buildDuration := time.Since(buildDurationStart)
-
-		waitForBuildkitAvailable := time.Duration(0)
-		buildContextSize := int64(0)
-		connectionType := ""
-		buildkitDuration := time.Duration(0)
-		contextTransferDuration := time.Duration(0)
-		buildkitRunner, ok := ob.Builder.BuildRunner.(*buildCmd.OktetoBuilder)
-		if ok {
-			buildkitMetadata := buildkitRunner.GetMetadata()
-			if buildkitMetadata != nil {
-				waitForBuildkitAvailable = buildkitMetadata.WaitForBuildkitAvailableTime
-				buildContextSize = buildkitMetadata.BuildContextSize
-				connectionType = buildkitMetadata.ConnectionType
-				buildkitDuration = buildkitMetadata.BuildkitDuration
-				contextTransferDuration = buildkitMetadata.ContextTransferDuration
+	} else {
+		for _, svcToBuild := range notCachedSvcs {
+			if options.EnableStages {
+				ob.ioCtrl.SetStage(fmt.Sprintf("Building service %s", svcToBuild.Name()))
+			}
+			if err := ob.buildService(ctx, options, svcToBuild); err != nil {
+				return err
 			}
 		}
-		svcToBuild.SetBuildDuration(buildDuration, waitForBuildkitAvailable, err == nil)
-		svcToBuild.Metadata().BuildContextSize = buildContextSize
-		svcToBuild.Metadata().ConnectionType = connectionType
-		svcToBuild.Metadata().BuildkitDuration = buildkitDuration
-		svcToBuild.Metadata().ContextTransferDuration = contextTransferDuration
-
-		if err != nil {
-			svcToBuild.Metadata().ErrorReason = err.Error()
-			return fmt.Errorf("error building service '%s': %w", svcToBuild, err)
-		}
-
-		ob.serviceEnvVarsHandler.SetServiceEnvVars(svcToBuild.Name(), imageTag)
 	}
 
 	if options.EnableStages {
@@ -325,6 +310,50 @@ func (ob *OktetoBuilder) Build(ctx context.Context, options *types.BuildOptions)
 	return options.Manifest.ExpandEnvVars()
 }
 
+// buildService builds the image of a service, records its build metadata and sets the
+// OKTETO_BUILD_<SVC>_* env vars so they are available for the services depending on it
+func (ob *OktetoBuilder) buildService(ctx context.Context, options *types.BuildOptions, svcToBuild *buildTypes.BuildInfo) error {
+	buildSvcInfo := options.Manifest.Build[svcToBuild.Name()]
+	if !ob.oktetoContext.IsOktetoCluster() && buildSvcInfo.Image == "" {
+		return fmt.Errorf("'build.%s.image' is required if your context doesn't have Okteto installed", svcToBuild)
+	}
+
+	buildDurationStart := time.Now()
+	imageTag, err := ob.buildServiceImages(ctx, options.Manifest, svcToBuild, options)
+
+	buildDuration := time.Since(buildDurationStart)
+
+	waitForBuildkitAvailable := time.Duration(0)
+	buildContextSize := int64(0)
+	connectionType := ""
+	buildkitDuration := time.Duration(0)
+	contextTransferDuration := time.Duration(0)
+	buildkitRunner, ok := ob.Builder.BuildRunner.(*buildCmd.OktetoBuilder)
+	if ok {
+		buildkitMetadata := buildkitRunner.GetMetadata()
+		if buildkitMetadata != nil {
+			waitForBuildkitAvailable = buildkitMetadata.WaitForBuildkitAvailableTime
+			buildContextSize = buildkitMetadata.BuildContextSize
+			connectionType = buildkitMetadata.ConnectionType
+			buildkitDuration = buildkitMetadata.BuildkitDuration
+			contextTransferDuration = buildkitMetadata.ContextTransferDuration
+		}
+	}
+	svcToBuild.SetBuildDuration(buildDuration, waitForBuildkitAvailable, err == nil)
+	svcToBuild.Metadata().BuildContextSize = buildContextSize
+	svcToBuild.Metadata().ConnectionType = connectionType
+	svcToBuild.Metadata().BuildkitDuration = buildkitDuration
+	svcToBuild.Metadata().ContextTransferDuration = contextTransferDuration
+
+	if err != nil {
+		svcToBuild.Metadata().ErrorReason = err.Error()
+		return fmt.Errorf("error building service '%s': %w", svcToBuild, err)
+	}
+
+	ob.serviceEnvVarsHandler.SetServiceEnvVars(svcToBuild.Name(), imageTag)
+	return nil
+}
+
 // buildServiceImages builds the images for the given service.
 // if service has volumes to include but is not okteto, an error is returned.
 // Returned image reference includes the digest
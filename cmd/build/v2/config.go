@@ -17,14 +17,22 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/okteto/okteto/pkg/env"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/okteto/okteto/pkg/types"
 	"github.com/spf13/afero"
 )
 
 const (
 	// OktetoEnableSmartBuildEnvVar represents whether the feature flag to enable smart builds is enabled or not
 	OktetoEnableSmartBuildEnvVar = "OKTETO_SMART_BUILDS_ENABLED"
+
+	// OktetoBuildParallelismEnvVar defines the maximum number of services built at the same time
+	OktetoBuildParallelismEnvVar = "OKTETO_BUILD_PARALLELISM"
+
+	// defaultBuildParallelism builds the services one at a time
+	defaultBuildParallelism = 1
 )
 
 type configRepositoryInterface interface {
@@ -141,3 +149,12 @@ func (oc oktetoBuilderConfig) GetAnonymizedRepo() string {
 func (oc oktetoBuilderConfig) IsSmartBuildsEnabled() bool {
 	return oc.isSmartBuildsEnable
 }
+
+// getBuildParallelism returns the maximum number of services to build at the same time.
+// The flag takes precedence over the env var
+func getBuildParallelism(options *types.BuildOptions) int {
+	if options.Parallelism > 0 {
+		return options.Parallelism
+	}
+	return env.LoadIntOrDefault(OktetoBuildParallelismEnvVar, defaultBuildParallelism)
+}
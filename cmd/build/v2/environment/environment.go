@@ -102,7 +102,14 @@ func (es *ServiceEnvVarsHandler) SetServiceEnvVars(service, reference string) {
 	es.ioCtrl.Logger().Debug("manifest env vars set")
 }
 
-// GetBuildEnvVars gets okteto build env vars
+// GetBuildEnvVars gets a copy of the okteto build env vars. Services might be built
+// concurrently, so the returned map must not be shared with the handler
 func (es *ServiceEnvVarsHandler) GetBuildEnvVars() map[string]string {
-	return es.buildEnvironments
+	es.lock.RLock()
+	defer es.lock.RUnlock()
+	result := make(map[string]string, len(es.buildEnvironments))
+	for k, v := range es.buildEnvironments {
+		result[k] = v
+	}
+	return result
 }
@@ -0,0 +1,131 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/okteto/okteto/cmd/build/basic"
+	buildTypes "github.com/okteto/okteto/cmd/build/v2/types"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/types"
+)
+
+// buildTask tracks the build of a service while building services in parallel
+type buildTask struct {
+	info *buildTypes.BuildInfo
+	done chan struct{}
+	err  error
+}
+
+// buildServicesInParallel builds every service as soon as all its dependencies are built, running
+// at most parallelism builds at the same time. The output of each build is displayed as a block
+// when the build finishes. The first build that fails cancels the builds that didn't finish yet.
+func (ob *OktetoBuilder) buildServicesInParallel(ctx context.Context, options *types.BuildOptions, svcsToBuild []*buildTypes.BuildInfo, parallelism int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tasks := make(map[string]*buildTask, len(svcsToBuild))
+	for _, svc := range svcsToBuild {
+		tasks[svc.Name()] = &buildTask{info: svc, done: make(chan struct{})}
+	}
+
+	if options.EnableStages {
+		ob.ioCtrl.SetStage("Building services")
+	}
+	sp := ob.ioCtrl.Out().Spinner(fmt.Sprintf("Building %d services...", len(svcsToBuild)))
+	sp.Start()
+	defer sp.Stop()
+
+	var (
+		firstErr error
+		errOnce  sync.Once
+		wg       sync.WaitGroup
+	)
+	semaphore := make(chan struct{}, parallelism)
+	wg.Add(len(svcsToBuild))
+	for _, svc := range svcsToBuild {
+		go func(task *buildTask) {
+			defer wg.Done()
+			defer close(task.done)
+
+			// services that are cached or not selected to build are not tracked, their env vars are already set
+			for _, dep := range options.Manifest.Build[task.info.Name()].DependsOn {
+				depTask, ok := tasks[dep]
+				if !ok {
+					continue
+				}
+				<-depTask.done
+				if depTask.err != nil {
+					task.err = fmt.Errorf("service '%s' was not built because its dependency '%s' failed", task.info.Name(), dep)
+					return
+				}
+			}
+
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				task.err = ctx.Err()
+				return
+			}
+			defer func() { <-semaphore }()
+
+			if ctx.Err() != nil {
+				task.err = ctx.Err()
+				return
+			}
+
+			task.err = ob.buildServiceInGroup(ctx, options, task.info)
+			if task.err != nil {
+				errOnce.Do(func() {
+					firstErr = task.err
+					cancel()
+				})
+			}
+		}(tasks[svc.Name()])
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// buildServiceInGroup builds a service with its own build runner, keeping its output in a group
+// that is flushed once the build finishes
+func (ob *OktetoBuilder) buildServiceInGroup(ctx context.Context, options *types.BuildOptions, svc *buildTypes.BuildInfo) error {
+	group := ob.ioCtrl.NewOutputGroup(fmt.Sprintf("Building service %s", svc.Name()))
+	defer group.Flush()
+
+	groupIO := group.IO()
+	svcBuilder := *ob
+	svcBuilder.ioCtrl = groupIO
+	svcBuilder.Builder = basic.Builder{
+		BuildRunner: ob.newRunner(groupIO),
+		IoCtrl:      groupIO,
+	}
+
+	// the tty display takes over the terminal, so concurrent builds use the plain display
+	svcOptions := *options
+	if svcOptions.OutputMode == "" || svcOptions.OutputMode == oktetoLog.TTYFormat {
+		svcOptions.OutputMode = oktetoLog.PlainFormat
+	}
+
+	groupIO.Out().Infof("Building service '%s'", svc.Name())
+	if err := svcBuilder.buildService(ctx, &svcOptions, svc); err != nil {
+		groupIO.Out().Warning("Build of service '%s' failed", svc.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/cmd/build/basic"
+	"github.com/okteto/okteto/pkg/build"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncFakeRegistry is a fakeRegistry safe for concurrent builds
+type syncFakeRegistry struct {
+	fakeRegistry
+	mu *sync.Mutex
+}
+
+func (fr syncFakeRegistry) GetImageTagWithDigest(imageTag string) (string, error) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	return fr.fakeRegistry.GetImageTagWithDigest(imageTag)
+}
+
+func (fr syncFakeRegistry) AddImageByOpts(opts *types.BuildOptions) error {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	return fr.fakeRegistry.AddImageByOpts(opts)
+}
+
+// concurrentRunner simulates builds that take some time and tracks how many run at the same time
+type concurrentRunner struct {
+	registry    syncFakeRegistry
+	failImage   string
+	built       []string
+	running     int
+	maxRunning  int
+	mu          sync.Mutex
+	buildTime   time.Duration
+	outputModes []string
+}
+
+func (*concurrentRunner) GetBuilder() string {
+	return "test"
+}
+
+func (r *concurrentRunner) fork(_ *io.Controller) basic.BuildRunner {
+	return r
+}
+
+func (r *concurrentRunner) Run(_ context.Context, opts *types.BuildOptions, _ *io.Controller) error {
+	r.mu.Lock()
+	r.running++
+	if r.running > r.maxRunning {
+		r.maxRunning = r.running
+	}
+	r.outputModes = append(r.outputModes, opts.OutputMode)
+	r.mu.Unlock()
+
+	time.Sleep(r.buildTime)
+
+	r.mu.Lock()
+	r.running--
+	r.built = append(r.built, opts.Tag)
+	r.mu.Unlock()
+
+	if opts.Tag == r.failImage {
+		return errors.New("build failed")
+	}
+	return r.registry.AddImageByOpts(opts)
+}
+
+func newParallelTestManifest(dir string) *model.Manifest {
+	dockerfile := filepath.Join(dir, "Dockerfile")
+	return &model.Manifest{
+		Name: "test",
+		Build: build.ManifestBuild{
+			"a": &build.Info{Context: dir, Dockerfile: dockerfile, Image: "okteto/a:test"},
+			"b": &build.Info{Context: dir, Dockerfile: dockerfile, Image: "okteto/b:test"},
+			"c": &build.Info{Context: dir, Dockerfile: dockerfile, Image: "okteto/c:test"},
+			"d": &build.Info{Context: dir, Dockerfile: dockerfile, Image: "okteto/d:test", DependsOn: []string{"a"}},
+		},
+	}
+}
+
+func TestBuildInParallel(t *testing.T) {
+	dir, err := createDockerfile(t)
+	require.NoError(t, err)
+
+	reg := syncFakeRegistry{fakeRegistry: newFakeRegistry(), mu: &sync.Mutex{}}
+	runner := &concurrentRunner{registry: reg, buildTime: 50 * time.Millisecond}
+	bc := NewFakeBuilder(runner, reg, fakeConfig{isOkteto: true})
+	bc.newRunner = runner.fork
+
+	err = bc.Build(context.Background(), &types.BuildOptions{
+		Manifest:    newParallelTestManifest(dir),
+		NoCache:     true,
+		Parallelism: 2,
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, runner.built, 4)
+	assert.Equal(t, 2, runner.maxRunning)
+	for _, mode := range runner.outputModes {
+		assert.Equal(t, "plain", mode)
+	}
+
+	// the dependency is built and its env vars are set before the dependent service starts
+	assert.Less(t, indexOf(runner.built, "okteto/a:test"), indexOf(runner.built, "okteto/d:test"))
+	found := false
+	for _, arg := range reg.getFakeImage("okteto/d:test").Args {
+		if strings.HasPrefix(arg, "OKTETO_BUILD_A_IMAGE=") {
+			found = true
+		}
+	}
+	assert.True(t, found, "expected OKTETO_BUILD_A_IMAGE to be injected in the build of 'd'")
+}
+
+func TestBuildInParallelWithError(t *testing.T) {
+	dir, err := createDockerfile(t)
+	require.NoError(t, err)
+
+	reg := syncFakeRegistry{fakeRegistry: newFakeRegistry(), mu: &sync.Mutex{}}
+	runner := &concurrentRunner{registry: reg, failImage: "okteto/a:test"}
+	bc := NewFakeBuilder(runner, reg, fakeConfig{isOkteto: true})
+	bc.newRunner = runner.fork
+
+	err = bc.Build(context.Background(), &types.BuildOptions{
+		Manifest:    newParallelTestManifest(dir),
+		NoCache:     true,
+		Parallelism: 4,
+	})
+	require.ErrorContains(t, err, "error building service 'a'")
+	assert.NotContains(t, runner.built, "okteto/d:test")
+}
+
+func TestGetBuildParallelism(t *testing.T) {
+	t.Setenv(OktetoBuildParallelismEnvVar, "")
+	assert.Equal(t, 1, getBuildParallelism(&types.BuildOptions{}))
+	assert.Equal(t, 2, getBuildParallelism(&types.BuildOptions{Parallelism: 2}))
+
+	t.Setenv(OktetoBuildParallelismEnvVar, "3")
+	assert.Equal(t, 3, getBuildParallelism(&types.BuildOptions{}))
+	assert.Equal(t, 5, getBuildParallelism(&types.BuildOptions{Parallelism: 5}))
+}
+
+func indexOf(list []string, value string) int {
+	for i, v := range list {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,254 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strings"
+
+	contextCMD "github.com/okteto/okteto/cmd/context"
+	"github.com/okteto/okteto/cmd/utils"
+	cpCmd "github.com/okteto/okteto/pkg/cmd/cp"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/apps"
+	"github.com/okteto/okteto/pkg/k8s/exec"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	oktetoIO "github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/okteto/okteto/pkg/ssh"
+	"github.com/okteto/okteto/pkg/validator"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// location is a path given to okteto cp. dev is empty for local paths
+type location struct {
+	dev  string
+	path string
+}
+
+func (l location) String() string {
+	if l.dev == "" {
+		return l.path
+	}
+	return fmt.Sprintf("%s:%s", l.dev, l.path)
+}
+
+// Cp copies files and directories between your computer and a development container
+func Cp(ctx context.Context, k8sLogger *oktetoIO.K8sLogger, fs afero.Fs) *cobra.Command {
+	var devPath string
+	var namespace string
+	var k8sContext string
+	var recursive bool
+	var resume bool
+	cmd := &cobra.Command{
+		Use:   "cp [devContainer:]SOURCE... [devContainer:]DESTINATION",
+		Short: "Copy files and directories between your computer and a Development Container",
+		Example: `# Copy a file to the Development Container 'api'
+okteto cp config.yaml api:/app/config.yaml
+
+# Copy the logs of the Development Container 'api' to the 'logs' folder
+okteto cp -r api:/var/log/app logs
+
+# Copy all the reports of the Development Container 'api' to the current folder
+okteto cp 'api:/app/reports/*.xml' .`,
+		Args: utils.MinimumNArgsAccepted(2, "https://okteto.com/docs/reference/okteto-cli/#cp"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validator.FileArgumentIsNotDir(fs, devPath); err != nil {
+				return err
+			}
+
+			ctxOpts := &contextCMD.Options{
+				Show:      true,
+				Context:   k8sContext,
+				Namespace: namespace,
+			}
+			if err := contextCMD.NewContextCommand().Run(ctx, ctxOpts); err != nil {
+				return err
+			}
+
+			manifest, err := model.GetManifestV2(devPath, fs)
+			if err != nil {
+				return err
+			}
+			if !okteto.IsOkteto() {
+				if err := manifest.ValidateForCLIOnly(); err != nil {
+					return err
+				}
+			}
+
+			sources, dst, err := parseLocations(args, manifest.Dev)
+			if err != nil {
+				return err
+			}
+
+			devName := dst.dev
+			if devName == "" {
+				devName = sources[0].dev
+			}
+			dev := manifest.Dev[devName]
+			remote, closeRemote, err := getRemoteFilesystem(ctx, dev, okteto.GetContext().Namespace, k8sLogger)
+			if err != nil {
+				return err
+			}
+			defer closeRemote()
+
+			copier := &cpCmd.Copier{
+				Src:       cpCmd.LocalFilesystem{},
+				Dst:       cpCmd.LocalFilesystem{},
+				Recursive: recursive,
+				Resume:    resume,
+			}
+			if oktetoLog.IsInteractive() {
+				copier.Progress = &utils.ProgressBar{}
+			}
+			if dst.dev == "" {
+				copier.Src = remote
+			} else {
+				copier.Dst = remote
+			}
+
+			patterns := []string{}
+			for _, s := range sources {
+				patterns = append(patterns, filesystemPath(s, dev))
+			}
+			if err := copier.Copy(ctx, patterns, filesystemPath(dst, dev)); err != nil {
+				return err
+			}
+
+			oktetoLog.Success("Copied to '%s'", dst)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&devPath, "file", "f", "", "the path to the Okteto manifest")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "overwrite the current Okteto Namespace")
+	cmd.Flags().StringVarP(&k8sContext, "context", "c", "", "overwrite the current Okteto Context")
+	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "copy directories recursively")
+	cmd.Flags().BoolVarP(&resume, "resume", "", false, "continue partial copies of files instead of overwriting them")
+	return cmd
+}
+
+// parseLocations returns the sources and the destination of the copy. Paths prefixed with the name of
+// a development container of the manifest and ':' are in that development container, and the rest are local.
+// Either all the sources or the destination must be in the same development container
+func parseLocations(args []string, devs model.ManifestDevs) ([]location, location, error) {
+	locations := make([]location, 0, len(args))
+	for _, arg := range args {
+		l := location{path: arg}
+		if name, p, found := strings.Cut(arg, ":"); found && devs.HasDev(name) {
+			l = location{dev: name, path: p}
+		}
+		locations = append(locations, l)
+	}
+
+	sources, dst := locations[:len(locations)-1], locations[len(locations)-1]
+	for _, s := range sources {
+		if dst.dev != "" && s.dev != "" {
+			return nil, location{}, oktetoErrors.UserError{
+				E:    fmt.Errorf("cannot copy '%s' to '%s': copies between development containers are not supported", s, dst),
+				Hint: "Copy the files to your computer first",
+			}
+		}
+		if dst.dev == "" && s.dev != sources[0].dev {
+			return nil, location{}, oktetoErrors.UserError{
+				E:    errors.New("all the sources must be in the same development container"),
+				Hint: "Use '<devContainer>:<path>' for every source",
+			}
+		}
+	}
+	if dst.dev == "" && sources[0].dev == "" {
+		return nil, location{}, oktetoErrors.UserError{
+			E:    errors.New("either the sources or the destination must be in a development container"),
+			Hint: fmt.Sprintf("Use '<devContainer>:<path>', where <devContainer> is one of: [%s]", strings.Join(devs.GetDevs(), ", ")),
+		}
+	}
+	return sources, dst, nil
+}
+
+// filesystemPath returns the slash-separated path of l. Relative paths of the development container are relative to its workdir
+func filesystemPath(l location, dev *model.Dev) string {
+	if l.dev == "" {
+		return filepath.ToSlash(l.path)
+	}
+	if path.IsAbs(l.path) {
+		return l.path
+	}
+	if dev.Workdir == "" {
+		return path.Join(".", l.path)
+	}
+	return path.Join(dev.Workdir, l.path)
+}
+
+// getRemoteFilesystem uses the SFTP server of the development container through the SSH port forward of a running
+// 'okteto up' session, and falls back to streaming the files with the Kubernetes exec API when there is no session
+func getRemoteFilesystem(ctx context.Context, dev *model.Dev, namespace string, k8sLogger *oktetoIO.K8sLogger) (cpCmd.Filesystem, func(), error) {
+	if port, err := ssh.GetPort(dev.Name); err == nil {
+		client, err := ssh.NewSFTPClient(ctx, dev.Interface, port)
+		if err == nil {
+			oktetoLog.Infof("copying files through the SFTP server of '%s'", dev.Name)
+			return cpCmd.NewSFTPFilesystem(client.Client), func() {
+				if err := client.Close(); err != nil {
+					oktetoLog.Infof("failed to close SFTP session: %s", err)
+				}
+			}, nil
+		}
+		oktetoLog.Infof("failed to open SFTP session with '%s', falling back to exec: %s", dev.Name, err)
+	}
+
+	c, cfg, err := okteto.GetK8sClientWithLogger(k8sLogger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	getAppDev := dev
+	if dev.Autocreate {
+		autocreateDev := *dev
+		autocreateDev.Name = model.DevCloneName(dev.Name)
+		getAppDev = &autocreateDev
+	}
+	app, _, err := utils.GetApp(ctx, getAppDev, namespace, c, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !dev.Autocreate && apps.IsDevModeOn(app) {
+		app = app.DevClone()
+		if err := app.Refresh(ctx, c); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	pod, err := app.GetRunningPod(ctx, c)
+	if err != nil {
+		return nil, nil, oktetoErrors.UserError{
+			E:    fmt.Errorf("development container '%s' is not running: %w", dev.Name, err),
+			Hint: "Run 'okteto up' and try again",
+		}
+	}
+	container := dev.Container
+	if container == "" {
+		container = pod.Spec.Containers[0].Name
+	}
+
+	oktetoLog.Infof("copying files through exec in pod '%s'", pod.Name)
+	return cpCmd.NewExecFilesystem(func(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, command []string) error {
+		return exec.Exec(ctx, c, cfg, namespace, pod.Name, container, false, stdin, stdout, stderr, command)
+	}), func() {}, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"testing"
+
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLocations(t *testing.T) {
+	devs := model.ManifestDevs{
+		"api":    &model.Dev{Name: "api"},
+		"worker": &model.Dev{Name: "worker"},
+	}
+
+	tests := []struct {
+		name            string
+		args            []string
+		expectedSources []location
+		expectedDst     location
+		expectErr       bool
+	}{
+		{
+			name:            "upload",
+			args:            []string{"a.txt", "b.txt", "api:/tmp"},
+			expectedSources: []location{{path: "a.txt"}, {path: "b.txt"}},
+			expectedDst:     location{dev: "api", path: "/tmp"},
+		},
+		{
+			name:            "download",
+			args:            []string{"api:/app/*.log", "api:out", "logs"},
+			expectedSources: []location{{dev: "api", path: "/app/*.log"}, {dev: "api", path: "out"}},
+			expectedDst:     location{path: "logs"},
+		},
+		{
+			name:            "windows-path-is-local",
+			args:            []string{`C:\Users\okteto\a.txt`, "api:"},
+			expectedSources: []location{{path: `C:\Users\okteto\a.txt`}},
+			expectedDst:     location{dev: "api"},
+		},
+		{
+			name:      "both-local",
+			args:      []string{"a.txt", "b.txt"},
+			expectErr: true,
+		},
+		{
+			name:      "between-devs",
+			args:      []string{"api:/a.txt", "worker:/a.txt"},
+			expectErr: true,
+		},
+		{
+			name:      "sources-in-different-devs",
+			args:      []string{"api:/a.txt", "worker:/b.txt", "."},
+			expectErr: true,
+		},
+		{
+			name:      "local-and-remote-sources",
+			args:      []string{"api:/a.txt", "b.txt", "."},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources, dst, err := parseLocations(tt.args, devs)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSources, sources)
+			assert.Equal(t, tt.expectedDst, dst)
+		})
+	}
+}
+
+func TestFilesystemPath(t *testing.T) {
+	dev := &model.Dev{Workdir: "/okteto"}
+	assert.Equal(t, "/okteto/src", filesystemPath(location{dev: "api", path: "src"}, dev))
+	assert.Equal(t, "/okteto", filesystemPath(location{dev: "api"}, dev))
+	assert.Equal(t, "/tmp/a", filesystemPath(location{dev: "api", path: "/tmp/a"}, dev))
+	assert.Equal(t, "src", filesystemPath(location{dev: "api", path: "src"}, &model.Dev{}))
+	assert.Equal(t, ".", filesystemPath(location{dev: "api"}, &model.Dev{}))
+	assert.Equal(t, "src/a.txt", filesystemPath(location{path: "src/a.txt"}, dev))
+}
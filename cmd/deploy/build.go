@@ -72,6 +72,11 @@ func buildImages(ctx context.Context, builder builderInterface, cmapHandler Conf
 		}
 	}
 
+	// the ConfigMap of the dev environment isn't updated in dry run mode
+	if deployOptions.DryRun {
+		return nil
+	}
+
 	err := cmapHandler.SetBuildEnvVars(ctx, deployOptions.Name, deployOptions.Namespace, builder.GetBuildEnvVars())
 	if err != nil {
 		oktetoLog.Infof("error setting build env vars: %s", err.Error())
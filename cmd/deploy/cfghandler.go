@@ -22,6 +22,7 @@ import (
 
 	"github.com/okteto/okteto/pkg/cmd/pipeline"
 	"github.com/okteto/okteto/pkg/format"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/log/io"
 	"github.com/okteto/okteto/pkg/okteto"
@@ -38,6 +39,12 @@ type ConfigMapHandler interface {
 	SetBuildEnvVars(context.Context, string, string, map[string]string) error
 	GetConfigmapVariablesEncoded(ctx context.Context, name, namespace string) (string, error)
 	AddPhaseDuration(context.Context, string, string, string, time.Duration) error
+	GetInventory(context.Context, string, string) ([]inventory.Resource, error)
+	UpdateInventory(context.Context, string, string, []inventory.Resource) error
+	GetHistory(context.Context, string, string) ([]pipeline.Revision, error)
+	AddRevision(context.Context, string, string, pipeline.Revision) (int, error)
+	GetOutputs(context.Context, string, string) ([]pipeline.Output, error)
+	UpdateOutputs(context.Context, string, string, []pipeline.Output) error
 }
 
 // oktetoDefaultConfigMapHandler is the runner used when the okteto is executed
@@ -110,6 +117,60 @@ func (ch *defaultConfigMapHandler) AddPhaseDuration(ctx context.Context, name, n
 	return pipeline.AddPhaseDuration(ctx, name, namespace, phase, duration, c)
 }
 
+// GetInventory returns the resources created or applied by the last deploy
+func (ch *defaultConfigMapHandler) GetInventory(ctx context.Context, name, namespace string) ([]inventory.Resource, error) {
+	c, _, err := ch.k8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, ch.k8slogger)
+	if err != nil {
+		return nil, err
+	}
+	return pipeline.GetInventory(ctx, name, namespace, c)
+}
+
+// UpdateInventory stores the resources created or applied by the deploy
+func (ch *defaultConfigMapHandler) UpdateInventory(ctx context.Context, name, namespace string, resources []inventory.Resource) error {
+	c, _, err := ch.k8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, ch.k8slogger)
+	if err != nil {
+		return err
+	}
+	return pipeline.UpdateInventory(ctx, name, namespace, resources, c)
+}
+
+// GetHistory returns the revisions of the last deploys, from the oldest to the newest
+func (ch *defaultConfigMapHandler) GetHistory(ctx context.Context, name, namespace string) ([]pipeline.Revision, error) {
+	c, _, err := ch.k8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, ch.k8slogger)
+	if err != nil {
+		return nil, err
+	}
+	return pipeline.GetHistory(ctx, name, namespace, c)
+}
+
+// AddRevision adds the deploy to the history and returns its revision number
+func (ch *defaultConfigMapHandler) AddRevision(ctx context.Context, name, namespace string, rev pipeline.Revision) (int, error) {
+	c, _, err := ch.k8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, ch.k8slogger)
+	if err != nil {
+		return 0, err
+	}
+	return pipeline.AddRevision(ctx, name, namespace, rev, c)
+}
+
+// GetOutputs returns the outputs of the last deploy
+func (ch *defaultConfigMapHandler) GetOutputs(ctx context.Context, name, namespace string) ([]pipeline.Output, error) {
+	c, _, err := ch.k8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, ch.k8slogger)
+	if err != nil {
+		return nil, err
+	}
+	return pipeline.GetOutputs(ctx, name, namespace, c)
+}
+
+// UpdateOutputs stores the outputs generated by the deploy commands
+func (ch *defaultConfigMapHandler) UpdateOutputs(ctx context.Context, name, namespace string, outputs []pipeline.Output) error {
+	c, _, err := ch.k8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, ch.k8slogger)
+	if err != nil {
+		return err
+	}
+	return pipeline.UpdateOutputs(ctx, name, namespace, outputs, c)
+}
+
 func (ch *defaultConfigMapHandler) SetBuildEnvVars(ctx context.Context, name, ns string, envVars map[string]string) error {
 	c, _, err := ch.k8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, ch.k8slogger)
 	if err != nil {
@@ -90,6 +90,10 @@ type Options struct {
 	RunInRemoteSet        bool
 	Wait                  bool
 	ShowCTA               bool
+	// DryRun previews the changes of the deploy commands without applying them
+	DryRun bool
+	// Revision is the revision of the history redeployed by okteto rollback. Its images are used instead of building them
+	Revision *pipeline.Revision
 }
 
 type builderInterface interface {
@@ -199,7 +203,10 @@ $ okteto deploy --remote
 
 
 # Execute okteto deploy skipping the build
-$ okteto deploy --no-build=true`,
+$ okteto deploy --no-build=true
+
+# Preview the changes of okteto deploy without applying them
+$ okteto deploy --dry-run`,
 		Args: utils.NoArgsAccepted(""),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			// check if remote flag is used by the user
@@ -246,70 +253,11 @@ $ okteto deploy --no-build=true`,
 
 			options.ShowCTA = oktetoLog.IsInteractive()
 
-			k8sClientProvider := okteto.NewK8sClientProviderWithLogger(k8sLogger)
-			pc, err := pipelineCMD.NewCommand(at)
+			c, err := newDeployCommand(at, insightsTracker, ioCtrl, k8sLogger)
 			if err != nil {
-				return fmt.Errorf("could not create pipeline command: %w", err)
-			}
-
-			onBuildFinish := []buildv2.OnBuildFinish{
-				at.TrackImageBuild,
-				insightsTracker.TrackImageBuild,
-			}
-
-			okCtx := &okteto.ContextStateless{Store: okteto.GetContextStore()}
-			conn := buildCmd.GetBuildkitConnector(okCtx, ioCtrl, at)
-
-			c := &Command{
-				GetManifest: model.GetManifestV2,
-
-				K8sClientProvider:    k8sClientProvider,
-				GetDeployer:          GetDeployer,
-				Builder:              buildv2.NewBuilderFromScratch(ioCtrl, onBuildFinish, conn),
-				RemoteConnector:      conn,
-				DeployWaiter:         NewDeployWaiter(k8sClientProvider, k8sLogger),
-				EndpointGetter:       NewEndpointGetter,
-				IsRemote:             env.LoadBoolean(constants.OktetoDeployRemote),
-				CfgMapHandler:        NewConfigmapHandler(k8sClientProvider, k8sLogger),
-				Fs:                   afero.NewOsFs(),
-				PipelineCMD:          pc,
-				RunningInInstaller:   config.RunningInInstaller(),
-				AnalyticsTracker:     at,
-				IoCtrl:               ioCtrl,
-				K8sLogger:            k8sLogger,
-				DivertDeployerGetter: newDivertDeployer,
-
-				onCleanUp:       []cleanUpFunc{},
-				InsightsTracker: insightsTracker,
-			}
-			startTime := time.Now()
-
-			stop := make(chan os.Signal, 1)
-			signal.Notify(stop, os.Interrupt)
-			exit := make(chan error, 1)
-
-			go func() {
-				if options.Namespace == "" {
-					options.Namespace = okteto.GetContext().Namespace
-				}
-				err := c.Run(ctx, options)
-				c.InsightsTracker.TrackDeploy(ctx, options.Name, options.Namespace, err == nil)
-				c.TrackDeploy(options.Manifest, options.RunInRemote, startTime, err, options.Namespace)
-				exit <- err
-			}()
-
-			select {
-			case <-stop:
-				oktetoLog.Infof("CTRL+C received, starting shutdown sequence")
-				oktetoLog.Spinner("Shutting down...")
-				oktetoLog.StartSpinner()
-				defer oktetoLog.StopSpinner()
-
-				c.cleanUp(ctx, oktetoErrors.ErrIntSig)
-				return oktetoErrors.ErrIntSig
-			case err := <-exit:
 				return err
 			}
+			return c.runInterruptible(ctx, options)
 		},
 	}
 
@@ -325,10 +273,90 @@ $ okteto deploy --no-build=true`,
 
 	cmd.Flags().BoolVarP(&options.Wait, "wait", "w", false, "wait until the deployment finishes and pods are healthy")
 	cmd.Flags().DurationVarP(&options.Timeout, "timeout", "t", getDefaultTimeout(), "when using `wait`, the maximum time to wait for the resources of the deployment to be healthy")
+	cmd.Flags().BoolVarP(&options.DryRun, "dry-run", "", false, "preview the changes of the deploy commands to the Kubernetes resources without applying them. The deploy commands run locally and any other change they make is applied")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "remote")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "dependencies")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "wait")
 
+	cmd.AddCommand(History(ctx, k8sLogger))
+	cmd.AddCommand(Outputs(ctx, k8sLogger))
 	return cmd
 }
 
+// newDeployCommand returns the command to run the deploy sequence with its default dependencies
+func newDeployCommand(at AnalyticsTrackerInterface, insightsTracker buildDeployTrackerInterface, ioCtrl *io.Controller, k8sLogger *io.K8sLogger) (*Command, error) {
+	k8sClientProvider := okteto.NewK8sClientProviderWithLogger(k8sLogger)
+	pc, err := pipelineCMD.NewCommand(at)
+	if err != nil {
+		return nil, fmt.Errorf("could not create pipeline command: %w", err)
+	}
+
+	onBuildFinish := []buildv2.OnBuildFinish{
+		at.TrackImageBuild,
+		insightsTracker.TrackImageBuild,
+	}
+
+	okCtx := &okteto.ContextStateless{Store: okteto.GetContextStore()}
+	conn := buildCmd.GetBuildkitConnector(okCtx, ioCtrl, at)
+
+	return &Command{
+		GetManifest: model.GetManifestV2,
+
+		K8sClientProvider:    k8sClientProvider,
+		GetDeployer:          GetDeployer,
+		Builder:              buildv2.NewBuilderFromScratch(ioCtrl, onBuildFinish, conn),
+		RemoteConnector:      conn,
+		DeployWaiter:         NewDeployWaiter(k8sClientProvider, k8sLogger),
+		EndpointGetter:       NewEndpointGetter,
+		IsRemote:             env.LoadBoolean(constants.OktetoDeployRemote),
+		CfgMapHandler:        NewConfigmapHandler(k8sClientProvider, k8sLogger),
+		Fs:                   afero.NewOsFs(),
+		PipelineCMD:          pc,
+		RunningInInstaller:   config.RunningInInstaller(),
+		AnalyticsTracker:     at,
+		IoCtrl:               ioCtrl,
+		K8sLogger:            k8sLogger,
+		DivertDeployerGetter: newDivertDeployer,
+
+		onCleanUp:       []cleanUpFunc{},
+		InsightsTracker: insightsTracker,
+	}, nil
+}
+
+// runInterruptible runs the deploy sequence and cleans up its resources when the user interrupts it
+func (dc *Command) runInterruptible(ctx context.Context, options *Options) error {
+	startTime := time.Now()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt)
+	exit := make(chan error, 1)
+
+	go func() {
+		if options.Namespace == "" {
+			options.Namespace = okteto.GetContext().Namespace
+		}
+		err := dc.Run(ctx, options)
+		if !options.DryRun {
+			dc.InsightsTracker.TrackDeploy(ctx, options.Name, options.Namespace, err == nil)
+			dc.TrackDeploy(options.Manifest, options.RunInRemote, startTime, err, options.Namespace)
+		}
+		exit <- err
+	}()
+
+	select {
+	case <-stop:
+		oktetoLog.Infof("CTRL+C received, starting shutdown sequence")
+		oktetoLog.Spinner("Shutting down...")
+		oktetoLog.StartSpinner()
+		defer oktetoLog.StopSpinner()
+
+		dc.cleanUp(ctx, oktetoErrors.ErrIntSig)
+		return oktetoErrors.ErrIntSig
+	case err := <-exit:
+		return err
+	}
+}
+
 // calculateManifestPathToBeStored calculates the manifest path that has to be stored in the config map for UI operations.
 // It calculates the absolute path from the received path and then, it gets the relative path the top level git dir (repo root)
 func (dc *Command) calculateManifestPathToBeStored(topLevelGitDir, manifestPath string) string {
@@ -395,6 +423,10 @@ func (dc *Command) Run(ctx context.Context, deployOptions *Options) error {
 		return err
 	}
 
+	if deployOptions.DryRun {
+		return dc.runDryRun(ctx, deployOptions)
+	}
+
 	dc.isRedeploy = resolveIsRedeploy(ctx, deployOptions.Name, deployOptions.Namespace, c)
 
 	dc.isWithinPreview = analytics.IsWithinPreview(ctx, func(ctx context.Context, ns string) error {
@@ -482,6 +514,7 @@ func (dc *Command) Run(ctx context.Context, deployOptions *Options) error {
 		if errStatus := dc.CfgMapHandler.UpdateConfigMap(ctx, cfg, data, err); errStatus != nil {
 			return errStatus
 		}
+		dc.recordRevision(ctx, deployOptions, data)
 		return err
 	}
 
@@ -489,10 +522,13 @@ func (dc *Command) Run(ctx context.Context, deployOptions *Options) error {
 		return nil
 	}
 
-	if err := buildImages(ctx, dc.Builder, dc.CfgMapHandler, deployOptions); err != nil {
+	if deployOptions.Revision != nil {
+		dc.setRevisionBuildEnvVars(ctx, deployOptions)
+	} else if err := buildImages(ctx, dc.Builder, dc.CfgMapHandler, deployOptions); err != nil {
 		if errStatus := dc.CfgMapHandler.UpdateConfigMap(ctx, cfg, data, err); errStatus != nil {
 			return errStatus
 		}
+		dc.recordRevision(ctx, deployOptions, data)
 		return err
 	}
 
@@ -528,9 +564,11 @@ func (dc *Command) Run(ctx context.Context, deployOptions *Options) error {
 		if hasDeployed {
 			if deployOptions.Wait {
 				if err := dc.DeployWaiter.wait(ctx, deployOptions); err != nil {
-					if err := dc.CfgMapHandler.UpdateConfigMap(ctx, cfg, data, err); err != nil {
-						oktetoLog.Infof("could not update configmap with timeout error: %s", err)
-						return err
+					errStatus := dc.CfgMapHandler.UpdateConfigMap(ctx, cfg, data, err)
+					dc.recordRevision(ctx, deployOptions, data)
+					if errStatus != nil {
+						oktetoLog.Infof("could not update configmap with timeout error: %s", errStatus)
+						return errStatus
 					}
 					return err
 				}
@@ -555,6 +593,7 @@ func (dc *Command) Run(ctx context.Context, deployOptions *Options) error {
 	if errStatus := dc.CfgMapHandler.UpdateConfigMap(ctx, cfg, data, err); errStatus != nil {
 		return errStatus
 	}
+	dc.recordRevision(ctx, deployOptions, data)
 
 	return err
 }
@@ -564,7 +603,7 @@ func (dc *Command) deploy(ctx context.Context, deployOptions *Options, cwd strin
 	deployer, err := dc.GetDeployer(
 		ctx,
 		deployOptions,
-		dc.Builder.GetBuildEnvVars,
+		dc.getBuildEnvVarsFunc(deployOptions),
 		dc.CfgMapHandler,
 		dc.K8sClientProvider,
 		dc.IoCtrl,
@@ -705,7 +744,8 @@ func GetDeployer(ctx context.Context,
 	dependencyEnvVarsGetter dependencyEnvVarsGetter,
 	conn buildCmd.BuildkitConnector,
 ) (Deployer, error) {
-	if ShouldRunInRemote(opts) {
+	// dry runs need the local proxy to preview the changes
+	if !opts.DryRun && ShouldRunInRemote(opts) {
 		oktetoLog.Info("Deploying remotely...")
 		return newRemoteDeployer(buildEnvVarsGetter, ioCtrl, dependencyEnvVarsGetter, conn), nil
 	}
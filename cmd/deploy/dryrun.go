@@ -0,0 +1,97 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/okteto/okteto/pkg/constants"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/okteto"
+)
+
+// runDryRun previews the changes that the deploy would make to the resources of the namespace. The deploy commands
+// run locally behind the proxy, which sends every change to the cluster in dry run mode and shows the diff with the
+// live objects at the end. No image is built, no Kubernetes resource is persisted and the ConfigMap of the dev
+// environment isn't updated, but anything else the commands do is applied
+func (dc *Command) runDryRun(ctx context.Context, deployOptions *Options) error {
+	manifest := deployOptions.Manifest
+	if manifest.HasDependencies() {
+		oktetoLog.Warning("The 'dependencies' section is not deployed in dry run mode")
+	}
+	if manifest.Deploy == nil {
+		return nil
+	}
+	if skipped := dryRunSkippedSections(deployOptions); len(skipped) > 0 {
+		oktetoLog.Warning("The %s sections are not previewed in dry run mode", strings.Join(skipped, ", "))
+	}
+
+	os.Setenv(constants.OktetoNameEnvVar, deployOptions.Name)
+
+	// images are never built in dry run mode. The images already in the registry set their build variables
+	notBuilt, err := dc.Builder.GetServicesToBuildDuringExecution(ctx, manifest, nil)
+	if err != nil {
+		return err
+	}
+	if len(notBuilt) > 0 {
+		sort.Strings(notBuilt)
+		for i := range notBuilt {
+			notBuilt[i] = fmt.Sprintf("'%s'", notBuilt[i])
+		}
+		oktetoLog.Warning("The images of %s are not built in dry run mode, so their build variables are empty", strings.Join(notBuilt, ", "))
+	}
+	oktetoLog.Warning("The deploy commands run for real in dry run mode. Only their requests to the Kubernetes API are previewed, any other change they make is applied")
+
+	deployer, err := dc.GetDeployer(
+		ctx,
+		deployOptions,
+		dc.Builder.GetBuildEnvVars,
+		dc.CfgMapHandler,
+		dc.K8sClientProvider,
+		dc.IoCtrl,
+		dc.K8sLogger,
+		GetDependencyEnvVars,
+		dc.RemoteConnector,
+	)
+	if err != nil {
+		return err
+	}
+	dc.onCleanUp = append(dc.onCleanUp, deployer.CleanUp)
+
+	oktetoLog.EnableMasking()
+	err = deployer.Deploy(ctx, deployOptions)
+	oktetoLog.DisableMasking()
+	return err
+}
+
+// dryRunSkippedSections returns the sections of the manifest that are applied without the proxy,
+// so they can't be previewed
+func dryRunSkippedSections(deployOptions *Options) []string {
+	deploy := deployOptions.Manifest.Deploy
+	var skipped []string
+	if deploy.ComposeSection != nil {
+		skipped = append(skipped, "'compose'")
+	}
+	if deploy.Endpoints != nil {
+		skipped = append(skipped, "'endpoints'")
+	}
+	if deploy.Divert != nil && deploy.Divert.Namespace != okteto.GetContext().Namespace {
+		skipped = append(skipped, "'divert'")
+	}
+	return skipped
+}
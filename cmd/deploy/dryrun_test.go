@@ -0,0 +1,142 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/okteto/okteto/internal/test"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
+	"github.com/okteto/okteto/pkg/k8s/configmaps"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/kubernetes"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestDeployDryRun(t *testing.T) {
+	fakeNamespace := "test"
+	fakeK8sClientProvider := test.NewFakeK8sProvider()
+	fakeDeployer := &fakeDeployer{}
+	divert := &fakeDivert{}
+	builder := &fakeV2Builder{}
+
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: fakeNamespace,
+				Cfg:       &clientcmdapi.Config{},
+			},
+		},
+		CurrentContext: "test",
+	}
+
+	c := &Command{
+		AnalyticsTracker:  &fakeTracker{},
+		GetManifest:       getFakeManifest,
+		K8sClientProvider: fakeK8sClientProvider,
+		EndpointGetter:    getFakeEndpoint,
+		Fs:                afero.NewMemMapFs(),
+		CfgMapHandler:     newDefaultConfigMapHandler(fakeK8sClientProvider, nil),
+		GetDeployer:       fakeDeployer.Get,
+		Builder:           builder,
+		IoCtrl:            io.NewIOController(),
+		DivertDeployerGetter: func(_ *model.DivertDeploy, _, _ string, _ kubernetes.Interface, _ *io.Controller) (DivertDeployer, error) {
+			return divert, nil
+		},
+	}
+	ctx := context.Background()
+	opts := &Options{
+		Name:      "movies",
+		Namespace: fakeNamespace,
+		Variables: []string{},
+		DryRun:    true,
+	}
+
+	fakeDeployer.On(
+		"Get",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(fakeDeployer, nil)
+	fakeDeployer.On("Deploy", mock.Anything, opts).Return(nil)
+
+	require.NoError(t, c.Run(ctx, opts))
+	fakeDeployer.AssertExpectations(t)
+	// images are never built in dry run mode
+	builder.AssertNotCalled(t, "Build", mock.Anything, mock.Anything)
+	// the divert section is applied without the proxy, so it isn't deployed in dry run mode
+	divert.AssertNotCalled(t, "Deploy", mock.Anything)
+
+	fakeClient, _, err := c.K8sClientProvider.ProvideWithLogger(clientcmdapi.NewConfig(), nil)
+	require.NoError(t, err)
+	_, err = configmaps.Get(ctx, pipeline.TranslatePipelineName(opts.Name), fakeNamespace, fakeClient)
+	assert.True(t, k8sErrors.IsNotFound(err))
+}
+
+func TestDryRunSkippedSections(t *testing.T) {
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: "test",
+			},
+		},
+		CurrentContext: "test",
+	}
+
+	tests := []struct {
+		name     string
+		deploy   *model.DeployInfo
+		expected []string
+	}{
+		{
+			name:   "only commands",
+			deploy: &model.DeployInfo{Commands: []model.DeployCommand{{Name: "kubectl apply -f k8s"}}},
+		},
+		{
+			name: "all the sections",
+			deploy: &model.DeployInfo{
+				ComposeSection: &model.ComposeSectionInfo{},
+				Endpoints:      model.EndpointSpec{},
+				Divert:         &model.DivertDeploy{Namespace: "staging"},
+			},
+			expected: []string{"'compose'", "'endpoints'", "'divert'"},
+		},
+		{
+			name: "divert in the same namespace",
+			deploy: &model.DeployInfo{
+				Divert: &model.DivertDeploy{Namespace: "test"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &Options{Manifest: &model.Manifest{Deploy: tt.deploy}}
+			assert.Equal(t, tt.expected, dryRunSkippedSections(opts))
+		})
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	contextCMD "github.com/okteto/okteto/cmd/context"
+	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
+	"github.com/okteto/okteto/pkg/constants"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	ioCtrl "github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	buildEnvVarPrefix = "OKTETO_BUILD_"
+	imageEnvVarSuffix = "_IMAGE"
+	shortCommitLength = 7
+)
+
+// HistoryOptions defines the options to list the deploys of a development environment
+type HistoryOptions struct {
+	Name         string
+	ManifestPath string
+	Namespace    string
+	K8sContext   string
+	Output       string
+}
+
+type historyItem struct {
+	Timestamp  time.Time         `json:"timestamp" yaml:"timestamp"`
+	Images     map[string]string `json:"images,omitempty" yaml:"images,omitempty"`
+	Status     string            `json:"status" yaml:"status"`
+	User       string            `json:"user,omitempty" yaml:"user,omitempty"`
+	GitCommit  string            `json:"gitCommit,omitempty" yaml:"gitCommit,omitempty"`
+	Branch     string            `json:"branch,omitempty" yaml:"branch,omitempty"`
+	Revision   int               `json:"revision" yaml:"revision"`
+	RollbackOf int               `json:"rollbackOf,omitempty" yaml:"rollbackOf,omitempty"`
+}
+
+// History lists the last deploys of a development environment
+func History(ctx context.Context, k8sLogger *ioCtrl.K8sLogger) *cobra.Command {
+	options := &HistoryOptions{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List the last deploys of your Development Environment",
+		Example: `# List the deploys of the Development Environment
+$ okteto deploy history
+
+# Redeploy one of them
+$ okteto rollback --revision 3`,
+		Args: utils.NoArgsAccepted(""),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := validateHistoryOutput(options.Output); err != nil {
+				return err
+			}
+
+			deployOptions := &Options{ManifestPath: options.ManifestPath, Name: options.Name}
+			if err := checkOktetoManifestPathFlag(deployOptions, afero.NewOsFs()); err != nil {
+				return err
+			}
+
+			if err := contextCMD.NewContextCommand().Run(ctx, &contextCMD.Options{Namespace: options.Namespace, Context: options.K8sContext, Show: options.Output == ""}); err != nil {
+				return err
+			}
+
+			if !okteto.IsOkteto() {
+				return oktetoErrors.ErrContextIsNotOktetoCluster
+			}
+
+			if err := resolveDevEnvironmentName(ctx, deployOptions, k8sLogger); err != nil {
+				return err
+			}
+			if options.Namespace == "" {
+				options.Namespace = okteto.GetContext().Namespace
+			}
+
+			cmapHandler := NewConfigmapHandler(okteto.NewK8sClientProviderWithLogger(k8sLogger), k8sLogger)
+			history, err := cmapHandler.GetHistory(ctx, deployOptions.Name, options.Namespace)
+			if err != nil {
+				return err
+			}
+			if len(history) == 0 && options.Output == "" {
+				oktetoLog.Information("There are no deploys of '%s' in the history", deployOptions.Name)
+				return nil
+			}
+			return printHistory(os.Stdout, history, options.Output)
+		},
+	}
+	cmd.Flags().StringVar(&options.Name, "name", "", "the name of the Development Environment")
+	cmd.Flags().StringVarP(&options.ManifestPath, "file", "f", "", "the path to the Okteto Manifest")
+	cmd.Flags().StringVarP(&options.Namespace, "namespace", "n", "", "overwrite the current Okteto Namespace")
+	cmd.Flags().StringVarP(&options.K8sContext, "context", "c", "", "overwrite the current Okteto Context")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", "", "output format. One of: ['json', 'yaml']")
+	return cmd
+}
+
+func validateHistoryOutput(output string) error {
+	switch output {
+	case "", "json", "yaml":
+		return nil
+	default:
+		return fmt.Errorf("output format is not accepted. Value must be one of: ['json', 'yaml']")
+	}
+}
+
+// printHistory shows the revisions from the newest to the oldest. The variables are never shown as they might be secrets
+func printHistory(w io.Writer, history []pipeline.Revision, output string) error {
+	items := make([]historyItem, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		rev := history[i]
+		items = append(items, historyItem{
+			Revision:   rev.Number,
+			Timestamp:  rev.Timestamp,
+			Status:     rev.Status,
+			User:       rev.User,
+			GitCommit:  rev.GitCommit,
+			Branch:     rev.Branch,
+			RollbackOf: rev.RollbackOf,
+			Images:     getRevisionImages(rev),
+		})
+	}
+
+	switch output {
+	case "json":
+		bytes, err := json.MarshalIndent(items, "", " ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(bytes))
+	case "yaml":
+		bytes, err := yaml.Marshal(items)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(w, string(bytes))
+	default:
+		tw := tabwriter.NewWriter(w, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join([]string{"Revision", "Deployed", "Status", "User", "Commit", "Description"}, "\t"))
+		for _, item := range items {
+			commit := item.GitCommit
+			if len(commit) > shortCommitLength {
+				commit = commit[:shortCommitLength]
+			}
+			description := "-"
+			if item.RollbackOf != 0 {
+				description = fmt.Sprintf("rollback to %d", item.RollbackOf)
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", item.Revision, item.Timestamp.Local().Format(time.DateTime), item.Status, valueOrDash(item.User), valueOrDash(commit), description)
+		}
+		tw.Flush()
+	}
+	return nil
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// getRevisionImages returns the images deployed by a revision indexed by the name of their build in the OKTETO_BUILD_* variables
+func getRevisionImages(rev pipeline.Revision) map[string]string {
+	images := map[string]string{}
+	for k, v := range rev.BuildEnvVars {
+		if !strings.HasPrefix(k, buildEnvVarPrefix) || !strings.HasSuffix(k, imageEnvVarSuffix) {
+			continue
+		}
+		build := strings.TrimSuffix(strings.TrimPrefix(k, buildEnvVarPrefix), imageEnvVarSuffix)
+		images[strings.ToLower(build)] = v
+	}
+	if len(images) == 0 {
+		return nil
+	}
+	return images
+}
+
+// resolveDevEnvironmentName sets the name of the development environment from the manifest when it isn't set by the user
+func resolveDevEnvironmentName(ctx context.Context, opts *Options, k8sLogger *ioCtrl.K8sLogger) error {
+	if opts.Name != "" {
+		return nil
+	}
+
+	manifest, err := model.GetManifestV2(opts.ManifestPath, afero.NewOsFs())
+	if err != nil {
+		return err
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get the current working directory: %w", err)
+	}
+	c, _, err := okteto.NewK8sClientProviderWithLogger(k8sLogger).Provide(okteto.GetContext().Cfg)
+	if err != nil {
+		return err
+	}
+	n := Namer{
+		Workdir:      cwd,
+		KubeClient:   c,
+		ManifestName: manifest.Name,
+		ManifestPath: opts.ManifestPathFlag,
+	}
+	opts.Name = n.ResolveName(ctx)
+	return nil
+}
+
+// recordRevision adds the deploy to the history of the development environment once its status is known
+func (dc *Command) recordRevision(ctx context.Context, deployOptions *Options, data *pipeline.CfgData) {
+	rev := pipeline.Revision{
+		Timestamp:    time.Now().UTC(),
+		User:         okteto.GetContext().Username,
+		Status:       data.Status,
+		GitCommit:    os.Getenv(constants.OktetoGitCommitEnvVar),
+		Branch:       data.Branch,
+		Filename:     data.Filename,
+		Variables:    deployOptions.Variables,
+		BuildEnvVars: filterBuildEnvVars(dc.getBuildEnvVarsFunc(deployOptions)()),
+	}
+	// compose files are not stored, so those deploys can't be rolled back
+	if deployOptions.Manifest.Type == model.OktetoManifestType {
+		rev.Manifest = deployOptions.Manifest.Manifest
+	}
+	if deployOptions.Revision != nil {
+		rev.RollbackOf = deployOptions.Revision.Number
+		rev.GitCommit = deployOptions.Revision.GitCommit
+		rev.Branch = deployOptions.Revision.Branch
+		rev.Filename = deployOptions.Revision.Filename
+	}
+
+	number, err := dc.CfgMapHandler.AddRevision(ctx, deployOptions.Name, deployOptions.Namespace, rev)
+	if err != nil {
+		oktetoLog.Infof("could not add the deploy to the history: %s", err)
+		return
+	}
+	oktetoLog.Infof("deploy of '%s' recorded as revision %d", deployOptions.Name, number)
+}
+
+// getBuildEnvVarsFunc returns the build variables of the images deployed, the ones of the revision on a rollback
+func (dc *Command) getBuildEnvVarsFunc(deployOptions *Options) buildEnvVarsGetter {
+	if deployOptions.Revision != nil {
+		return func() map[string]string {
+			return deployOptions.Revision.BuildEnvVars
+		}
+	}
+	return dc.Builder.GetBuildEnvVars
+}
+
+// setRevisionBuildEnvVars exposes the images of the revision to the deploy commands instead of building them
+func (dc *Command) setRevisionBuildEnvVars(ctx context.Context, deployOptions *Options) {
+	for k, v := range deployOptions.Revision.BuildEnvVars {
+		if err := os.Setenv(k, v); err != nil {
+			oktetoLog.Infof("could not set build env var %s: %s", k, err)
+		}
+	}
+	if err := dc.CfgMapHandler.SetBuildEnvVars(ctx, deployOptions.Name, deployOptions.Namespace, deployOptions.Revision.BuildEnvVars); err != nil {
+		oktetoLog.Infof("error setting build env vars: %s", err.Error())
+	}
+}
+
+func filterBuildEnvVars(envVars map[string]string) map[string]string {
+	result := map[string]string{}
+	for k, v := range envVars {
+		if strings.HasPrefix(k, buildEnvVarPrefix) {
+			result[k] = v
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,232 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/internal/test"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var historyManifest = []byte(`deploy:
+  - kubectl apply -f k8s.yml
+`)
+
+func newHistoryCommand(t *testing.T) (*Command, *fakeDeployer) {
+	t.Helper()
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: "test",
+				Username:  "cindy",
+				Cfg:       &clientcmdapi.Config{},
+			},
+		},
+		CurrentContext: "test",
+	}
+
+	fakeK8sClientProvider := test.NewFakeK8sProvider()
+	deployer := &fakeDeployer{}
+	deployer.On(
+		"Get",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(deployer, nil)
+	deployer.On("Deploy", mock.Anything, mock.Anything).Return(nil)
+
+	return &Command{
+		AnalyticsTracker: &fakeTracker{},
+		GetManifest: func(string, afero.Fs) (*model.Manifest, error) {
+			return model.ReadOktetoManifest(historyManifest, "")
+		},
+		K8sClientProvider: fakeK8sClientProvider,
+		EndpointGetter:    getFakeEndpoint,
+		Fs:                afero.NewMemMapFs(),
+		CfgMapHandler:     newDefaultConfigMapHandler(fakeK8sClientProvider, nil),
+		GetDeployer:       deployer.Get,
+		Builder:           &fakeV2Builder{},
+		IoCtrl:            io.NewIOController(),
+		DivertDeployerGetter: func(_ *model.DivertDeploy, _, _ string, _ kubernetes.Interface, _ *io.Controller) (DivertDeployer, error) {
+			return &fakeDivert{}, nil
+		},
+	}, deployer
+}
+
+func TestDeployRecordsRevision(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newHistoryCommand(t)
+	t.Setenv("OKTETO_GIT_COMMIT", "")
+
+	opts := &Options{Name: "movies", Namespace: "test", Variables: []string{"A=1"}}
+	require.NoError(t, c.Run(ctx, opts))
+	require.NoError(t, c.Run(ctx, &Options{Name: "movies", Namespace: "test"}))
+
+	history, err := c.CfgMapHandler.GetHistory(ctx, "movies", "test")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 1, history[0].Number)
+	assert.Equal(t, 2, history[1].Number)
+	assert.Equal(t, pipeline.DeployedStatus, history[0].Status)
+	assert.Equal(t, "cindy", history[0].User)
+	assert.Equal(t, historyManifest, history[0].Manifest)
+	assert.Equal(t, []string{"A=1"}, history[0].Variables)
+	assert.Zero(t, history[0].RollbackOf)
+}
+
+func TestDeployRollback(t *testing.T) {
+	ctx := context.Background()
+	c, _ := newHistoryCommand(t)
+	t.Setenv("OKTETO_BUILD_API_IMAGE", "")
+	// the images of the revision are deployed, so nothing is built
+	builder := &fakeV2Builder{buildErr: assert.AnError}
+	c.Builder = builder
+
+	rev := &pipeline.Revision{
+		Number:       3,
+		Manifest:     historyManifest,
+		GitCommit:    "0123456789",
+		BuildEnvVars: map[string]string{"OKTETO_BUILD_API_IMAGE": "okteto.dev/api@sha256:1"},
+	}
+	opts := &Options{Name: "movies", Namespace: "test", Revision: rev}
+	require.NoError(t, c.Run(ctx, opts))
+	assert.Equal(t, "okteto.dev/api@sha256:1", os.Getenv("OKTETO_BUILD_API_IMAGE"))
+	assert.Equal(t, rev.BuildEnvVars, c.getBuildEnvVarsFunc(opts)())
+
+	history, err := c.CfgMapHandler.GetHistory(ctx, "movies", "test")
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, 3, history[0].RollbackOf)
+	assert.Equal(t, "0123456789", history[0].GitCommit)
+	assert.Equal(t, rev.BuildEnvVars, history[0].BuildEnvVars)
+}
+
+func TestSelectRevision(t *testing.T) {
+	history := []pipeline.Revision{
+		{Number: 4, Status: pipeline.DeployedStatus},
+		{Number: 5, Status: pipeline.ErrorStatus},
+		{Number: 6, Status: pipeline.DeployedStatus},
+	}
+	tests := []struct {
+		expectedErr error
+		name        string
+		history     []pipeline.Revision
+		number      int
+		expected    int
+	}{
+		{
+			name:        "empty history",
+			expectedErr: errNoHistory,
+		},
+		{
+			name:     "last successful before the current one",
+			history:  history,
+			expected: 4,
+		},
+		{
+			name:     "requested revision",
+			history:  history,
+			number:   5,
+			expected: 5,
+		},
+		{
+			name:        "only the current revision",
+			history:     history[2:],
+			expectedErr: errNoPreviousRevision,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rev, err := selectRevision(tt.history, tt.number)
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.expected, rev.Number)
+			}
+		})
+	}
+
+	_, err := selectRevision(history, 2)
+	assert.ErrorContains(t, err, "revision 2 is not in the history. Available revisions are 4 to 6")
+}
+
+func TestGetRevisionManifest(t *testing.T) {
+	manifest, err := getRevisionManifest(&pipeline.Revision{Number: 1, Manifest: historyManifest}, "okteto.yml")
+	require.NoError(t, err)
+	assert.Equal(t, "okteto.yml", manifest.ManifestPath)
+	assert.Len(t, manifest.Deploy.Commands, 1)
+
+	_, err = getRevisionManifest(&pipeline.Revision{Number: 2}, "")
+	assert.ErrorContains(t, err, "it wasn't deployed from an Okteto Manifest")
+
+	_, err = getRevisionManifest(&pipeline.Revision{Number: 3, Manifest: []byte("deploy:\n  compose: docker-compose.yml\n")}, "")
+	assert.ErrorContains(t, err, "the 'compose' section is not supported")
+}
+
+func TestPrintHistory(t *testing.T) {
+	ts := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+	history := []pipeline.Revision{
+		{
+			Number:       1,
+			Timestamp:    ts,
+			Status:       pipeline.DeployedStatus,
+			User:         "cindy",
+			GitCommit:    "0123456789abcdef",
+			Variables:    []string{"PASSWORD=secret"},
+			BuildEnvVars: map[string]string{"OKTETO_BUILD_API_IMAGE": "okteto.dev/api@sha256:1", "OKTETO_BUILD_API_TAG": "sha256:1"},
+		},
+		{
+			Number:     2,
+			Timestamp:  ts.Add(time.Hour),
+			Status:     pipeline.ErrorStatus,
+			RollbackOf: 1,
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printHistory(&out, history, ""))
+	expected := `Revision  Deployed             Status    User   Commit   Description
+2         2026-01-01 11:00:00  error     -      -        rollback to 1
+1         2026-01-01 10:00:00  deployed  cindy  0123456  -
+`
+	assert.Equal(t, expected, out.String())
+
+	out.Reset()
+	require.NoError(t, printHistory(&out, history, "json"))
+	assert.NotContains(t, out.String(), "secret")
+	assert.Contains(t, out.String(), `"api": "okteto.dev/api@sha256:1"`)
+
+	out.Reset()
+	require.NoError(t, printHistory(&out, nil, "json"))
+	assert.Equal(t, "[]\n", out.String())
+}
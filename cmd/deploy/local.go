@@ -54,6 +54,7 @@ func (ld *localDeployer) Deploy(ctx context.Context, deployOptions *Options) err
 		Namespace:    deployOptions.Namespace,
 		Variables:    deployOptions.Variables,
 		ManifestPath: deployOptions.Manifest.ManifestPath,
+		DryRun:       deployOptions.DryRun,
 		Deployable: deployable.Entity{
 			Commands: deployOptions.Manifest.Deploy.Commands,
 			Divert:   deployOptions.Manifest.Deploy.Divert,
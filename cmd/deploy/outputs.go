@@ -0,0 +1,153 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	contextCMD "github.com/okteto/okteto/cmd/context"
+	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	ioCtrl "github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+const maskedOutputValue = "***"
+
+// OutputsOptions defines the options to show the outputs of a development environment
+type OutputsOptions struct {
+	Name          string
+	ManifestPath  string
+	Namespace     string
+	K8sContext    string
+	Output        string
+	ShowSensitive bool
+}
+
+type outputItem struct {
+	Name      string `json:"name" yaml:"name"`
+	Value     string `json:"value" yaml:"value"`
+	Command   string `json:"command,omitempty" yaml:"command,omitempty"`
+	Sensitive bool   `json:"sensitive" yaml:"sensitive"`
+}
+
+// Outputs shows the outputs generated by the deploy commands of a development environment
+func Outputs(ctx context.Context, k8sLogger *ioCtrl.K8sLogger) *cobra.Command {
+	options := &OutputsOptions{}
+	cmd := &cobra.Command{
+		Use:   "outputs",
+		Short: "Show the outputs generated by the last deploy of your Development Environment",
+		Example: `# Show the outputs of the Development Environment
+$ okteto deploy outputs
+
+# Show them as JSON, including the values of the sensitive outputs
+$ okteto deploy outputs -o json --show-sensitive`,
+		Args: utils.NoArgsAccepted(""),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := validateHistoryOutput(options.Output); err != nil {
+				return err
+			}
+
+			deployOptions := &Options{ManifestPath: options.ManifestPath, Name: options.Name}
+			if err := checkOktetoManifestPathFlag(deployOptions, afero.NewOsFs()); err != nil {
+				return err
+			}
+
+			if err := contextCMD.NewContextCommand().Run(ctx, &contextCMD.Options{Namespace: options.Namespace, Context: options.K8sContext, Show: options.Output == ""}); err != nil {
+				return err
+			}
+
+			if !okteto.IsOkteto() {
+				return oktetoErrors.ErrContextIsNotOktetoCluster
+			}
+
+			if err := resolveDevEnvironmentName(ctx, deployOptions, k8sLogger); err != nil {
+				return err
+			}
+			if options.Namespace == "" {
+				options.Namespace = okteto.GetContext().Namespace
+			}
+
+			cmapHandler := NewConfigmapHandler(okteto.NewK8sClientProviderWithLogger(k8sLogger), k8sLogger)
+			outputs, err := cmapHandler.GetOutputs(ctx, deployOptions.Name, options.Namespace)
+			if err != nil {
+				return err
+			}
+			if len(outputs) == 0 && options.Output == "" {
+				oktetoLog.Information("'%s' doesn't have outputs", deployOptions.Name)
+				return nil
+			}
+			return printOutputs(os.Stdout, outputs, options.Output, options.ShowSensitive)
+		},
+	}
+	cmd.Flags().StringVar(&options.Name, "name", "", "the name of the Development Environment")
+	cmd.Flags().StringVarP(&options.ManifestPath, "file", "f", "", "the path to the Okteto Manifest")
+	cmd.Flags().StringVarP(&options.Namespace, "namespace", "n", "", "overwrite the current Okteto Namespace")
+	cmd.Flags().StringVarP(&options.K8sContext, "context", "c", "", "overwrite the current Okteto Context")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", "", "output format. One of: ['json', 'yaml']")
+	cmd.Flags().BoolVar(&options.ShowSensitive, "show-sensitive", false, "show the values of the sensitive outputs")
+	return cmd
+}
+
+// printOutputs shows the outputs in the order they were generated. The values of the sensitive outputs are
+// masked unless showSensitive is set
+func printOutputs(w io.Writer, outputs []pipeline.Output, output string, showSensitive bool) error {
+	items := make([]outputItem, 0, len(outputs))
+	for _, o := range outputs {
+		value := o.Value
+		if o.Sensitive && !showSensitive {
+			value = maskedOutputValue
+		}
+		items = append(items, outputItem{
+			Name:      o.Name,
+			Value:     value,
+			Command:   o.Command,
+			Sensitive: o.Sensitive,
+		})
+	}
+
+	switch output {
+	case "json":
+		bytes, err := json.MarshalIndent(items, "", " ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(bytes))
+	case "yaml":
+		bytes, err := yaml.Marshal(items)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(w, string(bytes))
+	default:
+		tw := tabwriter.NewWriter(w, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join([]string{"Name", "Value", "Command"}, "\t"))
+		for _, item := range items {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", item.Name, valueOrDash(item.Value), valueOrDash(item.Command))
+		}
+		tw.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintOutputs(t *testing.T) {
+	outputs := []pipeline.Output{
+		{Name: "DATABASE_HOST", Value: "postgres", Command: "create db"},
+		{Name: "DATABASE_PASSWORD", Value: "s3cr3t", Command: "create db", Sensitive: true},
+		{Name: "EMPTY"},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printOutputs(&out, outputs, "", false))
+	expected := `Name               Value     Command
+DATABASE_HOST      postgres  create db
+DATABASE_PASSWORD  ***       create db
+EMPTY              -         -
+`
+	assert.Equal(t, expected, out.String())
+
+	out.Reset()
+	require.NoError(t, printOutputs(&out, outputs, "json", false))
+	assert.NotContains(t, out.String(), "s3cr3t")
+	assert.Contains(t, out.String(), `"sensitive": true`)
+
+	out.Reset()
+	require.NoError(t, printOutputs(&out, outputs, "json", true))
+	assert.Contains(t, out.String(), `"value": "s3cr3t"`)
+
+	out.Reset()
+	require.NoError(t, printOutputs(&out, outputs[:1], "yaml", false))
+	assert.Equal(t, "- name: DATABASE_HOST\n  value: postgres\n  command: create db\n  sensitive: false\n", out.String())
+
+	out.Reset()
+	require.NoError(t, printOutputs(&out, nil, "json", false))
+	assert.Equal(t, "[]\n", out.String())
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	contextCMD "github.com/okteto/okteto/cmd/context"
+	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
+	"github.com/okteto/okteto/pkg/constants"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var (
+	errNoHistory          = errors.New("there are no deploys in the history of the Development Environment")
+	errNoPreviousRevision = errors.New("there is no previous successful deploy in the history of the Development Environment")
+)
+
+// Rollback redeploys a previous revision of a development environment
+func Rollback(ctx context.Context, at AnalyticsTrackerInterface, insightsTracker buildDeployTrackerInterface, ioCtrl *io.Controller, k8sLogger *io.K8sLogger) *cobra.Command {
+	options := &Options{}
+	var revision int
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Redeploy a previous deploy of your Development Environment using the images it deployed",
+		Example: `# Redeploy the last successful deploy before the current one
+$ okteto rollback
+
+# Redeploy the revision 3 listed by 'okteto deploy history'
+$ okteto rollback --revision 3`,
+		Args: utils.NoArgsAccepted(""),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			os.Setenv(constants.OktetoSkipConfigCredentialsUpdate, "false")
+
+			if err := checkOktetoManifestPathFlag(options, afero.NewOsFs()); err != nil {
+				return err
+			}
+
+			if err := contextCMD.NewContextCommand().Run(ctx, &contextCMD.Options{Show: true, Namespace: options.Namespace, Context: options.K8sContext}); err != nil {
+				return err
+			}
+
+			if !okteto.IsOkteto() {
+				return oktetoErrors.ErrContextIsNotOktetoCluster
+			}
+
+			if err := resolveDevEnvironmentName(ctx, options, k8sLogger); err != nil {
+				return err
+			}
+			if options.Namespace == "" {
+				options.Namespace = okteto.GetContext().Namespace
+			}
+
+			c, err := newDeployCommand(at, insightsTracker, ioCtrl, k8sLogger)
+			if err != nil {
+				return err
+			}
+			history, err := c.CfgMapHandler.GetHistory(ctx, options.Name, options.Namespace)
+			if err != nil {
+				return err
+			}
+			rev, err := selectRevision(history, revision)
+			if err != nil {
+				return fmt.Errorf("could not rollback '%s': %w", options.Name, err)
+			}
+			manifest, err := getRevisionManifest(rev, options.ManifestPath)
+			if err != nil {
+				return err
+			}
+
+			if err := validateAndSet(rev.Variables, os.Setenv); err != nil {
+				return err
+			}
+			options.Variables = rev.Variables
+			options.Revision = rev
+			options.NoBuild = true
+			options.ShowCTA = oktetoLog.IsInteractive()
+			c.GetManifest = func(string, afero.Fs) (*model.Manifest, error) {
+				return manifest, nil
+			}
+
+			oktetoLog.Information("Rolling back '%s' to revision %d", options.Name, rev.Number)
+			if rev.GitCommit != "" && rev.GitCommit != os.Getenv(constants.OktetoGitCommitEnvVar) {
+				oktetoLog.Warning("Revision %d was deployed from commit %s. The deploy commands run from the current working directory", rev.Number, rev.GitCommit)
+			}
+			return c.runInterruptible(ctx, options)
+		},
+	}
+
+	cmd.Flags().IntVar(&revision, "revision", 0, "the revision to redeploy, as listed by 'okteto deploy history'. Defaults to the last successful deploy before the current one")
+	cmd.Flags().StringVar(&options.Name, "name", "", "the name of the Development Environment")
+	cmd.Flags().StringVarP(&options.ManifestPath, "file", "f", "", "the path to the Okteto Manifest")
+	cmd.Flags().StringVarP(&options.Namespace, "namespace", "n", "", "overwrite the current Okteto Namespace")
+	cmd.Flags().StringVarP(&options.K8sContext, "context", "c", "", "overwrite the current Okteto Context")
+	cmd.Flags().BoolVarP(&options.Wait, "wait", "w", false, "wait until the deployment finishes and pods are healthy")
+	cmd.Flags().DurationVarP(&options.Timeout, "timeout", "t", getDefaultTimeout(), "when using `wait`, the maximum time to wait for the resources of the deployment to be healthy")
+	return cmd
+}
+
+// selectRevision returns the revision to redeploy. When no revision is requested, it is the last successful
+// deploy before the current one
+func selectRevision(history []pipeline.Revision, number int) (*pipeline.Revision, error) {
+	if len(history) == 0 {
+		return nil, errNoHistory
+	}
+
+	if number != 0 {
+		for i := range history {
+			if history[i].Number == number {
+				return &history[i], nil
+			}
+		}
+		return nil, fmt.Errorf("revision %d is not in the history. Available revisions are %d to %d", number, history[0].Number, history[len(history)-1].Number)
+	}
+
+	for i := len(history) - 2; i >= 0; i-- {
+		if history[i].Status == pipeline.DeployedStatus {
+			return &history[i], nil
+		}
+	}
+	return nil, errNoPreviousRevision
+}
+
+// getRevisionManifest returns the Okteto Manifest deployed by a revision
+func getRevisionManifest(rev *pipeline.Revision, manifestPath string) (*model.Manifest, error) {
+	if len(rev.Manifest) == 0 {
+		return nil, fmt.Errorf("revision %d can't be rolled back: it wasn't deployed from an Okteto Manifest", rev.Number)
+	}
+	manifest, err := model.ReadOktetoManifest(rev.Manifest, manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("revision %d can't be rolled back: %w", rev.Number, err)
+	}
+	if manifest.Deploy != nil && manifest.Deploy.ComposeSection != nil {
+		return nil, fmt.Errorf("revision %d can't be rolled back: the 'compose' section is not supported", rev.Number)
+	}
+	return manifest, nil
+}
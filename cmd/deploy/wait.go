@@ -15,18 +15,21 @@ package deploy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
-	"time"
+	"strings"
 
-	"github.com/okteto/okteto/pkg/cmd/pipeline"
+	"github.com/okteto/okteto/pkg/env"
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
-	"github.com/okteto/okteto/pkg/k8s/deployments"
-	"github.com/okteto/okteto/pkg/k8s/statefulsets"
+	"github.com/okteto/okteto/pkg/format"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	ioCtrl "github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
 	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/okteto/okteto/pkg/readiness"
+	"k8s.io/client-go/dynamic"
 )
 
 type Waiter struct {
@@ -66,48 +69,96 @@ func (dw *Waiter) wait(ctx context.Context, opts *Options) error {
 	return nil
 }
 
+// waitForResourcesToBeRunning waits until the resources with the deployed-by label of the development environment
+// and the checks declared in 'deploy.wait' are ready
 func (dw *Waiter) waitForResourcesToBeRunning(ctx context.Context, opts *Options) error {
-	ticker := time.NewTicker(5 * time.Second)
-	to := time.NewTicker(opts.Timeout)
-	c, _, err := dw.K8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, dw.K8sLogger)
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	checkers, err := dw.getCheckers(opts)
 	if err != nil {
 		return err
 	}
 
-	for {
-		select {
-		case <-to.C:
-			return fmt.Errorf("'%s' resources where not healthy after %s", opts.Manifest.Name, opts.Timeout.String())
-		case <-ticker.C:
-			ns := okteto.GetContext().Namespace
-			dList, err := pipeline.ListDeployments(ctx, opts.Manifest.Name, ns, c)
-			if err != nil {
-				return err
-			}
-			areAllRunning := true
-			for i := range dList {
-				d := &dList[i]
-				if !deployments.IsRunning(ctx, ns, d.Name, c) {
-					areAllRunning = false
-				}
-			}
-			if !areAllRunning {
-				continue
-			}
-			sfsList, err := pipeline.ListStatefulsets(ctx, opts.Manifest.Name, ns, c)
-			if err != nil {
-				return err
-			}
-			for i := range sfsList {
-				ss := &sfsList[i]
-				if !statefulsets.IsRunning(ctx, ns, ss.Name, c) {
-					areAllRunning = false
-				}
-			}
-			if !areAllRunning {
-				continue
-			}
-			return nil
+	err = readiness.Wait(ctx, checkers, func(pending []readiness.Pending) {
+		oktetoLog.Spinner(fmt.Sprintf("Waiting for %s to be deployed: %s", opts.Name, describePending(pending)))
+		for _, p := range pending {
+			oktetoLog.Infof("waiting for %s", p)
+		}
+	})
+	if err == nil {
+		return nil
+	}
+
+	var notReadyErr *readiness.NotReadyError
+	if !errors.As(err, &notReadyErr) {
+		return err
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return oktetoErrors.UserError{
+			E:    fmt.Errorf("'%s' resources where not healthy after %s", opts.Manifest.Name, opts.Timeout.String()),
+			Hint: fmt.Sprintf("Pending resources:%s", listPending(notReadyErr.Pending)),
+		}
+	}
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+	return oktetoErrors.UserError{
+		E:    fmt.Errorf("'%s' resources failed", opts.Manifest.Name),
+		Hint: fmt.Sprintf("Failed resources:%s", listPending(notReadyErr.Pending)),
+	}
+}
+
+func (dw *Waiter) getCheckers(opts *Options) ([]readiness.Checker, error) {
+	c, restConfig, err := dw.K8sClientProvider.ProvideWithLogger(okteto.GetContext().Cfg, dw.K8sLogger)
+	if err != nil {
+		return nil, err
+	}
+	dynClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := fmt.Sprintf("%s=%s", model.DeployedByLabel, format.ResourceK8sMetaString(opts.Manifest.Name))
+	checkers := []readiness.Checker{
+		readiness.NewResourceChecker(dynClient, c.Discovery(), okteto.GetContext().Namespace, selector),
+	}
+
+	if opts.Manifest.Deploy == nil || len(opts.Manifest.Deploy.Wait) == 0 {
+		return checkers, nil
+	}
+	probes := make([]readiness.Probe, 0, len(opts.Manifest.Deploy.Wait))
+	for _, check := range opts.Manifest.Deploy.Wait {
+		httpURL, err := env.ExpandEnv(check.HTTP)
+		if err != nil {
+			return nil, err
 		}
+		tcpAddress, err := env.ExpandEnv(check.TCP)
+		if err != nil {
+			return nil, err
+		}
+		probes = append(probes, readiness.Probe{
+			Name:   check.Name,
+			HTTP:   httpURL,
+			TCP:    tcpAddress,
+			Status: check.Status,
+		})
+	}
+	return append(checkers, readiness.NewProbeChecker(probes)), nil
+}
+
+// describePending returns a summary of the pending items for the spinner
+func describePending(pending []readiness.Pending) string {
+	if len(pending) == 1 {
+		return fmt.Sprintf("%s is pending", pending[0])
+	}
+	return fmt.Sprintf("%d resources pending, %s", len(pending), pending[0])
+}
+
+func listPending(pending []readiness.Pending) string {
+	var sb strings.Builder
+	for _, p := range pending {
+		sb.WriteString(fmt.Sprintf("\n    - %s", p))
 	}
+	return sb.String()
 }
@@ -18,6 +18,7 @@ import (
 
 	"github.com/okteto/okteto/pkg/cmd/pipeline"
 	"github.com/okteto/okteto/pkg/k8s/configmaps"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
@@ -30,6 +31,7 @@ type configMapHandler interface {
 	destroyConfigMap(context.Context, *apiv1.ConfigMap, string) error
 	setErrorStatus(context.Context, *apiv1.ConfigMap, *pipeline.CfgData, error) error
 	getConfigmapVariablesEncoded(ctx context.Context, name, namespace string) (string, error)
+	getInventory(ctx context.Context, name, namespace string) ([]inventory.Resource, error)
 }
 
 // oktetoDefaultConfigMapHandler is the runner used when the okteto is executed
@@ -56,6 +58,10 @@ func (ch *defaultConfigMapHandler) getConfigmapVariablesEncoded(ctx context.Cont
 	return pipeline.GetConfigmapVariablesEncoded(ctx, name, namespace, ch.k8sClient)
 }
 
+func (ch *defaultConfigMapHandler) getInventory(ctx context.Context, name, namespace string) ([]inventory.Resource, error) {
+	return pipeline.GetInventory(ctx, name, namespace, ch.k8sClient)
+}
+
 func (ch *defaultConfigMapHandler) destroyConfigMap(ctx context.Context, cfg *apiv1.ConfigMap, namespace string) error {
 	return configmaps.Destroy(ctx, cfg.Name, namespace, ch.k8sClient)
 }
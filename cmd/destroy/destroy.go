@@ -38,6 +38,7 @@ import (
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
 	"github.com/okteto/okteto/pkg/filesystem"
 	"github.com/okteto/okteto/pkg/format"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	"github.com/okteto/okteto/pkg/k8s/kubeconfig"
 	"github.com/okteto/okteto/pkg/k8s/namespaces"
 	"github.com/okteto/okteto/pkg/k8s/secrets"
@@ -65,6 +66,7 @@ const (
 
 type destroyer interface {
 	DestroyWithLabel(ctx context.Context, ns string, opts namespaces.DeleteAllOptions) error
+	DestroyInventory(ctx context.Context, ns string, resources []inventory.Resource, opts namespaces.DeleteAllOptions) error
 	DestroySFSVolumes(ctx context.Context, ns string, opts namespaces.DeleteAllOptions) error
 }
 
@@ -589,6 +591,16 @@ func (dc *destroyCommand) destroyK8sResources(ctx context.Context, opts *Options
 		}
 	}
 
+	if resources := dc.getInventory(ctx, opts); len(resources) > 0 {
+		oktetoLog.Debugf("destroying %d resources of the inventory", len(resources))
+		oktetoLog.SetStage("Destroying inventory")
+		if err := dc.nsDestroyer.DestroyInventory(ctx, opts.Namespace, resources, deleteOpts); err != nil {
+			oktetoLog.Infof("could not delete all the resources: %s", err)
+			return err
+		}
+	}
+
+	// resources without an entry in the inventory, like the ones created by commands of a previous deploy, still have the deployed-by label
 	oktetoLog.Debugf("destroying resources with deployed-by label '%s'", deployedBySelector)
 	oktetoLog.SetStage(fmt.Sprintf("Destroying by label '%s'", deployedBySelector))
 	if err := dc.nsDestroyer.DestroyWithLabel(ctx, opts.Namespace, deleteOpts); err != nil {
@@ -599,6 +611,21 @@ func (dc *destroyCommand) destroyK8sResources(ctx context.Context, opts *Options
 	return nil
 }
 
+// getInventory returns the resources recorded by the last deploy of the dev environment. Dev environments
+// deployed by previous versions of okteto don't have an inventory and are only destroyed by label
+func (dc *destroyCommand) getInventory(ctx context.Context, opts *Options) []inventory.Resource {
+	if dc.ConfigMapHandler == nil {
+		return nil
+	}
+
+	resources, err := dc.ConfigMapHandler.getInventory(ctx, opts.Name, opts.Namespace)
+	if err != nil {
+		oktetoLog.Infof("could not get the inventory of '%s': %s", opts.Name, err)
+		return nil
+	}
+	return resources
+}
+
 func (dc *destroyCommand) destroyHelmReleasesIfPresent(ctx context.Context, opts *Options, labelSelector string) error {
 	sList, err := dc.secrets.List(ctx, opts.Namespace, labelSelector)
 	if err != nil {
@@ -27,6 +27,7 @@ import (
 	"github.com/okteto/okteto/pkg/deps"
 	"github.com/okteto/okteto/pkg/divert"
 	okerrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	"github.com/okteto/okteto/pkg/k8s/namespaces"
 	"github.com/okteto/okteto/pkg/log/io"
 	"github.com/okteto/okteto/pkg/model"
@@ -82,6 +83,7 @@ var fakeManifestWithDependencies = &model.Manifest{
 type fakeDestroyer struct {
 	err              error
 	errOnVolumes     error
+	inventory        []inventory.Resource
 	destroyed        bool
 	destroyedVolumes bool
 }
@@ -105,6 +107,15 @@ func (fd *fakeDestroyer) DestroyWithLabel(_ context.Context, _ string, _ namespa
 	return nil
 }
 
+func (fd *fakeDestroyer) DestroyInventory(_ context.Context, _ string, resources []inventory.Resource, _ namespaces.DeleteAllOptions) error {
+	if fd.err != nil {
+		return fd.err
+	}
+
+	fd.inventory = resources
+	return nil
+}
+
 func (fd *fakeDestroyer) DestroySFSVolumes(_ context.Context, _ string, _ namespaces.DeleteAllOptions) error {
 	if fd.errOnVolumes != nil {
 		return fd.errOnVolumes
@@ -1099,3 +1110,34 @@ func TestHasDivert(t *testing.T) {
 func loadBoolPointer(v bool) *bool {
 	return &v
 }
+
+func TestDestroyK8sResourcesWithInventory(t *testing.T) {
+	ctx := context.Background()
+	resources := []inventory.Resource{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "namespace", Name: "api", UID: "1"},
+	}
+	cmap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pipeline.TranslatePipelineName("test-app"),
+			Namespace: "namespace",
+		},
+	}
+	fakeClient, _, err := test.NewFakeK8sProvider(cmap).Provide(api.NewConfig())
+	require.NoError(t, err)
+	require.NoError(t, pipeline.UpdateInventory(ctx, "test-app", "namespace", resources, fakeClient))
+
+	destroyer := &fakeDestroyer{}
+	dc := &destroyCommand{
+		ConfigMapHandler: NewConfigmapHandler(fakeClient),
+		nsDestroyer:      destroyer,
+		secrets:          &fakeSecretHandler{},
+	}
+
+	err = dc.destroyK8sResources(ctx, &Options{
+		Name:      "test-app",
+		Namespace: "namespace",
+	})
+	require.NoError(t, err)
+	require.Equal(t, resources, destroyer.inventory)
+	require.True(t, destroyer.destroyed)
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package divert
+
+import (
+	"context"
+
+	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/spf13/cobra"
+)
+
+// Divert divert management commands
+func Divert(ctx context.Context, ioCtrl *io.Controller, k8sLogger *io.K8sLogger) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "divert",
+		Short: "Divert management commands",
+		Args:  utils.NoArgsAccepted("https://www.okteto.com/docs/reference/okteto-cli/#divert"),
+	}
+	cmd.AddCommand(Status(ctx, ioCtrl, k8sLogger))
+	return cmd
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package divert
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	contextCMD "github.com/okteto/okteto/cmd/context"
+	"github.com/okteto/okteto/cmd/utils"
+	"github.com/okteto/okteto/pkg/divert"
+	"github.com/okteto/okteto/pkg/divert/k8s"
+	"github.com/okteto/okteto/pkg/k8s/httproutes"
+	"github.com/okteto/okteto/pkg/k8s/virtualservices"
+	oktetoIO "github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/spf13/cobra"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// StatusOptions represents the options of the divert status command
+type StatusOptions struct {
+	Namespace  string
+	K8sContext string
+	Prune      bool
+}
+
+type statusReader interface {
+	List(ctx context.Context, sharedNamespace string, namespaces []string) ([]divert.Status, error)
+}
+
+// statusCommand shows the diverts of a shared namespace
+type statusCommand struct {
+	reader         statusReader
+	listNamespaces func(ctx context.Context) ([]string, error)
+	ioCtrl         *oktetoIO.Controller
+	out            io.Writer
+}
+
+// Status shows the diverts of a shared namespace into the developer namespaces
+func Status(ctx context.Context, ioCtrl *oktetoIO.Controller, k8sLogger *oktetoIO.K8sLogger) *cobra.Command {
+	options := &StatusOptions{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the services and hosts of a shared namespace diverted into other namespaces",
+		Args:  utils.NoArgsAccepted("https://www.okteto.com/docs/reference/okteto-cli/#divert-status"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := contextCMD.NewContextCommand().Run(ctx, &contextCMD.Options{Namespace: options.Namespace, Context: options.K8sContext, Show: true}); err != nil {
+				return err
+			}
+			if options.Namespace == "" {
+				options.Namespace = okteto.GetContext().Namespace
+			}
+
+			sc, err := newStatusCommand(ioCtrl, k8sLogger)
+			if err != nil {
+				return err
+			}
+			return sc.run(ctx, options)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.Namespace, "namespace", "n", "", "the shared namespace to inspect")
+	cmd.Flags().StringVarP(&options.K8sContext, "context", "c", "", "the context to use")
+	cmd.Flags().BoolVarP(&options.Prune, "prune", "", false, "remove the orphaned diverts")
+	return cmd
+}
+
+func newStatusCommand(ioCtrl *oktetoIO.Controller, k8sLogger *oktetoIO.K8sLogger) (*statusCommand, error) {
+	c, _, err := okteto.NewK8sClientProviderWithLogger(k8sLogger).Provide(okteto.GetContext().Cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var divertClient k8s.DivertV1Interface
+	if okteto.GetContext().DivertCRDSEnabled {
+		divertClient, err = k8s.GetDivertClient()
+		if err != nil {
+			return nil, fmt.Errorf("error creating divert client: %w", err)
+		}
+	}
+	ic, err := virtualservices.GetIstioClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating istio client: %w", err)
+	}
+	gc, err := httproutes.GetGatewayClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating gateway client: %w", err)
+	}
+
+	return &statusCommand{
+		reader:         divert.NewStatusReader(c, divertClient, ic, gc),
+		listNamespaces: namespaceLister(c),
+		ioCtrl:         ioCtrl,
+		out:            os.Stdout,
+	}, nil
+}
+
+// namespaceLister returns the namespaces of the developers. In Okteto, they are the namespaces the user has access to
+func namespaceLister(c kubernetes.Interface) func(ctx context.Context) ([]string, error) {
+	return func(ctx context.Context) ([]string, error) {
+		result := []string{}
+		if okteto.IsOkteto() {
+			oc, err := okteto.NewOktetoClient()
+			if err != nil {
+				return nil, err
+			}
+			namespaces, err := oc.Namespaces().List(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get namespaces: %w", err)
+			}
+			for _, ns := range namespaces {
+				result = append(result, ns.ID)
+			}
+			return result, nil
+		}
+
+		namespaces, err := c.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			// without cluster-scope permissions, only the diverts of the shared namespace are listed
+			if k8sErrors.IsForbidden(err) {
+				return result, nil
+			}
+			return nil, fmt.Errorf("failed to get namespaces: %w", err)
+		}
+		for _, ns := range namespaces.Items {
+			result = append(result, ns.Name)
+		}
+		return result, nil
+	}
+}
+
+func (sc *statusCommand) run(ctx context.Context, options *StatusOptions) error {
+	namespaces, err := sc.listNamespaces(ctx)
+	if err != nil {
+		return err
+	}
+	statuses, err := sc.reader.List(ctx, options.Namespace, namespaces)
+	if err != nil {
+		return err
+	}
+
+	if len(statuses) == 0 {
+		sc.ioCtrl.Out().Println(fmt.Sprintf("There are no diverts of namespace '%s'", options.Namespace))
+		return nil
+	}
+	sc.printStatuses(statuses)
+
+	orphans := 0
+	for i := range statuses {
+		if !statuses[i].Orphan {
+			continue
+		}
+		orphans++
+		if !options.Prune {
+			continue
+		}
+		if err := statuses[i].Prune(ctx); err != nil {
+			return fmt.Errorf("failed to prune divert '%s/%s' of namespace '%s': %w", statuses[i].Kind, statuses[i].Name, statuses[i].Namespace, err)
+		}
+		sc.ioCtrl.Out().Success("Orphaned divert '%s/%s' of namespace '%s' removed", statuses[i].Kind, statuses[i].Name, statuses[i].Namespace)
+	}
+	if orphans > 0 && !options.Prune {
+		sc.ioCtrl.Out().Warning("Found %d orphaned diverts. Run 'okteto divert status --prune' to remove them", orphans)
+	}
+	return nil
+}
+
+func (sc *statusCommand) printStatuses(statuses []divert.Status) {
+	w := tabwriter.NewWriter(sc.out, 1, 1, 2, ' ', 0)
+	fmt.Fprintf(w, "Namespace\tDriver\tResource\tDivert header\tStatus\n")
+	for _, s := range statuses {
+		fmt.Fprintf(w, "%s\t%s\t%s/%s\t%s\t%s\n", s.Namespace, s.Driver, s.Kind, s.Name, s.Header, statusMessage(s))
+	}
+	w.Flush()
+}
+
+func statusMessage(s divert.Status) string {
+	switch {
+	case s.Orphan:
+		return fmt.Sprintf("orphaned: %s", s.Reason)
+	case !s.Healthy:
+		return fmt.Sprintf("unhealthy: %s", s.Reason)
+	default:
+		return "healthy"
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package divert
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/divert"
+	"github.com/okteto/okteto/pkg/divert/k8s"
+	"github.com/okteto/okteto/pkg/divert/k8s/fake"
+	oktetoIO "github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type fakeStatusReader struct {
+	err        error
+	statuses   []divert.Status
+	namespaces []string
+}
+
+func (f *fakeStatusReader) List(_ context.Context, _ string, namespaces []string) ([]divert.Status, error) {
+	f.namespaces = namespaces
+	return f.statuses, f.err
+}
+
+func newTestStatusCommand(reader statusReader, out *bytes.Buffer) *statusCommand {
+	return &statusCommand{
+		reader: reader,
+		listNamespaces: func(context.Context) ([]string, error) {
+			return []string{"staging", "cindy"}, nil
+		},
+		ioCtrl: oktetoIO.NewIOController(),
+		out:    out,
+	}
+}
+
+func TestStatusRun(t *testing.T) {
+	out := &bytes.Buffer{}
+	reader := &fakeStatusReader{
+		statuses: []divert.Status{
+			{Driver: constants.OktetoDivertNginxDriver, Kind: divert.StatusKindService, Name: "api", Namespace: "cindy", Header: "baggage: okteto-divert=cindy", Healthy: true},
+			{Driver: constants.OktetoDivertNginxDriver, Kind: divert.StatusKindService, Name: "worker", Namespace: "cindy", Header: "baggage: okteto-divert=cindy", Reason: "service 'worker' doesn't exist in namespace 'staging'"},
+		},
+	}
+	sc := newTestStatusCommand(reader, out)
+
+	require.NoError(t, sc.run(context.Background(), &StatusOptions{Namespace: "staging"}))
+	assert.Equal(t, []string{"staging", "cindy"}, reader.namespaces)
+	assert.Regexp(t, `cindy +nginx +service/api +baggage: okteto-divert=cindy +healthy`, out.String())
+	assert.Contains(t, out.String(), "unhealthy: service 'worker' doesn't exist in namespace 'staging'")
+}
+
+func TestStatusRunError(t *testing.T) {
+	sc := newTestStatusCommand(&fakeStatusReader{err: errors.New("forbidden")}, &bytes.Buffer{})
+	require.EqualError(t, sc.run(context.Background(), &StatusOptions{Namespace: "staging"}), "forbidden")
+}
+
+func TestStatusRunPrune(t *testing.T) {
+	ctx := context.Background()
+	c := k8sfake.NewClientset(&apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cindy"}})
+	dc := fake.NewFakeDivertV1(fake.PossibleDivertErrors{}, &k8s.Divert{
+		ObjectMeta: metav1.ObjectMeta{Name: "movies-db", Namespace: "cindy"},
+		Spec:       k8s.DivertSpec{Service: "db", SharedNamespace: "staging", DivertKey: "cindy"},
+	})
+	out := &bytes.Buffer{}
+	sc := newTestStatusCommand(divert.NewStatusReader(c, dc, nil, nil), out)
+
+	require.NoError(t, sc.run(ctx, &StatusOptions{Namespace: "staging"}))
+	assert.Contains(t, out.String(), "orphaned: service 'db' doesn't exist in namespace 'cindy'")
+	_, err := dc.Diverts("cindy").Get(ctx, "movies-db", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, sc.run(ctx, &StatusOptions{Namespace: "staging", Prune: true}))
+	_, err = dc.Diverts("cindy").Get(ctx, "movies-db", metav1.GetOptions{})
+	require.Error(t, err)
+}
+
+func TestNamespaceListerForbidden(t *testing.T) {
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts:       map[string]*okteto.Context{"test": {Namespace: "staging"}},
+		CurrentContext: "test",
+	}
+	c := k8sfake.NewClientset()
+	c.PrependReactor("list", "namespaces", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8sErrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", errors.New("forbidden"))
+	})
+
+	namespaces, err := namespaceLister(c)(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, namespaces)
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/okteto/okteto/pkg/validator"
 	"github.com/spf13/cobra"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 const (
@@ -274,7 +275,7 @@ func (pc *Command) ExecuteDeployPipeline(ctx context.Context, opts *DeployOption
 		return err
 	}
 
-	if err := setEnvsFromDependency(cmap, os.Setenv); err != nil {
+	if err := setEnvsFromDependency(ctx, cmap, c, os.Setenv); err != nil {
 		return fmt.Errorf("could not set environment variable generated by dependency '%s': %w", opts.Name, err)
 	}
 
@@ -303,28 +304,34 @@ func shouldRedeployDependencies(opts *DeployOptions) bool {
 
 type envSetter func(name, value string) error
 
-// setEnvsFromDependency sets the environment variables found at configmap.Data[dependencyEnvs]
-func setEnvsFromDependency(cmap *v1.ConfigMap, envSetter envSetter) error {
+// setEnvsFromDependency sets the environment variables found at configmap.Data[dependencyEnvs] and the outputs of the dependency
+func setEnvsFromDependency(ctx context.Context, cmap *v1.ConfigMap, c kubernetes.Interface, envSetter envSetter) error {
 	if cmap == nil || cmap.Data == nil {
 		return nil
 	}
 
-	dependencyEnvsEncoded, ok := cmap.Data[constants.OktetoDependencyEnvsKey]
-	if !ok {
-		return nil
-	}
-
-	name := strings.TrimPrefix(cmap.Name, pipeline.ConfigmapNamePrefix)
-	sanitizedName := strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
-
-	decodedEnvs, err := base64.StdEncoding.DecodeString(dependencyEnvsEncoded)
+	outputs, err := pipeline.ReadOutputs(ctx, cmap, c)
 	if err != nil {
 		return err
 	}
+	pipeline.MaskSensitiveOutputs(outputs)
+
 	envsToSet := make(map[string]string)
-	if err = json.Unmarshal(decodedEnvs, &envsToSet); err != nil {
-		return err
+	if dependencyEnvsEncoded, ok := cmap.Data[constants.OktetoDependencyEnvsKey]; ok {
+		decodedEnvs, err := base64.StdEncoding.DecodeString(dependencyEnvsEncoded)
+		if err != nil {
+			return err
+		}
+		if err = json.Unmarshal(decodedEnvs, &envsToSet); err != nil {
+			return err
+		}
 	}
+	for _, o := range outputs {
+		envsToSet[o.Name] = o.Value
+	}
+
+	name := strings.TrimPrefix(cmap.Name, pipeline.ConfigmapNamePrefix)
+	sanitizedName := strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
 	for envKey, envValue := range envsToSet {
 		envName := fmt.Sprintf(dependencyEnvTemplate, strings.ToUpper(sanitizedName), envKey)
 		if err := envSetter(envName, envValue); err != nil {
@@ -35,6 +35,7 @@ import (
 	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func Test_getRepositoryURL(t *testing.T) {
@@ -741,6 +742,23 @@ func TestSetEnvsFromDependencyNoError(t *testing.T) {
 				"OKTETO_DEPENDENCY_TEST_CONFIGMAP_VARIABLE_TESTSETENVSFROMDEPEN_TWO": "another env value",
 			},
 		},
+		{
+			name:      "configmap has outputs",
+			envSetter: fakeEnvSetter{},
+			cmap: &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "okteto-git-test-configmap",
+				},
+				Data: map[string]string{
+					// [{"name":"DATABASE_URL","value":"postgres://db","command":"db","sensitive":true}]
+					"outputs": "W3sibmFtZSI6IkRBVEFCQVNFX1VSTCIsInZhbHVlIjoicG9zdGdyZXM6Ly9kYiIsImNvbW1hbmQiOiJkYiIsInNlbnNpdGl2ZSI6dHJ1ZX1d",
+				},
+			},
+			expectedErr: false,
+			expectedEnvsSet: map[string]string{
+				"OKTETO_DEPENDENCY_TEST_CONFIGMAP_VARIABLE_DATABASE_URL": "postgres://db",
+			},
+		},
 		{
 			name: "configmap has dependency envs - return err",
 			envSetter: fakeEnvSetter{
@@ -761,7 +779,7 @@ func TestSetEnvsFromDependencyNoError(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := setEnvsFromDependency(tt.cmap, tt.envSetter.Set)
+			err := setEnvsFromDependency(context.Background(), tt.cmap, fake.NewSimpleClientset(), tt.envSetter.Set)
 			require.Truef(t, tt.expectedErr == (err != nil), "unexpected error")
 			require.Equal(t, tt.expectedEnvsSet, tt.envSetter.envs)
 		})
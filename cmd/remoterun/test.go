@@ -15,6 +15,7 @@ package remoterun
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -140,7 +141,16 @@ commands:
 				oktetoLog.Info(message)
 			}
 
-			return runner.RunTest(context.Background(), params)
+			err = runner.RunTest(context.Background(), params)
+			var cmdErr deployable.TestCommandError
+			if errors.As(err, &cmdErr) {
+				// the name of the failed command is sent in its own stage, so okteto test gets it without parsing the error
+				oktetoLog.SetStage(deployable.FailedTestCommandStage)
+				oktetoLog.Println(cmdErr.Command)
+				oktetoLog.SetStage(params.Name)
+				return cmdErr.Err
+			}
+			return err
 		},
 	}
 
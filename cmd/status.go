@@ -15,7 +15,9 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"os/signal"
 	"time"
@@ -46,6 +48,8 @@ func Status(fs afero.Fs) *cobra.Command {
 	var k8sContext string
 	var showInfo bool
 	var watch bool
+	var forwards bool
+	var output string
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Status of the file synchronization process for a given Development Container",
@@ -59,9 +63,13 @@ func Status(fs afero.Fs) *cobra.Command {
 				return oktetoErrors.ErrNotInDevContainer
 			}
 
+			if output != "" && output != "json" {
+				return fmt.Errorf("invalid output format '%s', must be 'json'", output)
+			}
+
 			ctx := context.Background()
 
-			if err := contextCMD.NewContextCommand().Run(ctx, &contextCMD.Options{Show: true, Namespace: namespace, Context: k8sContext}); err != nil {
+			if err := contextCMD.NewContextCommand().Run(ctx, &contextCMD.Options{Show: output == "", Namespace: namespace, Context: k8sContext}); err != nil {
 				return err
 			}
 
@@ -81,6 +89,21 @@ func Status(fs afero.Fs) *cobra.Command {
 			if len(args) == 1 {
 				devName = args[0]
 			}
+
+			if output == "json" {
+				devs := manifest.Dev
+				if devName != "" {
+					dev, err := utils.GetDevFromManifest(manifest, devName)
+					if err != nil {
+						return err
+					}
+					devs = model.ManifestDevs{devName: dev}
+				}
+				err = runJSON(ctx, devs, okteto.GetContext().Namespace, watch)
+				analytics.TrackStatus(err == nil, showInfo)
+				return err
+			}
+
 			dev, err := utils.GetDevFromManifest(manifest, devName)
 			if err != nil {
 				if !errors.Is(err, utils.ErrNoDevSelected) {
@@ -93,6 +116,12 @@ func Status(fs afero.Fs) *cobra.Command {
 				}
 			}
 
+			if forwards {
+				err = runForwards(dev, okteto.GetContext().Namespace)
+				analytics.TrackStatus(err == nil, showInfo)
+				return err
+			}
+
 			waitForStates := []config.UpState{config.Synchronizing, config.Ready}
 			if err := status.Wait(dev, okteto.GetContext().Namespace, waitForStates); err != nil {
 				return err
@@ -126,6 +155,8 @@ func Status(fs afero.Fs) *cobra.Command {
 	cmd.Flags().StringVarP(&k8sContext, "context", "c", "", "overwrite the current Okteto Context")
 	cmd.Flags().BoolVarP(&showInfo, "info", "i", false, "show syncthing links for troubleshooting the synchronization service")
 	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "watch for changes")
+	cmd.Flags().BoolVarP(&forwards, "forwards", "", false, "show the health of the global forwards")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "output format, it reports all the development containers in dev mode. One of: ['json']")
 	return cmd
 }
 
@@ -185,3 +216,44 @@ func runWithoutWatch(ctx context.Context, sy *syncthing.Syncthing) error {
 	}
 	return nil
 }
+
+func runForwards(dev *model.Dev, namespace string) error {
+	if _, err := config.GetState(dev.Name, namespace); err != nil {
+		return err
+	}
+
+	statuses, err := config.GetForwards(dev.Name, namespace)
+	if err != nil {
+		return err
+	}
+
+	if len(statuses) == 0 {
+		oktetoLog.Information("There are no global forwards for the development container '%s'", dev.Name)
+		return nil
+	}
+
+	return status.PrintForwards(os.Stdout, statuses, time.Now())
+}
+
+// runJSON prints the status of the development containers in dev mode. With watch, it prints one line per change
+func runJSON(ctx context.Context, devs model.ManifestDevs, namespace string, watch bool) error {
+	if !watch {
+		b, err := json.MarshalIndent(status.GetDevStatuses(ctx, devs, namespace), "", " ")
+		if err != nil {
+			return err
+		}
+		oktetoLog.Println(string(b))
+		return nil
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+	return status.Watch(ctx, devs, namespace, time.Second, func(s status.DevStatus) error {
+		b, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+		oktetoLog.Println(string(b))
+		return nil
+	})
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"text/tabwriter"
+
+	"github.com/okteto/okteto/cmd/utils"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/syncthing"
+	"github.com/okteto/okteto/pkg/validator"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	keepLocal  = "local"
+	keepRemote = "remote"
+	showDiff   = "diff"
+	skip       = "skip"
+)
+
+// Conflicts lists and resolves the synchronization conflicts of a development container
+func Conflicts() *cobra.Command {
+	var devPath string
+	var keep string
+	cmd := &cobra.Command{
+		Use:   "conflicts [devContainer]",
+		Short: "List and resolve the file synchronization conflicts of a Development Container",
+		Args:  utils.MaximumNArgsAccepted(1, "https://okteto.com/docs/reference/okteto-cli/#sync"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if keep != "" && keep != keepLocal && keep != keepRemote {
+				return fmt.Errorf("invalid value '%s' for --keep, must be '%s' or '%s'", keep, keepLocal, keepRemote)
+			}
+
+			fs := afero.NewOsFs()
+			if err := validator.FileArgumentIsNotDir(fs, devPath); err != nil {
+				return err
+			}
+			manifest, err := model.GetManifestV2(devPath, fs)
+			if err != nil {
+				return err
+			}
+
+			devName := ""
+			if len(args) == 1 {
+				devName = args[0]
+			}
+			dev, err := utils.GetDevFromManifest(manifest, devName)
+			if err != nil {
+				if !errors.Is(err, utils.ErrNoDevSelected) {
+					return err
+				}
+				selector := utils.NewOktetoSelector("Select the development container:", "Development container")
+				dev, err = utils.SelectDevFromManifest(manifest, selector, manifest.Dev.GetDevs())
+				if err != nil {
+					return err
+				}
+			}
+
+			folders := []string{}
+			for _, f := range dev.Sync.Folders {
+				folders = append(folders, f.LocalPath)
+			}
+			conflicts, err := syncthing.FindConflicts(folders)
+			if err != nil {
+				return err
+			}
+
+			if len(conflicts) == 0 {
+				oktetoLog.Success("No synchronization conflicts found for '%s'", dev.Name)
+				if !dev.Sync.KeepConflicts {
+					oktetoLog.Information("Conflicts are only kept when 'sync.keepConflicts' is enabled in your manifest")
+				}
+				return nil
+			}
+
+			if keep != "" {
+				return resolveAll(conflicts, keep)
+			}
+
+			printConflicts(os.Stdout, conflicts)
+			if !oktetoLog.IsInteractive() {
+				oktetoLog.Information("Run 'okteto sync conflicts --keep local|remote' to resolve them")
+				return nil
+			}
+			return resolveInteractive(conflicts, askResolution)
+		},
+	}
+
+	cmd.Flags().StringVarP(&devPath, "file", "f", "", "the path to the Okteto manifest")
+	cmd.Flags().StringVarP(&keep, "keep", "", "", "resolve all the conflicts keeping the 'local' or the 'remote' version of the files")
+	return cmd
+}
+
+func printConflicts(out io.Writer, conflicts []syncthing.Conflict) {
+	w := tabwriter.NewWriter(out, 1, 1, 2, ' ', 0)
+	fmt.Fprintf(w, "File\tConflict copy\tCopy version\tDetected\n")
+	for _, c := range conflicts {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.RelativePath(), c.CopyPath, copyVersion(c), c.Time.Format("2006-01-02 15:04:05"))
+	}
+	w.Flush()
+}
+
+func copyVersion(c syncthing.Conflict) string {
+	if c.LocalInCopy() {
+		return keepLocal
+	}
+	return keepRemote
+}
+
+func resolve(c syncthing.Conflict, keep string) error {
+	var err error
+	if keep == keepLocal {
+		err = c.KeepLocal()
+	} else {
+		err = c.KeepRemote()
+	}
+	if err != nil {
+		return err
+	}
+	oktetoLog.Success("Kept the %s version of '%s'", keep, c.RelativePath())
+	return nil
+}
+
+func resolveAll(conflicts []syncthing.Conflict, keep string) error {
+	for _, c := range conflicts {
+		if err := resolve(c, keep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveInteractive asks how to resolve every conflict until it is resolved or skipped
+func resolveInteractive(conflicts []syncthing.Conflict, ask func(syncthing.Conflict) (string, error)) error {
+	for _, c := range conflicts {
+		for {
+			option, err := ask(c)
+			if err != nil {
+				return err
+			}
+			if option == skip {
+				break
+			}
+			if option == showDiff {
+				if err := diff(c); err != nil {
+					oktetoLog.Warning("Failed to show the differences of '%s': %s", c.RelativePath(), err)
+				}
+				continue
+			}
+			if err := resolve(c, option); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func askResolution(c syncthing.Conflict) (string, error) {
+	selector := utils.NewOktetoSelector(fmt.Sprintf("How do you want to resolve the conflict on '%s'?", c.RelativePath()), "Resolution")
+	return selector.AskForOptionsOkteto([]utils.SelectorItem{
+		{Name: keepLocal, Label: "Keep the local version", Enable: true},
+		{Name: keepRemote, Label: "Keep the version of the development container", Enable: true},
+		{Name: showDiff, Label: "Show the differences", Enable: true},
+		{Name: skip, Label: "Skip", Enable: true},
+	}, 0)
+}
+
+// diff shows the differences between the local and the remote version of the file
+func diff(c syncthing.Conflict) error {
+	local, remote := c.Path, c.CopyPath
+	if c.LocalInCopy() {
+		local, remote = remote, local
+	}
+
+	var cmd *exec.Cmd
+	if _, err := exec.LookPath("git"); err == nil {
+		cmd = exec.Command("git", "diff", "--no-index", "--", remote, local)
+	} else {
+		cmd = exec.Command("diff", "-u", remote, local)
+	}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err := cmd.Run()
+
+	// both commands exit with 1 when the files are different
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sync
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/syncthing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createConflicts(t *testing.T) (string, []syncthing.Conflict) {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"main.go": "remote",
+		"main.sync-conflict-20260102-150405-ABKAVQF.go": "local",
+		"app.py": "local",
+		"app.sync-conflict-20260102-150405-ATOPHFJ.py": "remote",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+	conflicts, err := syncthing.FindConflicts([]string{dir})
+	require.NoError(t, err)
+	require.Len(t, conflicts, 2)
+	return dir, conflicts
+}
+
+func TestPrintConflicts(t *testing.T) {
+	_, conflicts := createConflicts(t)
+	out := &bytes.Buffer{}
+	printConflicts(out, conflicts)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "File"))
+	assert.True(t, strings.HasPrefix(lines[1], "app.py"))
+	assert.Contains(t, lines[1], keepRemote)
+	assert.True(t, strings.HasPrefix(lines[2], "main.go"))
+	assert.Contains(t, lines[2], keepLocal)
+}
+
+func TestResolveAll(t *testing.T) {
+	dir, conflicts := createConflicts(t)
+	require.NoError(t, resolveAll(conflicts, keepRemote))
+
+	for name, expected := range map[string]string{"main.go": "remote", "app.py": "remote"} {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(b))
+	}
+	remaining, err := syncthing.FindConflicts([]string{dir})
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+}
+
+func TestResolveInteractive(t *testing.T) {
+	dir, conflicts := createConflicts(t)
+	answers := []string{keepLocal, skip}
+	asked := 0
+	err := resolveInteractive(conflicts, func(c syncthing.Conflict) (string, error) {
+		answer := answers[asked]
+		asked++
+		return answer, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, asked)
+
+	b, err := os.ReadFile(filepath.Join(dir, "app.py"))
+	require.NoError(t, err)
+	assert.Equal(t, "local", string(b))
+
+	remaining, err := syncthing.FindConflicts([]string{dir})
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "main.go", remaining[0].RelativePath())
+}
@@ -0,0 +1,425 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	stdio "io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/moby/patternmatcher"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/format"
+	"github.com/okteto/okteto/pkg/k8s/configmaps"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/spf13/afero"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// testCacheConfigmapPrefix is the prefix of the configmaps storing the last result of a test container that passed
+	testCacheConfigmapPrefix = "okteto-test-"
+
+	// testCacheLabel identifies the configmaps storing test results
+	testCacheLabel = "dev.okteto.com/test-cache"
+
+	testCacheHashField      = "hash"
+	testCachePassedAtField  = "passedAt"
+	testCacheArtifactsField = "artifacts.tar.gz"
+
+	// maxCachedArtifactsSize is the maximum size of the artifacts stored with a result, as configmaps are limited to 1MB
+	maxCachedArtifactsSize = 900 * 1024
+)
+
+// errArtifactsTooLarge is returned when the artifacts of a test container don't fit in the cache
+var errArtifactsTooLarge = errors.New("artifacts are too large to be cached")
+
+// cachedTestResult is the result of a test container that passed
+type cachedTestResult struct {
+	passedAt  time.Time
+	hash      string
+	artifacts []byte
+}
+
+// testResultStore stores the last result of each test container that passed
+type testResultStore interface {
+	Get(ctx context.Context, test string) (*cachedTestResult, error)
+	Put(ctx context.Context, test string, result *cachedTestResult) error
+}
+
+// configmapTestResultStore stores the results of the test containers in configmaps of the namespace
+type configmapTestResultStore struct {
+	c         kubernetes.Interface
+	namespace string
+	devenv    string
+}
+
+func (s *configmapTestResultStore) name(test string) string {
+	return format.ResourceK8sMetaString(fmt.Sprintf("%s%s-%s", testCacheConfigmapPrefix, s.devenv, test))
+}
+
+// Get returns the last result of the test container that passed, or nil if there is none
+func (s *configmapTestResultStore) Get(ctx context.Context, test string) (*cachedTestResult, error) {
+	cmap, err := configmaps.Get(ctx, s.name(test), s.namespace, s.c)
+	if err != nil {
+		if oktetoErrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	result := &cachedTestResult{
+		hash:      cmap.Data[testCacheHashField],
+		artifacts: cmap.BinaryData[testCacheArtifactsField],
+	}
+	if passedAt, err := time.Parse(time.RFC3339, cmap.Data[testCachePassedAtField]); err == nil {
+		result.passedAt = passedAt
+	}
+	return result, nil
+}
+
+// Put replaces the last result of the test container that passed. The configmap has the deployed-by
+// label of the dev environment, so 'okteto destroy' deletes it
+func (s *configmapTestResultStore) Put(ctx context.Context, test string, result *cachedTestResult) error {
+	cmap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.name(test),
+			Namespace: s.namespace,
+			Labels: map[string]string{
+				testCacheLabel:        "true",
+				model.DeployedByLabel: format.ResourceK8sMetaString(s.devenv),
+			},
+		},
+		Data: map[string]string{
+			testCacheHashField:     result.hash,
+			testCachePassedAtField: result.passedAt.UTC().Format(time.RFC3339),
+		},
+	}
+	if len(result.artifacts) > 0 {
+		cmap.BinaryData = map[string][]byte{testCacheArtifactsField: result.artifacts}
+	}
+	return configmaps.Deploy(ctx, cmap, s.namespace, s.c)
+}
+
+// imageDigestGetter returns the image with its digest
+type imageDigestGetter interface {
+	GetImageTagWithDigest(image string) (string, error)
+}
+
+// testResultCache skips the test containers whose inputs didn't change since the last time they
+// passed, restoring the artifacts they exported
+type testResultCache struct {
+	store    testResultStore
+	registry imageDigestGetter
+	fs       afero.Fs
+	ioCtrl   *io.Controller
+}
+
+// hash returns the hash of the inputs of the test container
+func (c *testResultCache) hash(input testHashInput) (string, error) {
+	if input.test.Image != "" {
+		digest, err := c.registry.GetImageTagWithDigest(input.test.Image)
+		if err != nil {
+			return "", fmt.Errorf("failed to get the digest of image '%s': %w", input.test.Image, err)
+		}
+		input.imageDigest = digest
+	}
+	return hashTest(c.fs, input)
+}
+
+// restore returns true when the test container already passed with the same hash. Its artifacts are
+// restored into contextDir
+func (c *testResultCache) restore(ctx context.Context, name, hash, contextDir string) bool {
+	result, err := c.store.Get(ctx, name)
+	if err != nil {
+		c.ioCtrl.Logger().Infof("failed to get the cached result of test container '%s': %s", name, err)
+		return false
+	}
+	if result == nil || result.hash != hash {
+		return false
+	}
+	if err := restoreArtifacts(c.fs, contextDir, result.artifacts); err != nil {
+		c.ioCtrl.Logger().Infof("failed to restore the artifacts of test container '%s': %s", name, err)
+		return false
+	}
+	return true
+}
+
+// save stores that the test container passed with the given hash, along with its artifacts
+func (c *testResultCache) save(ctx context.Context, name, hash, contextDir string, artifacts []model.Artifact) {
+	archive, err := archiveArtifacts(c.fs, contextDir, artifacts)
+	if err != nil {
+		c.ioCtrl.Logger().Infof("result of test container '%s' not cached: %s", name, err)
+		return
+	}
+	result := &cachedTestResult{
+		hash:      hash,
+		passedAt:  time.Now(),
+		artifacts: archive,
+	}
+	if err := c.store.Put(ctx, name, result); err != nil {
+		c.ioCtrl.Logger().Infof("failed to cache the result of test container '%s': %s", name, err)
+	}
+}
+
+// testHashInput is everything that determines the result of a test container
+type testHashInput struct {
+	test *model.Test
+	// contextDir is the absolute path of the context of the test container
+	contextDir string
+	// imageDigest is the image of the test container with its digest
+	imageDigest string
+	// ignoreRules are the rules of the .oktetoignore file that apply to the test container
+	ignoreRules []string
+	// outputs are the absolute paths written by okteto test into the context (artifacts and reports).
+	// They are not part of the hash, as they change with every execution
+	outputs []string
+	// variables are the variables and env vars injected into the test container
+	variables []string
+}
+
+// hashTest returns a hash of the content of the test context, the image, the commands and the variables
+// of a test container, so a test container with the same hash is expected to have the same result
+func hashTest(fs afero.Fs, input testHashInput) (string, error) {
+	contextHash, err := hashTestContext(fs, input.contextDir, input.ignoreRules, input.outputs)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash the test context: %w", err)
+	}
+
+	commands := make([]string, 0, len(input.test.Commands))
+	for _, cmd := range input.test.Commands {
+		commands = append(commands, fmt.Sprintf("%s=%s", cmd.Name, cmd.Command))
+	}
+	artifacts := make([]string, 0, len(input.test.Artifacts))
+	for _, artifact := range input.test.Artifacts {
+		artifacts = append(artifacts, fmt.Sprintf("%s=%s", artifact.Path, artifact.Destination))
+	}
+	hosts := make([]string, 0, len(input.test.Hosts))
+	for _, host := range input.test.Hosts {
+		hosts = append(hosts, fmt.Sprintf("%s=%s", host.Hostname, host.IP))
+	}
+	services := make([]string, 0, len(input.test.Services))
+	for name, svc := range input.test.Services {
+		env := make([]string, 0, len(svc.Environment))
+		for _, v := range svc.Environment {
+			env = append(env, fmt.Sprintf("%s=%s", v.Name, v.Value))
+		}
+		services = append(services, fmt.Sprintf("%s=%s,%s", name, svc.Image, strings.Join(env, ",")))
+	}
+	sort.Strings(services)
+	variables := append([]string{}, input.variables...)
+	sort.Strings(variables)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "context:%s;", contextHash)
+	fmt.Fprintf(&b, "image:%s;", input.imageDigest)
+	fmt.Fprintf(&b, "commands:%s;", strings.Join(commands, ";"))
+	fmt.Fprintf(&b, "artifacts:%s;", strings.Join(artifacts, ";"))
+	fmt.Fprintf(&b, "hosts:%s;", strings.Join(hosts, ";"))
+	fmt.Fprintf(&b, "shards:%d;", input.test.Shards)
+	fmt.Fprintf(&b, "services:%s;", strings.Join(services, ";"))
+	fmt.Fprintf(&b, "variables:%s;", strings.Join(variables, ";"))
+
+	hash := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// hashTestContext returns a hash of the paths and contents of the files of the test context that
+// are not ignored. The .git folder and the outputs of okteto test are not part of the hash, as they
+// change with every commit or execution
+func hashTestContext(fs afero.Fs, contextDir string, ignoreRules, outputs []string) (string, error) {
+	pm, err := patternmatcher.New(ignoreRules)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	err = afero.Walk(fs, contextDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(contextDir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if info.IsDir() && rel == ".git" {
+			return filepath.SkipDir
+		}
+		for _, output := range outputs {
+			if isSubPath(output, path) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+		ignored, err := pm.MatchesOrParentMatches(rel)
+		if err != nil {
+			return err
+		}
+		if ignored {
+			if info.IsDir() && !pm.Exclusions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			fmt.Fprintf(h, "%s:%s;", rel, info.Mode().Type())
+			return nil
+		}
+		f, err := fs.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		fmt.Fprintf(h, "%s:%s:", rel, info.Mode().Perm())
+		if _, err := stdio.Copy(h, f); err != nil {
+			return err
+		}
+		fmt.Fprint(h, ";")
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// testOutputs returns the absolute paths written by okteto test into the context of a test container:
+// the destinations of its artifacts and the reports
+func testOutputs(test *model.Test, contextDir string, reports []reportTarget) []string {
+	outputs := make([]string, 0, len(reports)+len(test.Artifacts))
+	for _, report := range reports {
+		outputs = append(outputs, report.path)
+	}
+	for _, artifact := range shardArtifacts(test.Artifacts, test.Shards) {
+		outputs = append(outputs, filepath.Join(contextDir, artifact.Destination))
+	}
+	return outputs
+}
+
+// archiveArtifacts returns a tar.gz with the artifacts exported into contextDir. Artifacts that
+// don't exist are skipped, as the test container might not generate them
+func archiveArtifacts(fs afero.Fs, contextDir string, artifacts []model.Artifact) ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, artifact := range artifacts {
+		root := filepath.Join(contextDir, artifact.Destination)
+		if _, err := fs.Stat(root); err != nil {
+			continue
+		}
+		err := afero.Walk(fs, root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+			rel, err := filepath.Rel(contextDir, path)
+			if err != nil {
+				return err
+			}
+			hdr := &tar.Header{
+				Name:    filepath.ToSlash(rel),
+				Mode:    int64(info.Mode().Perm()),
+				Size:    info.Size(),
+				ModTime: info.ModTime(),
+			}
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			f, err := fs.Open(path)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, err = stdio.Copy(tw, f)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() > maxCachedArtifactsSize {
+			return nil, errArtifactsTooLarge
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	if buf.Len() > maxCachedArtifactsSize {
+		return nil, errArtifactsTooLarge
+	}
+	return buf.Bytes(), nil
+}
+
+// restoreArtifacts extracts into contextDir the artifacts archived by archiveArtifacts
+func restoreArtifacts(fs afero.Fs, contextDir string, archive []byte) error {
+	if len(archive) == 0 {
+		return nil
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, stdio.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(contextDir, filepath.FromSlash(hdr.Name))
+		if !strings.HasPrefix(target, filepath.Clean(contextDir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid artifact path '%s'", hdr.Name)
+		}
+		if err := fs.MkdirAll(filepath.Dir(target), 0700); err != nil {
+			return err
+		}
+		f, err := fs.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode).Perm())
+		if err != nil {
+			return err
+		}
+		if _, err := stdio.Copy(f, tr); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type fakeDigestGetter struct {
+	digests map[string]string
+}
+
+func (f fakeDigestGetter) GetImageTagWithDigest(image string) (string, error) {
+	digest, ok := f.digests[image]
+	if !ok {
+		return "", errors.New("not found")
+	}
+	return digest, nil
+}
+
+func newFakeTestContext(t *testing.T) afero.Fs {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/app/main.go", []byte("package main"), 0600))
+	require.NoError(t, afero.WriteFile(fs, "/app/node_modules/lib.js", []byte("lib"), 0600))
+	require.NoError(t, afero.WriteFile(fs, "/app/.git/HEAD", []byte("ref"), 0600))
+	return fs
+}
+
+func TestHashTest(t *testing.T) {
+	fs := newFakeTestContext(t)
+	input := testHashInput{
+		test:        &model.Test{Commands: []model.TestCommand{{Name: "unit", Command: "go test"}}},
+		contextDir:  "/app",
+		imageDigest: "golang@sha256:1",
+		ignoreRules: []string{"node_modules"},
+		variables:   []string{"B=2", "A=1"},
+	}
+	hash, err := hashTest(fs, input)
+	require.NoError(t, err)
+
+	// ignored files, the .git folder and the order of the variables don't change the hash
+	require.NoError(t, afero.WriteFile(fs, "/app/node_modules/lib.js", []byte("lib v2"), 0600))
+	require.NoError(t, afero.WriteFile(fs, "/app/.git/HEAD", []byte("another ref"), 0600))
+	input.variables = []string{"A=1", "B=2"}
+	same, err := hashTest(fs, input)
+	require.NoError(t, err)
+	assert.Equal(t, hash, same)
+
+	require.NoError(t, afero.WriteFile(fs, "/app/main.go", []byte("package main // changed"), 0600))
+	changedContext, err := hashTest(fs, input)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changedContext)
+
+	input.imageDigest = "golang@sha256:2"
+	changedImage, err := hashTest(fs, input)
+	require.NoError(t, err)
+	assert.NotEqual(t, changedContext, changedImage)
+
+	input.test = &model.Test{
+		Commands: input.test.Commands,
+		Services: map[string]*model.TestService{"db": {Image: "postgres:16"}},
+	}
+	changedServices, err := hashTest(fs, input)
+	require.NoError(t, err)
+	assert.NotEqual(t, changedImage, changedServices)
+}
+
+func TestTestResultCacheHitWithArtifacts(t *testing.T) {
+	ctx := context.Background()
+	fs := newFakeTestContext(t)
+	cache := &testResultCache{
+		store:    &configmapTestResultStore{c: fake.NewSimpleClientset(), namespace: "ns", devenv: "app"},
+		registry: fakeDigestGetter{},
+		fs:       fs,
+		ioCtrl:   io.NewIOController(),
+	}
+	test := &model.Test{
+		Commands:  []model.TestCommand{{Name: "unit", Command: "go test"}},
+		Artifacts: []model.Artifact{{Path: "reports", Destination: "reports"}},
+	}
+	input := testHashInput{
+		test:       test,
+		contextDir: "/app",
+		outputs:    testOutputs(test, "/app", []reportTarget{{format: "junit", path: "/app/okteto-report.xml"}}),
+	}
+
+	// first run: the test container exports its artifacts and okteto test writes the report into the context
+	firstHash, err := cache.hash(input)
+	require.NoError(t, err)
+	assert.False(t, cache.restore(ctx, "unit", firstHash, "/app"))
+	require.NoError(t, afero.WriteFile(fs, "/app/reports/junit.xml", []byte("<testsuite/>"), 0600))
+	require.NoError(t, afero.WriteFile(fs, "/app/okteto-report.xml", []byte("<testsuites/>"), 0600))
+	cache.save(ctx, "unit", firstHash, "/app", test.Artifacts)
+
+	// second run: the outputs of the first run don't change the hash
+	secondHash, err := cache.hash(input)
+	require.NoError(t, err)
+	assert.Equal(t, firstHash, secondHash)
+	assert.True(t, cache.restore(ctx, "unit", secondHash, "/app"))
+
+	// third run: the restored artifacts don't change the hash either
+	thirdHash, err := cache.hash(input)
+	require.NoError(t, err)
+	assert.True(t, cache.restore(ctx, "unit", thirdHash, "/app"))
+}
+
+func TestConfigmapTestResultStoreLabels(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewSimpleClientset()
+	store := &configmapTestResultStore{c: c, namespace: "ns", devenv: "My App"}
+	require.NoError(t, store.Put(ctx, "unit", &cachedTestResult{hash: "hash", passedAt: time.Now()}))
+
+	cmap, err := c.CoreV1().ConfigMaps("ns").Get(ctx, store.name("unit"), metav1.GetOptions{})
+	require.NoError(t, err)
+	// the deployed-by label of the dev environment makes 'okteto destroy' delete the cached results
+	assert.Equal(t, "my-app", cmap.Labels[model.DeployedByLabel])
+	assert.Equal(t, "true", cmap.Labels[testCacheLabel])
+}
+
+func TestArchiveAndRestoreArtifacts(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/app/reports/junit.xml", []byte("<testsuite/>"), 0600))
+	require.NoError(t, afero.WriteFile(fs, "/app/coverage.out", []byte("mode: set"), 0600))
+
+	artifacts := []model.Artifact{
+		{Path: "reports", Destination: "reports"},
+		{Path: "coverage.out", Destination: "coverage.out"},
+		{Path: "missing", Destination: "missing"},
+	}
+	archive, err := archiveArtifacts(fs, "/app", artifacts)
+	require.NoError(t, err)
+
+	restored := afero.NewMemMapFs()
+	require.NoError(t, restoreArtifacts(restored, "/app", archive))
+	content, err := afero.ReadFile(restored, "/app/reports/junit.xml")
+	require.NoError(t, err)
+	assert.Equal(t, "<testsuite/>", string(content))
+	content, err = afero.ReadFile(restored, "/app/coverage.out")
+	require.NoError(t, err)
+	assert.Equal(t, "mode: set", string(content))
+}
+
+func TestTestResultCache(t *testing.T) {
+	ctx := context.Background()
+	fs := newFakeTestContext(t)
+	require.NoError(t, afero.WriteFile(fs, "/app/reports/junit.xml", []byte("<testsuite/>"), 0600))
+
+	cache := &testResultCache{
+		store:    &configmapTestResultStore{c: fake.NewSimpleClientset(), namespace: "ns", devenv: "app"},
+		registry: fakeDigestGetter{digests: map[string]string{"golang": "golang@sha256:1"}},
+		fs:       fs,
+		ioCtrl:   io.NewIOController(),
+	}
+	test := &model.Test{
+		Image:     "golang",
+		Commands:  []model.TestCommand{{Name: "unit", Command: "go test"}},
+		Artifacts: []model.Artifact{{Path: "reports", Destination: "reports"}},
+	}
+
+	hash, err := cache.hash(testHashInput{test: test, contextDir: "/app"})
+	require.NoError(t, err)
+	assert.False(t, cache.restore(ctx, "unit", hash, "/app"))
+
+	cache.save(ctx, "unit", hash, "/app", test.Artifacts)
+	require.NoError(t, fs.RemoveAll("/app/reports"))
+
+	assert.False(t, cache.restore(ctx, "unit", "another-hash", "/app"))
+	assert.True(t, cache.restore(ctx, "unit", hash, "/app"))
+	content, err := afero.ReadFile(fs, "/app/reports/junit.xml")
+	require.NoError(t, err)
+	assert.Equal(t, "<testsuite/>", string(content))
+
+	// images without digest can't be cached
+	test.Image = "unknown"
+	_, err = cache.hash(testHashInput{test: test, contextDir: "/app"})
+	assert.Error(t, err)
+}
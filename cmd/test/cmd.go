@@ -20,6 +20,8 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"strings"
+	"sync"
 	"time"
 
 	buildv2 "github.com/okteto/okteto/cmd/build/v2"
@@ -31,6 +33,7 @@ import (
 	"github.com/okteto/okteto/pkg/analytics"
 	"github.com/okteto/okteto/pkg/build/buildkit"
 	buildCMD "github.com/okteto/okteto/pkg/cmd/build"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
 	"github.com/okteto/okteto/pkg/config"
 	"github.com/okteto/okteto/pkg/constants"
 	"github.com/okteto/okteto/pkg/dag"
@@ -46,12 +49,14 @@ import (
 	"github.com/okteto/okteto/pkg/model"
 	"github.com/okteto/okteto/pkg/okteto"
 	oktetoPath "github.com/okteto/okteto/pkg/path"
+	"github.com/okteto/okteto/pkg/registry"
 	"github.com/okteto/okteto/pkg/remote"
 	"github.com/okteto/okteto/pkg/repository"
 	"github.com/okteto/okteto/pkg/types"
 	"github.com/okteto/okteto/pkg/validator"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -62,9 +67,20 @@ type Options struct {
 	K8sContext       string
 	Name             string
 	Variables        []string
+	Reports          []string
 	Timeout          time.Duration
+	Parallel         int
 	Deploy           bool
 	NoCache          bool
+	FailFast         bool
+	Watch            bool
+	Local            bool
+
+	// reportTargets are the reports parsed from Reports
+	reportTargets []reportTarget
+
+	// runID identifies the services of the test containers started by this execution
+	runID string
 }
 
 type builder interface {
@@ -88,6 +104,13 @@ func Test(ctx context.Context, ioCtrl *io.Controller, k8sLogger *io.K8sLogger, a
 				return err
 			}
 
+			reportTargets, err := parseReportTargets(options.Reports)
+			if err != nil {
+				return err
+			}
+			options.reportTargets = reportTargets
+			options.runID = utilrand.String(testServiceRunIDLength)
+
 			stop := make(chan os.Signal, 1)
 			signal.Notify(stop, os.Interrupt)
 			exit := make(chan error, 1)
@@ -116,6 +139,7 @@ func Test(ctx context.Context, ioCtrl *io.Controller, k8sLogger *io.K8sLogger, a
 				oktetoLog.Spinner("Shutting down...")
 				oktetoLog.StartSpinner()
 				defer oktetoLog.StopSpinner()
+				cleanupTestServices(options.runID, ioCtrl)
 				return oktetoErrors.ErrIntSig
 			case err := <-exit:
 				return err
@@ -131,7 +155,13 @@ func Test(ctx context.Context, ioCtrl *io.Controller, k8sLogger *io.K8sLogger, a
 	cmd.Flags().DurationVarP(&options.Timeout, "timeout", "t", getDefaultTimeout(), "the duration to wait for the Test Container to run. Any value should contain a corresponding time unit e.g. 1s, 2m, 3h")
 	cmd.Flags().StringVar(&options.Name, "name", "", "the name of the Development Environment")
 	cmd.Flags().BoolVar(&options.Deploy, "deploy", false, "Force execution of the commands in the 'deploy' section")
-	cmd.Flags().BoolVar(&options.NoCache, "no-cache", false, "by default, the caches of a Test Container are reused between executions")
+	cmd.Flags().BoolVar(&options.NoCache, "no-cache", false, "by default, the caches of a Test Container and the results of the ones with 'cacheResults' or 'skipIfNoFileChanges' are reused between executions")
+	cmd.Flags().IntVar(&options.Parallel, "parallel", 1, "maximum number of test containers to run at the same time. Test containers run once all their dependencies pass")
+	cmd.Flags().BoolVar(&options.FailFast, "fail-fast", true, "stop running test containers as soon as one of them fails")
+	cmd.Flags().BoolVar(&options.Local, "local", false, "run the commands of the test containers in the local machine instead of using Remote Execution")
+	cmd.MarkFlagsMutuallyExclusive("local", "parallel")
+	cmd.Flags().BoolVar(&options.Watch, "watch", false, "keep running and re-run the test containers affected by the changes on their contexts, and the ones depending on them. The Okteto Manifest and the images are loaded once and the services of the test containers keep running between changes, so restart okteto test to apply changes to the manifest or to the build context of the test images")
+	cmd.Flags().StringArrayVar(&options.Reports, "report", []string{}, "generate a report of the test containers with the form <format>=<path>. Supported formats are 'junit' and 'json' (can be set more than once)")
 
 	return cmd
 }
@@ -148,21 +178,24 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
 		return analytics.TestMetadata{}, err
 	}
 
-	if !okteto.IsOkteto() {
+	// the commands run locally only need access to the cluster of the context
+	if !options.Local && !okteto.IsOkteto() {
 		return analytics.TestMetadata{}, oktetoErrors.ErrContextIsNotOktetoCluster
 	}
 
-	create, err := utils.ShouldCreateNamespace(ctx, okteto.GetContext().Namespace)
-	if err != nil {
-		return analytics.TestMetadata{}, err
-	}
-	if create {
-		nsCmd, err := namespace.NewCommand(ioCtrl)
+	if okteto.IsOkteto() {
+		create, err := utils.ShouldCreateNamespace(ctx, okteto.GetContext().Namespace)
 		if err != nil {
 			return analytics.TestMetadata{}, err
 		}
-		if err := nsCmd.Create(ctx, &namespace.CreateOptions{Namespace: okteto.GetContext().Namespace}); err != nil {
-			return analytics.TestMetadata{}, err
+		if create {
+			nsCmd, err := namespace.NewCommand(ioCtrl)
+			if err != nil {
+				return analytics.TestMetadata{}, err
+			}
+			if err := nsCmd.Create(ctx, &namespace.CreateOptions{Namespace: okteto.GetContext().Namespace}); err != nil {
+				return analytics.TestMetadata{}, err
+			}
 		}
 	}
 
@@ -254,9 +287,24 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
 
 	testServices := tree.Ordered()
 
-	wasBuilt, err := doBuild(ctx, manifest, testServices, builder, ioCtrl)
-	if err != nil {
-		return analytics.TestMetadata{}, fmt.Errorf("okteto test needs to build the images defined but failed: %w", err)
+	if options.Local {
+		for _, name := range testServices {
+			if len(manifest.Test[name].Services) > 0 {
+				return analytics.TestMetadata{}, oktetoErrors.UserError{
+					E:    fmt.Errorf("test container '%s' defines services, which are not supported with --local", name),
+					Hint: "Run the test container without --local to start its services in the namespace",
+				}
+			}
+		}
+	}
+
+	// the images of the test containers are only needed to run them in Remote Execution
+	wasBuilt := false
+	if !options.Local {
+		wasBuilt, err = doBuild(ctx, manifest, testServices, builder, ioCtrl)
+		if err != nil {
+			return analytics.TestMetadata{}, fmt.Errorf("okteto test needs to build the images defined but failed: %w", err)
+		}
 	}
 
 	if options.Deploy && manifest.Deploy == nil {
@@ -319,6 +367,14 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
 		}
 	}
 
+	// the outputs of the deploy commands are available as variables in the test containers
+	outputs, err := configmapHandler.GetOutputs(ctx, manifest.Name, okteto.GetContext().Namespace)
+	if err != nil {
+		ioCtrl.Logger().Infof("could not get the outputs of '%s': %s", manifest.Name, err)
+	}
+	pipeline.MaskSensitiveOutputs(outputs)
+	options.Variables = append(options.Variables, pipeline.OutputsToVariables(outputs, options.Variables)...)
+
 	metadata := analytics.TestMetadata{
 		StagesCount: len(testServices),
 		Deployed:    options.Deploy,
@@ -326,6 +382,7 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
 	}
 
 	testAnalytics := make([]*analytics.SingleTestMetadata, 0)
+	var analyticsMu sync.Mutex
 
 	// send all events appended on each test
 
@@ -336,26 +393,60 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
 		}
 	}(testAnalytics)
 
-	for _, name := range testServices {
+	var resultCache *testResultCache
+	var servicesRunner *testServicesRunner
+	c, _, k8sErr := k8sClientProvider.Provide(okteto.GetContext().Cfg)
+	if k8sErr != nil {
+		ioCtrl.Logger().Infof("test results won't be cached: %s", k8sErr)
+	} else {
+		servicesRunner = newTestServicesRunner(c, okteto.GetContext().Namespace, manifest.Name, options.runID)
+		resultCache = &testResultCache{
+			store: &configmapTestResultStore{
+				c:         c,
+				namespace: okteto.GetContext().Namespace,
+				devenv:    manifest.Name,
+			},
+			registry: registry.NewOktetoRegistry(okteto.Config{}),
+			fs:       fs,
+			ioCtrl:   ioCtrl,
+		}
+	}
+
+	runners := newReusableRunners(func(runnerIO *io.Controller, concurrent bool) paramsRunner {
+		if options.Local {
+			return newLocalTestRunner(fs, options.Variables, runnerIO)
+		}
+		testBuilder := buildCMD.NewOktetoBuilder(
+			okCtxForBuilder,
+			fs,
+			runnerIO,
+			conn,
+		)
+		if concurrent {
+			// concurrent test containers can't share the connection to buildkit
+			testBuilder = testBuilder.Fork(runnerIO)
+		}
+		return remote.NewRunner(runnerIO, testBuilder)
+	})
+
+	runTestContainer := func(ctx context.Context, name string, testIO *io.Controller) error {
 		test := manifest.Test[name]
 
 		ctxCwd := path.Clean(path.Join(cwd, test.Context))
 
 		commandFlags, err := deployCMD.GetCommandFlags(name, options.Variables)
 		if err != nil {
-			return metadata, err
+			return err
 		}
 
-		runner := remote.NewRunner(ioCtrl, buildCMD.NewOktetoBuilder(
-			okCtxForBuilder,
-			fs,
-			ioCtrl,
-			conn,
-		))
+		newRunner := func(runnerIO *io.Controller, concurrent bool) paramsRunner {
+			// the prefix of the output identifies the shard of the test container
+			return runners.get(name+runnerIO.Prefix(), runnerIO, concurrent)
+		}
 		commands := make([]model.DeployCommand, len(test.Commands))
 
 		for i, cmd := range test.Commands {
-			commands[i] = model.DeployCommand(cmd)
+			commands[i] = model.DeployCommand{Name: cmd.Name, Command: cmd.Command}
 		}
 
 		ig := ignore.NewOktetoIgnorer(path.Join(ctxCwd, model.IgnoreFilename))
@@ -363,7 +454,7 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
 		// Read "test" and "test.{name}" sections from the .oktetoignore file
 		testIgnoreRules, err := ig.Rules(ignore.RootSection, "test", fmt.Sprintf("test.%s", name))
 		if err != nil {
-			return analytics.TestMetadata{}, fmt.Errorf("failed to create ignore rules for %s: %w", name, err)
+			return fmt.Errorf("failed to create ignore rules for %s: %w", name, err)
 		}
 		params := &remote.Params{
 			BaseImage:           test.Image,
@@ -404,7 +495,52 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
 			params.CacheInvalidationKey = "const"
 		}
 
-		ioCtrl.Out().Infof("Executing test container '%s'", name)
+		var testHash string
+		if (test.CacheResults || test.SkipIfNoFileChanges) && !options.NoCache && resultCache != nil {
+			variables := append([]string{}, options.Variables...)
+			for k, v := range params.BuildEnvVars {
+				variables = append(variables, fmt.Sprintf("%s=%s", k, v))
+			}
+			for k, v := range params.DependenciesEnvVars {
+				variables = append(variables, fmt.Sprintf("%s=%s", k, v))
+			}
+			testHash, err = resultCache.hash(testHashInput{
+				test:        test,
+				contextDir:  ctxCwd,
+				ignoreRules: testIgnoreRules,
+				outputs:     testOutputs(test, ctxCwd, options.reportTargets),
+				variables:   variables,
+			})
+			if err != nil {
+				testIO.Logger().Infof("test container '%s' can't be cached: %s", name, err)
+			} else if resultCache.restore(ctx, name, testHash, ctxCwd) {
+				testIO.Out().Success("Skipping test container '%s', CACHED", name)
+				return nil
+			}
+		}
+
+		if len(test.Services) > 0 {
+			if servicesRunner == nil {
+				return fmt.Errorf("failed to start the services of test container '%s': %w", name, k8sErr)
+			}
+			var hosts []model.Host
+			if options.Watch {
+				// the services are kept between the iterations of watch mode
+				hosts, err = servicesRunner.startOnce(ctx, name, test.Services, testIO)
+			} else {
+				var stopServices func()
+				hosts, stopServices, err = servicesRunner.start(ctx, name, test.Services, testIO)
+				if err == nil {
+					defer stopServices()
+				}
+			}
+			if err != nil {
+				return err
+			}
+			params.Hosts = append(append([]model.Host{}, test.Hosts...), hosts...)
+		}
+
+		testIO.Out().Infof("Executing test container '%s'", name)
 		testMetadata := analytics.SingleTestMetadata{
 			DevenvName: manifest.Name,
 			TestName:   name,
@@ -412,15 +548,22 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
 			Repository: repository.NewRepository(manifest.ManifestPath).GetAnonymizedRepo(),
 		}
 		testStartTime := time.Now()
-		err = runner.Run(ctx, params)
+		if test.Shards > 1 {
+			err = runShards(ctx, name, test.Shards, params, testIO, newRunner, options.Local)
+		} else {
+			err = newRunner(testIO, options.Parallel > 1).Run(ctx, params)
+		}
 		testMetadata.Duration = time.Since(testStartTime)
 		testMetadata.Success = err == nil
+		analyticsMu.Lock()
 		testAnalytics = append(testAnalytics, &testMetadata)
+		analyticsMu.Unlock()
 		if err != nil {
-			// If it is a commandErr, it means that there were an error on the tests itself, so we should return that error directly
+			// If it is a commandErr, it means that there were an error on the tests itself, so we should return that error directly.
+			// The same applies to the errors of the commands run locally
 			var cmdErr buildkit.CommandErr
-			if errors.As(err, &cmdErr) {
-				return metadata, err
+			if errors.As(err, &cmdErr) || options.Local {
+				return err
 			}
 
 			hint := `Please verify the specified image is accessible.
@@ -430,19 +573,119 @@ func doRun(ctx context.Context, servicesToTest []string, options *Options, ioCtr
     You can use --log-level flag to get additional output.`
 			}
 
-			ioCtrl.Logger().Infof("error executing test container: %v", err)
-			err = oktetoErrors.UserError{
+			testIO.Logger().Infof("error executing test container: %v", err)
+			return oktetoErrors.UserError{
 				E:    fmt.Errorf("error executing test container '%s'", name),
 				Hint: hint,
 			}
+		}
+		if testHash != "" {
+			resultCache.save(ctx, name, testHash, ctxCwd, shardArtifacts(test.Artifacts, test.Shards))
+		}
+		testIO.Out().Success("Test container '%s' passed", name)
+		return nil
+	}
+
+	scheduler := &testScheduler{
+		run:          runTestContainer,
+		ioCtrl:       ioCtrl,
+		tests:        manifest.Test,
+		retryBackoff: defaultTestRetryBackoff,
+		parallelism:  options.Parallel,
+		failFast:     options.FailFast,
+	}
+	// latestResults keeps the last result of each test container, so the report covers all of them in watch mode
+	latestResults := map[string]*testResult{}
+	runTests := func(ctx context.Context, names []string) error {
+		testsStartTime := time.Now()
+		results := scheduler.Run(ctx, names)
+		elapsed := time.Since(testsStartTime)
+		if options.Parallel > 1 || options.Watch {
+			printTestSummary(ioCtrl, results, elapsed)
+		}
+		for _, r := range results {
+			latestResults[r.name] = r
+		}
+
+		testsErr := testsError(results)
+		if len(options.reportTargets) > 0 {
+			report := &testReport{
+				fs:        fs,
+				name:      manifest.Name,
+				elapsed:   elapsed,
+				artifacts: map[string][]string{},
+			}
+			for _, name := range testServices {
+				if r, ok := latestResults[name]; ok {
+					report.results = append(report.results, r)
+				}
+				for _, artifact := range shardArtifacts(manifest.Test[name].Artifacts, manifest.Test[name].Shards) {
+					report.artifacts[name] = append(report.artifacts[name], path.Join(cwd, manifest.Test[name].Context, artifact.Destination))
+				}
+			}
+			if err := report.write(options.reportTargets); err != nil {
+				if testsErr != nil {
+					oktetoLog.Warning("%s", err.Error())
+				} else {
+					return err
+				}
+			}
+		}
+		return testsErr
+	}
+
+	err = runTests(ctx, testServices)
+	if options.Watch {
+		if err != nil {
+			ioCtrl.Out().Warning("%s", err.Error())
+		}
+		if servicesRunner != nil {
+			defer servicesRunner.stopAll()
+		}
+		watcher, err := newTestWatcher(manifest, tree, testServices, cwd, options, ioCtrl)
+		if err != nil {
 			return metadata, err
 		}
-		oktetoLog.Success("Test container '%s' passed", name)
+		err = watcher.Watch(ctx, func(ctx context.Context, tests []string) {
+			if err := runTests(ctx, tests); err != nil {
+				ioCtrl.Out().Warning("%s", err.Error())
+			}
+		})
+		return metadata, err
+	}
+
+	if err != nil {
+		return metadata, err
 	}
 	metadata.Success = true
 	return metadata, nil
 }
 
+// testsError returns the error of the failed test container, ignoring the ones allowed to fail.
+// When several test containers failed, the error lists all of them
+func testsError(results []*testResult) error {
+	var failed []*testResult
+	for _, r := range results {
+		if r.failed() {
+			failed = append(failed, r)
+		}
+	}
+	switch len(failed) {
+	case 0:
+		return nil
+	case 1:
+		return failed[0].err
+	}
+	names := make([]string, 0, len(failed))
+	for _, r := range failed {
+		names = append(names, fmt.Sprintf("'%s'", r.name))
+	}
+	return oktetoErrors.UserError{
+		E:    fmt.Errorf("test containers %s failed", strings.Join(names, ", ")),
+		Hint: "Check the output of each test container above for more details",
+	}
+}
+
 func doBuild(ctx context.Context, manifest *model.Manifest, svcs []string, builder builder, ioCtrl *io.Controller) (bool, error) {
 	// make sure the images used for the tests exist. If they don't build them
 	svcsToBuild := []string{}
@@ -0,0 +1,284 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	stdio "io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/deployable"
+	"github.com/okteto/okteto/pkg/format"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/okteto/okteto/pkg/remote"
+	"github.com/spf13/afero"
+)
+
+// testCommandsRunner runs the commands of a test container
+type testCommandsRunner interface {
+	RunTest(ctx context.Context, params deployable.TestParameters) error
+}
+
+// localTestRunner runs the commands of a test container in the local machine instead of using
+// Remote Execution, the same way okteto deploy runs the deploy commands when it isn't remote
+type localTestRunner struct {
+	kubeconfig deployable.KubeConfigHandler
+	fs         afero.Fs
+	newProxy   func() (deployable.ProxyInterface, error)
+	// newCommandsRunner returns the runner of the commands executed in dir until ctx is done
+	newCommandsRunner func(ctx context.Context, dir string) testCommandsRunner
+	getTempKubeconfig func(name string) string
+	namespace         string
+	subdomain         string
+	variables         []string
+}
+
+func newLocalTestRunner(fs afero.Fs, variables []string, ioCtrl *io.Controller) *localTestRunner {
+	kubeconfig := deployable.NewKubeConfig()
+	return &localTestRunner{
+		kubeconfig: kubeconfig,
+		fs:         fs,
+		newProxy: func() (deployable.ProxyInterface, error) {
+			return deployable.NewProxy(kubeconfig, model.GetAvailablePort)
+		},
+		newCommandsRunner: func(ctx context.Context, dir string) testCommandsRunner {
+			return &deployable.TestRunner{
+				Executor: &localCommandExecutor{ctx: ctx, ioCtrl: ioCtrl, dir: dir},
+				Fs:       fs,
+			}
+		},
+		getTempKubeconfig: deployable.GetTempKubeConfigFile,
+		namespace:         okteto.GetContext().Namespace,
+		subdomain:         okteto.GetSubdomain(),
+		variables:         variables,
+	}
+}
+
+// Run runs the commands of the test container from its context, with the kubeconfig pointing to
+// the local proxy, and copies its artifacts to their destinations
+func (r *localTestRunner) Run(ctx context.Context, params *remote.Params) error {
+	proxy, err := r.newProxy()
+	if err != nil {
+		return fmt.Errorf("could not configure local proxy: %w", err)
+	}
+
+	kubeconfigPath := r.getTempKubeconfig(params.TestName)
+	oktetoLog.Debugf("creating temporal kubeconfig file '%s'", kubeconfigPath)
+	if err := r.kubeconfig.Modify(proxy.GetPort(), proxy.GetToken(), kubeconfigPath); err != nil {
+		return fmt.Errorf("could not create temporal kubeconfig: %w", err)
+	}
+	defer func() {
+		if err := r.fs.Remove(kubeconfigPath); err != nil {
+			oktetoLog.Infof("could not remove temporal kubeconfig file: %s", err)
+		}
+	}()
+
+	proxy.SetName(format.ResourceK8sMetaString(params.Manifest.Name))
+	proxy.InitTranslator()
+	proxy.Start()
+	defer func() {
+		if err := proxy.Shutdown(ctx); err != nil {
+			oktetoLog.Infof("could not stop local server: %s", err)
+		}
+	}()
+
+	err = r.newCommandsRunner(ctx, params.ContextAbsolutePathOverride).RunTest(ctx, deployable.TestParameters{
+		Name:       params.TestName,
+		Namespace:  r.namespace,
+		Deployable: params.Deployable,
+		Variables:  r.testVariables(params, kubeconfigPath),
+	})
+
+	// artifacts are exported even if the commands fail, like in Remote Execution
+	if copyErr := copyArtifacts(r.fs, params.ContextAbsolutePathOverride, params.Artifacts); copyErr != nil {
+		if err != nil {
+			oktetoLog.Infof("could not copy artifacts of test container '%s': %s", params.TestName, copyErr)
+			return err
+		}
+		return copyErr
+	}
+	return err
+}
+
+// testVariables returns the environment of the test commands, with the same variables injected
+// in the test container by Remote Execution
+func (r *localTestRunner) testVariables(params *remote.Params, kubeconfigPath string) []string {
+	var variables []string
+	for _, envVars := range []map[string]string{params.BuildEnvVars, params.OktetoCommandSpecificEnvVars, params.DependenciesEnvVars} {
+		keys := make([]string, 0, len(envVars))
+		for k := range envVars {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			variables = append(variables, fmt.Sprintf("%s=%s", k, envVars[k]))
+		}
+	}
+	variables = append(variables, r.variables...)
+	return append(
+		variables,
+		fmt.Sprintf("%s=%s", constants.KubeConfigEnvVar, kubeconfigPath),
+		fmt.Sprintf("%s=%s", constants.OktetoNameEnvVar, params.Manifest.Name),
+		fmt.Sprintf("%s=%s", model.OktetoNamespaceEnvVar, r.namespace),
+		fmt.Sprintf("%s=%s", model.OktetoDomainEnvVar, r.subdomain),
+		fmt.Sprintf("%s=true", constants.OktetoSkipConfigCredentialsUpdate),
+		fmt.Sprintf("%s=true", oktetoLog.OktetoDisableSpinnerEnvVar),
+	)
+}
+
+// localCommandWaitDelay is the time to wait for the output of a command after it was killed
+const localCommandWaitDelay = 5 * time.Second
+
+// localCommandExecutor executes the commands of a test container in the local machine. The
+// commands are killed when ctx is done, so the timeout of the test container is enforced, and
+// their output is written with the prefix of ioCtrl and recorded in its captured output
+type localCommandExecutor struct {
+	ctx    context.Context
+	ioCtrl *io.Controller
+	dir    string
+}
+
+// Execute executes the command adding env to the execution environment
+func (e *localCommandExecutor) Execute(command model.DeployCommand, env []string) error {
+	cmd := exec.CommandContext(e.ctx, "bash", "-c", command.Command)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Dir = e.dir
+	// processes started by the command could keep its output open after it was killed
+	cmd.WaitDelay = localCommandWaitDelay
+
+	output := &commandOutput{ioCtrl: e.ioCtrl}
+	cmd.Stdout = output
+	cmd.Stderr = output
+	err := cmd.Run()
+	output.flush()
+	if err != nil && e.ctx.Err() != nil {
+		return fmt.Errorf("%w: %w", err, e.ctx.Err())
+	}
+	return err
+}
+
+// CleanUp is a no-op, the output of the commands is written line by line
+func (*localCommandExecutor) CleanUp(_ error) {}
+
+// commandOutput writes every line of the output of a command into ioCtrl and records it in the
+// captured output of ioCtrl, if any
+type commandOutput struct {
+	ioCtrl *io.Controller
+	buf    []byte
+	mu     sync.Mutex
+}
+
+func (o *commandOutput) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.buf = append(o.buf, p...)
+	for {
+		i := bytes.IndexByte(o.buf, '\n')
+		if i < 0 {
+			break
+		}
+		o.writeLine(string(o.buf[:i]))
+		o.buf = o.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+// flush writes the last line of the output when it doesn't end with a new line
+func (o *commandOutput) flush() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.buf) > 0 {
+		o.writeLine(string(o.buf))
+		o.buf = nil
+	}
+}
+
+func (o *commandOutput) writeLine(line string) {
+	o.ioCtrl.Out().Println(line)
+	if capture := o.ioCtrl.Capture(); capture != nil {
+		fmt.Fprintln(capture, oktetoLog.Redact(line))
+	}
+}
+
+// copyArtifacts copies the artifacts generated in contextDir to their destinations. Artifacts that
+// don't exist are skipped
+func copyArtifacts(fs afero.Fs, contextDir string, artifacts []model.Artifact) error {
+	for _, artifact := range artifacts {
+		src := filepath.Join(contextDir, artifact.Path)
+		dst := filepath.Join(contextDir, artifact.Destination)
+		if src == dst {
+			continue
+		}
+		if _, err := fs.Stat(src); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if err := copyPath(fs, src, dst); err != nil {
+			return fmt.Errorf("could not copy artifact '%s' to '%s': %w", artifact.Path, artifact.Destination, err)
+		}
+	}
+	return nil
+}
+
+// copyPath copies the file or directory src into dst
+func copyPath(fs afero.Fs, src, dst string) error {
+	return afero.Walk(fs, src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return fs.MkdirAll(target, info.Mode().Perm()|0700)
+		}
+		if err := fs.MkdirAll(filepath.Dir(target), 0700); err != nil {
+			return err
+		}
+		return copyFile(fs, path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(fs afero.Fs, src, dst string, perm os.FileMode) error {
+	from, err := fs.Open(src)
+	if err != nil {
+		return err
+	}
+	defer from.Close()
+
+	to, err := fs.OpenFile(dst, os.O_RDWR|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := stdio.Copy(to, from); err != nil {
+		to.Close()
+		return err
+	}
+	return to.Close()
+}
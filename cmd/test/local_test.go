@@ -0,0 +1,191 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/deployable"
+	"github.com/okteto/okteto/pkg/divert"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/remote"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+)
+
+type fakeLocalProxy struct {
+	name     string
+	started  bool
+	shutdown bool
+}
+
+func (p *fakeLocalProxy) Start()                            { p.started = true }
+func (p *fakeLocalProxy) Shutdown(_ context.Context) error  { p.shutdown = true; return nil }
+func (*fakeLocalProxy) GetPort() int                        { return 8080 }
+func (*fakeLocalProxy) GetToken() string                    { return "token" }
+func (p *fakeLocalProxy) SetName(name string)               { p.name = name }
+func (*fakeLocalProxy) SetDivert(_ divert.Driver)           {}
+func (*fakeLocalProxy) SetInventory(_ *inventory.Inventory) {}
+func (*fakeLocalProxy) SetDryRun(_ *deployable.DryRun)      {}
+func (*fakeLocalProxy) InitTranslator()                     {}
+
+type fakeLocalKubeconfig struct {
+	fs afero.Fs
+}
+
+func (*fakeLocalKubeconfig) Read() (*rest.Config, error) { return nil, nil }
+
+func (k *fakeLocalKubeconfig) Modify(_ int, _, dest string) error {
+	return afero.WriteFile(k.fs, dest, []byte("kubeconfig"), 0600)
+}
+
+type fakeTestCommandsRunner struct {
+	fs     afero.Fs
+	err    error
+	dir    string
+	params deployable.TestParameters
+}
+
+func (r *fakeTestCommandsRunner) RunTest(_ context.Context, params deployable.TestParameters) error {
+	r.params = params
+	if err := afero.WriteFile(r.fs, filepath.Join(r.dir, "reports", "junit.xml"), []byte("<testsuites/>"), 0600); err != nil {
+		return err
+	}
+	return r.err
+}
+
+func newFakeLocalTestRunner(fs afero.Fs, commands *fakeTestCommandsRunner, proxy *fakeLocalProxy) *localTestRunner {
+	return &localTestRunner{
+		kubeconfig: &fakeLocalKubeconfig{fs: fs},
+		fs:         fs,
+		newProxy: func() (deployable.ProxyInterface, error) {
+			return proxy, nil
+		},
+		newCommandsRunner: func(_ context.Context, dir string) testCommandsRunner {
+			commands.dir = dir
+			return commands
+		},
+		getTempKubeconfig: func(name string) string {
+			return filepath.Join("/tmp", "kubeconfig-"+name)
+		},
+		namespace: "ns",
+		subdomain: "okteto.dev",
+		variables: []string{"FOO=bar"},
+	}
+}
+
+func TestLocalTestRunner(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	commands := &fakeTestCommandsRunner{fs: fs}
+	proxy := &fakeLocalProxy{}
+	runner := newFakeLocalTestRunner(fs, commands, proxy)
+
+	err := runner.Run(context.Background(), &remote.Params{
+		TestName:                     "unit",
+		Manifest:                     &model.Manifest{Name: "My App"},
+		ContextAbsolutePathOverride:  "/app/api",
+		BuildEnvVars:                 map[string]string{"OKTETO_BUILD_API_IMAGE": "okteto/api"},
+		OktetoCommandSpecificEnvVars: map[string]string{"CI": "true"},
+		Artifacts:                    []model.Artifact{{Path: "reports", Destination: "out/reports"}, {Path: "missing", Destination: "missing"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "/app/api", commands.dir)
+	assert.Equal(t, "unit", commands.params.Name)
+	assert.Equal(t, "ns", commands.params.Namespace)
+	assert.Subset(t, commands.params.Variables, []string{
+		"OKTETO_BUILD_API_IMAGE=okteto/api",
+		"CI=true",
+		"FOO=bar",
+		"KUBECONFIG=/tmp/kubeconfig-unit",
+		"OKTETO_NAME=My App",
+		"OKTETO_NAMESPACE=ns",
+		"OKTETO_DOMAIN=okteto.dev",
+	})
+
+	assert.Equal(t, "my-app", proxy.name)
+	assert.True(t, proxy.started)
+	assert.True(t, proxy.shutdown)
+
+	content, err := afero.ReadFile(fs, "/app/api/out/reports/junit.xml")
+	require.NoError(t, err)
+	assert.Equal(t, "<testsuites/>", string(content))
+
+	_, err = fs.Stat("/tmp/kubeconfig-unit")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLocalTestRunnerCommandFails(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	commands := &fakeTestCommandsRunner{fs: fs, err: deployable.TestCommandError{Command: "unit", Err: errors.New("exit status 1")}}
+	runner := newFakeLocalTestRunner(fs, commands, &fakeLocalProxy{})
+
+	err := runner.Run(context.Background(), &remote.Params{
+		TestName:                    "unit",
+		Manifest:                    &model.Manifest{Name: "app"},
+		ContextAbsolutePathOverride: "/app",
+		Artifacts:                   []model.Artifact{{Path: "reports", Destination: "exported"}},
+	})
+	require.ErrorContains(t, err, "error executing command 'unit'")
+
+	// artifacts are exported even if the test container failed
+	_, err = fs.Stat("/app/exported/junit.xml")
+	require.NoError(t, err)
+}
+
+func TestLocalCommandExecutorCapturesOutput(t *testing.T) {
+	output := &outputBuffer{}
+	ioCtrl := io.NewIOController().WithPrefix("[unit] ").WithCapture(output)
+	e := &localCommandExecutor{ctx: context.Background(), ioCtrl: ioCtrl, dir: t.TempDir()}
+
+	err := e.Execute(model.DeployCommand{Name: "unit", Command: "echo first; echo second >&2; printf last"}, []string{"FOO=bar"})
+	require.NoError(t, err)
+	assert.Equal(t, "first\nsecond\nlast\n", output.String())
+}
+
+func TestLocalCommandExecutorEnv(t *testing.T) {
+	output := &outputBuffer{}
+	dir := t.TempDir()
+	e := &localCommandExecutor{ctx: context.Background(), ioCtrl: io.NewIOController().WithCapture(output), dir: dir}
+
+	err := e.Execute(model.DeployCommand{Name: "env", Command: "echo $FOO; pwd"}, []string{"FOO=bar"})
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "bar", lines[0])
+	resolved, err := filepath.EvalSymlinks(dir)
+	require.NoError(t, err)
+	assert.Equal(t, resolved, lines[1])
+}
+
+func TestLocalCommandExecutorTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	e := &localCommandExecutor{ctx: ctx, ioCtrl: io.NewIOController(), dir: t.TempDir()}
+
+	start := time.Now()
+	err := e.Execute(model.DeployCommand{Name: "sleep", Command: "sleep 30"}, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
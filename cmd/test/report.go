@@ -0,0 +1,313 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/okteto/okteto/pkg/deployable"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/spf13/afero"
+)
+
+const (
+	junitReportFormat = "junit"
+	jsonReportFormat  = "json"
+)
+
+// reportTarget is a report requested with the --report flag
+type reportTarget struct {
+	format string
+	path   string
+}
+
+// parseReportTargets parses the values of the --report flag, with the form format=path.
+// Paths are made absolute, as the working directory changes when the manifest is loaded
+func parseReportTargets(values []string) ([]reportTarget, error) {
+	targets := make([]reportTarget, 0, len(values))
+	for _, value := range values {
+		format, path, found := strings.Cut(value, "=")
+		if !found || path == "" {
+			return nil, fmt.Errorf("invalid report '%s': it must have the form <format>=<path>, e.g. junit=report.xml", value)
+		}
+		if format != junitReportFormat && format != jsonReportFormat {
+			return nil, fmt.Errorf("invalid report format '%s': supported formats are '%s' and '%s'", format, junitReportFormat, jsonReportFormat)
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid report path '%s': %w", path, err)
+		}
+		targets = append(targets, reportTarget{format: format, path: absPath})
+	}
+	return targets, nil
+}
+
+// testReport gathers the results of the test containers to write them in the formats requested
+type testReport struct {
+	fs      afero.Fs
+	name    string
+	results []*testResult
+	elapsed time.Duration
+
+	// artifacts are the absolute paths of the artifacts exported by each test container. JUnit files
+	// found in them are merged into the JUnit report
+	artifacts map[string][]string
+}
+
+// write writes every report requested
+func (r *testReport) write(targets []reportTarget) error {
+	for _, target := range targets {
+		var (
+			content []byte
+			err     error
+		)
+		switch target.format {
+		case junitReportFormat:
+			content, err = r.junit()
+		case jsonReportFormat:
+			content, err = r.json()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to generate %s report: %w", target.format, err)
+		}
+		if err := r.fs.MkdirAll(filepath.Dir(target.path), 0700); err != nil {
+			return fmt.Errorf("failed to create the folder of the %s report: %w", target.format, err)
+		}
+		if err := afero.WriteFile(r.fs, target.path, content, 0600); err != nil {
+			return fmt.Errorf("failed to write %s report: %w", target.format, err)
+		}
+		oktetoLog.Success("Test report saved at '%s'", target.path)
+	}
+	return nil
+}
+
+type jsonTestReport struct {
+	Name     string         `json:"name"`
+	Tests    []jsonTestCase `json:"tests"`
+	Duration float64        `json:"duration"`
+	Success  bool           `json:"success"`
+}
+
+type jsonTestCase struct {
+	Name         string     `json:"name"`
+	Status       testStatus `json:"status"`
+	Command      string     `json:"command,omitempty"`
+	Error        string     `json:"error,omitempty"`
+	Output       string     `json:"output,omitempty"`
+	Duration     float64    `json:"duration"`
+	Attempts     int        `json:"attempts,omitempty"`
+	AllowFailure bool       `json:"allowFailure,omitempty"`
+}
+
+// json returns the report in json format. Durations are in seconds
+func (r *testReport) json() ([]byte, error) {
+	report := jsonTestReport{
+		Name:     r.name,
+		Duration: r.elapsed.Seconds(),
+		Success:  true,
+		Tests:    make([]jsonTestCase, 0, len(r.results)),
+	}
+	for _, result := range r.results {
+		tc := jsonTestCase{
+			Name:         result.name,
+			Status:       result.status,
+			Duration:     result.duration.Seconds(),
+			Command:      failedCommand(result.err),
+			Output:       result.output,
+			Attempts:     result.attempts,
+			AllowFailure: result.allowFailure,
+		}
+		if result.err != nil {
+			tc.Error = result.err.Error()
+		}
+		if result.failed() || result.status == testSkipped {
+			report.Success = false
+		}
+		report.Tests = append(report.Tests, tc)
+	}
+	return json.MarshalIndent(report, "", "  ")
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr,omitempty"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+}
+
+// junitTestSuite is a suite of the JUnit report. Suites merged from the artifacts keep their
+// original attributes and content
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Time      string          `xml:"time,attr,omitempty"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	Attrs     []xml.Attr      `xml:",any,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+	Content   string          `xml:",innerxml"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+}
+
+type junitTestCase struct {
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
+
+// junit returns the report in JUnit XML format. Each test container is a test case of the suite
+// named after the manifest. The output of the commands, which mixes stdout and stderr, is
+// reported as system-out
+func (r *testReport) junit() ([]byte, error) {
+	suite := junitTestSuite{
+		Name: r.name,
+		Time: junitTime(r.elapsed),
+	}
+	for _, result := range r.results {
+		tc := junitTestCase{
+			Name:      result.name,
+			Classname: r.name,
+			Time:      junitTime(result.duration),
+			SystemOut: result.output,
+		}
+		switch result.status {
+		case testPassed:
+		case testSkipped:
+			tc.Skipped = &junitMessage{}
+			if result.err != nil {
+				tc.Skipped.Message = result.err.Error()
+			}
+			suite.Skipped++
+		case testFailed, testCancelled:
+			tc.Failure = &junitMessage{Type: string(result.status)}
+			if result.err != nil {
+				tc.Failure.Message = result.err.Error()
+			}
+			if cmd := failedCommand(result.err); cmd != "" {
+				tc.Failure.Content = fmt.Sprintf("command '%s' failed", cmd)
+			}
+			suite.Failures++
+		}
+		if !result.start.IsZero() && suite.Timestamp == "" {
+			suite.Timestamp = result.start.UTC().Format("2006-01-02T15:04:05")
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+		suite.Tests++
+	}
+
+	report := junitTestSuites{
+		Name:   r.name,
+		Time:   junitTime(r.elapsed),
+		Suites: []junitTestSuite{suite},
+	}
+	for _, result := range r.results {
+		report.Suites = append(report.Suites, r.junitArtifacts(result.name)...)
+	}
+	for _, s := range report.Suites {
+		report.Tests += s.Tests
+		report.Failures += s.Failures
+		report.Errors += s.Errors
+		report.Skipped += s.Skipped
+	}
+
+	content, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), content...), nil
+}
+
+// junitArtifacts returns the suites of the JUnit files exported as artifacts by a test container
+func (r *testReport) junitArtifacts(name string) []junitTestSuite {
+	var suites []junitTestSuite
+	for _, artifact := range r.artifacts[name] {
+		err := afero.Walk(r.fs, artifact, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() || filepath.Ext(path) != ".xml" {
+				return nil
+			}
+			fileSuites, err := r.readJUnitFile(path)
+			if err != nil {
+				oktetoLog.Infof("ignoring artifact '%s' of test container '%s': %s", path, name, err)
+				return nil
+			}
+			suites = append(suites, fileSuites...)
+			return nil
+		})
+		if err != nil {
+			oktetoLog.Infof("failed to read artifact '%s' of test container '%s': %s", artifact, name, err)
+		}
+	}
+	return suites
+}
+
+// readJUnitFile returns the suites of a JUnit file, whose root element can be either testsuites or testsuite
+func (r *testReport) readJUnitFile(path string) ([]junitTestSuite, error) {
+	content, err := afero.ReadFile(r.fs, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var suites []junitTestSuite
+	var all junitTestSuites
+	if err := xml.Unmarshal(content, &all); err == nil {
+		suites = all.Suites
+	} else {
+		var suite junitTestSuite
+		if err := xml.Unmarshal(content, &suite); err != nil {
+			return nil, errors.New("it is not a JUnit file")
+		}
+		suites = []junitTestSuite{suite}
+	}
+
+	for i := range suites {
+		// the original content is kept as is, so the parsed test cases are not written again
+		suites[i].TestCases = nil
+	}
+	return suites, nil
+}
+
+// failedCommand returns the name of the command that made the test container fail
+func failedCommand(err error) string {
+	var cmdErr deployable.TestCommandError
+	if !errors.As(err, &cmdErr) {
+		return ""
+	}
+	return cmdErr.Command
+}
+
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/build/buildkit"
+	"github.com/okteto/okteto/pkg/deployable"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeTestResults() []*testResult {
+	return []*testResult{
+		{name: "unit", status: testPassed, duration: 2 * time.Second, output: "ok\n"},
+		{
+			name:     "integration",
+			status:   testFailed,
+			duration: time.Second,
+			output:   "FAIL\n",
+			err: buildkit.CommandErr{
+				Stage:  "integration",
+				Err:    deployable.TestCommandError{Command: "go test", Err: errors.New("exit status 1")},
+				Output: "test",
+			},
+		},
+		{name: "e2e", status: testSkipped, err: errors.New("dependency failed")},
+	}
+}
+
+func TestParseReportTargets(t *testing.T) {
+	targets, err := parseReportTargets([]string{"junit=report.xml", "json=/tmp/report.json"})
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	assert.Equal(t, junitReportFormat, targets[0].format)
+	assert.True(t, filepath.IsAbs(targets[0].path))
+	assert.Equal(t, reportTarget{format: jsonReportFormat, path: "/tmp/report.json"}, targets[1])
+
+	_, err = parseReportTargets([]string{"report.xml"})
+	assert.ErrorContains(t, err, "<format>=<path>")
+
+	_, err = parseReportTargets([]string{"html=report.html"})
+	assert.ErrorContains(t, err, "invalid report format 'html'")
+}
+
+func TestJSONReport(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	report := &testReport{fs: fs, name: "app", results: newFakeTestResults(), elapsed: 3 * time.Second}
+	require.NoError(t, report.write([]reportTarget{{format: jsonReportFormat, path: "/reports/report.json"}}))
+
+	content, err := afero.ReadFile(fs, "/reports/report.json")
+	require.NoError(t, err)
+	var got jsonTestReport
+	require.NoError(t, json.Unmarshal(content, &got))
+
+	assert.Equal(t, "app", got.Name)
+	assert.False(t, got.Success)
+	require.Len(t, got.Tests, 3)
+	assert.Equal(t, jsonTestCase{Name: "unit", Status: testPassed, Duration: 2, Output: "ok\n"}, got.Tests[0])
+	assert.Equal(t, "go test", got.Tests[1].Command)
+	assert.Equal(t, "FAIL\n", got.Tests[1].Output)
+	assert.Equal(t, testSkipped, got.Tests[2].Status)
+}
+
+func TestJUnitReport(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	artifact := `<?xml version="1.0"?>
+<testsuite name="pkg/api" tests="2" failures="0" hostname="runner">
+  <testcase name="TestGet" classname="api" time="0.1"></testcase>
+  <testcase name="TestPost" classname="api" time="0.2"></testcase>
+</testsuite>`
+	require.NoError(t, afero.WriteFile(fs, "/app/reports/api.xml", []byte(artifact), 0600))
+	require.NoError(t, afero.WriteFile(fs, "/app/reports/coverage.out", []byte("mode: set"), 0600))
+
+	report := &testReport{
+		fs:        fs,
+		name:      "app",
+		results:   newFakeTestResults(),
+		elapsed:   3 * time.Second,
+		artifacts: map[string][]string{"unit": {"/app/reports"}},
+	}
+	content, err := report.junit()
+	require.NoError(t, err)
+
+	var got junitTestSuites
+	require.NoError(t, xml.Unmarshal(content, &got))
+	assert.Equal(t, 5, got.Tests)
+	assert.Equal(t, 1, got.Failures)
+	assert.Equal(t, 1, got.Skipped)
+	require.Len(t, got.Suites, 2)
+
+	suite := got.Suites[0]
+	require.Len(t, suite.TestCases, 3)
+	assert.Equal(t, "2.000", suite.TestCases[0].Time)
+	assert.Equal(t, "ok\n", suite.TestCases[0].SystemOut)
+	require.NotNil(t, suite.TestCases[1].Failure)
+	assert.Equal(t, "command 'go test' failed", suite.TestCases[1].Failure.Content)
+	require.NotNil(t, suite.TestCases[2].Skipped)
+
+	merged := got.Suites[1]
+	assert.Equal(t, "pkg/api", merged.Name)
+	require.Len(t, merged.TestCases, 2)
+	assert.Contains(t, merged.Attrs, xml.Attr{Name: xml.Name{Local: "hostname"}, Value: "runner"})
+}
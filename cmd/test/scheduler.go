@@ -0,0 +1,294 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+)
+
+type testStatus string
+
+const (
+	testPassed    testStatus = "passed"
+	testFailed    testStatus = "failed"
+	testSkipped   testStatus = "skipped"
+	testCancelled testStatus = "cancelled"
+
+	// defaultTestRetryBackoff is the wait before the first retry of a test container
+	defaultTestRetryBackoff = 5 * time.Second
+
+	// maxTestRetryBackoff is the maximum wait between retries of a test container
+	maxTestRetryBackoff = time.Minute
+)
+
+// testResult is the outcome of a test container
+type testResult struct {
+	err      error
+	name     string
+	status   testStatus
+	start    time.Time
+	duration time.Duration
+	attempts int
+
+	// allowFailure is true when the failure of the test container doesn't fail the execution
+	allowFailure bool
+
+	// output is the output of the commands of the test container
+	output string
+}
+
+// runTestFn runs the test container name, writing its output into ioCtrl
+type runTestFn func(ctx context.Context, name string, ioCtrl *io.Controller) error
+
+// testScheduler runs the test containers of a manifest respecting their dependencies
+type testScheduler struct {
+	run    runTestFn
+	ioCtrl *io.Controller
+	tests  model.ManifestTests
+
+	// retryBackoff is the wait before the first retry of a test container, it doubles after each retry
+	retryBackoff time.Duration
+
+	// parallelism is the maximum number of test containers running at the same time
+	parallelism int
+
+	// failFast stops the execution once a test container fails
+	failFast bool
+}
+
+// Run runs the tests, which must be sorted by their dependencies, and returns their results in the same order.
+// A test is skipped when any of its dependencies didn't pass, unless the dependency is allowed to fail.
+func (s *testScheduler) Run(ctx context.Context, tests []string) []*testResult {
+	if s.parallelism <= 1 {
+		return s.runSequentially(ctx, tests)
+	}
+	return s.runInParallel(ctx, tests)
+}
+
+func (s *testScheduler) runSequentially(ctx context.Context, tests []string) []*testResult {
+	results := make([]*testResult, 0, len(tests))
+	byName := map[string]*testResult{}
+	failed := false
+	for _, name := range tests {
+		result := &testResult{name: name}
+		results = append(results, result)
+		byName[name] = result
+
+		if failed && s.failFast {
+			result.status = testSkipped
+			continue
+		}
+		if dep := s.failedDependency(name, byName); dep != "" {
+			result.status = testSkipped
+			result.err = fmt.Errorf("test container '%s' was skipped because its dependency '%s' didn't pass", name, dep)
+			continue
+		}
+
+		s.runTest(ctx, result, s.ioCtrl)
+		if result.failed() {
+			failed = true
+		}
+	}
+	return results
+}
+
+// runInParallel runs every test as soon as all its dependencies pass. The output of each test is
+// prefixed with its name, as several tests write at the same time.
+func (s *testScheduler) runInParallel(ctx context.Context, tests []string) []*testResult {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]*testResult, 0, len(tests))
+	byName := make(map[string]*testResult, len(tests))
+	done := make(map[string]chan struct{}, len(tests))
+	for _, name := range tests {
+		result := &testResult{name: name}
+		results = append(results, result)
+		byName[name] = result
+		done[name] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, s.parallelism)
+	wg.Add(len(tests))
+	for _, name := range tests {
+		go func(result *testResult) {
+			defer wg.Done()
+			defer close(done[result.name])
+
+			for _, dep := range s.tests[result.name].DependsOn {
+				depDone, ok := done[dep]
+				if !ok {
+					continue
+				}
+				<-depDone
+			}
+			if dep := s.failedDependency(result.name, byName); dep != "" {
+				result.status = testSkipped
+				result.err = fmt.Errorf("test container '%s' was skipped because its dependency '%s' didn't pass", result.name, dep)
+				return
+			}
+
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				result.status = testSkipped
+				return
+			}
+			defer func() { <-semaphore }()
+
+			if ctx.Err() != nil {
+				result.status = testSkipped
+				return
+			}
+
+			s.runTest(ctx, result, s.ioCtrl.WithPrefix(fmt.Sprintf("[%s] ", result.name)))
+			if result.failed() && s.failFast {
+				cancel()
+			}
+		}(byName[name])
+	}
+	wg.Wait()
+
+	return results
+}
+
+// runTest runs a single test container and records its result. The test container is executed
+// again while it fails and it has retries left, waiting longer before each retry
+func (s *testScheduler) runTest(ctx context.Context, result *testResult, ioCtrl *io.Controller) {
+	test := s.tests[result.name]
+	result.allowFailure = test.AllowFailure
+
+	output := &outputBuffer{}
+	backoff := s.retryBackoff
+	result.start = time.Now()
+	for {
+		result.attempts++
+		result.err = s.runAttempt(ctx, result.name, test.Timeout, ioCtrl.WithCapture(output))
+		if result.err == nil || ctx.Err() != nil || result.attempts > test.Retries {
+			break
+		}
+
+		ioCtrl.Out().Warning("Test container '%s' failed, retrying in %s (%d/%d)", result.name, backoff, result.attempts, test.Retries)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+		}
+		backoff = min(2*backoff, maxTestRetryBackoff)
+	}
+	result.duration = time.Since(result.start)
+	result.output = output.String()
+
+	switch {
+	case result.err == nil:
+		result.status = testPassed
+	case ctx.Err() != nil:
+		result.status = testCancelled
+	default:
+		result.status = testFailed
+		if result.allowFailure {
+			ioCtrl.Out().Warning("Test container '%s' failed, but it is allowed to fail", result.name)
+		}
+	}
+}
+
+// runAttempt runs the test container once, stopping it if it takes longer than timeout
+func (s *testScheduler) runAttempt(ctx context.Context, name string, timeout time.Duration, ioCtrl *io.Controller) error {
+	if timeout == 0 {
+		return s.run(ctx, name, ioCtrl)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := s.run(attemptCtx, name, ioCtrl)
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return oktetoErrors.UserError{
+			E:    fmt.Errorf("test container '%s' didn't finish in %s", name, timeout),
+			Hint: "Increase the 'timeout' of the test container in your Okteto Manifest",
+		}
+	}
+	return err
+}
+
+// outputBuffer captures the output of a test container, whose shards write at the same time
+type outputBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+func (b *outputBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *outputBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// failed returns true when the test container failed and it isn't allowed to fail
+func (r *testResult) failed() bool {
+	return (r.status == testFailed || r.status == testCancelled) && !r.allowFailure
+}
+
+// failedDependency returns the first dependency of the test that didn't pass and isn't allowed to fail
+func (s *testScheduler) failedDependency(name string, results map[string]*testResult) string {
+	for _, dep := range s.tests[name].DependsOn {
+		r, ok := results[dep]
+		if !ok || r.status == testPassed {
+			continue
+		}
+		if r.status == testFailed && r.allowFailure {
+			continue
+		}
+		return dep
+	}
+	return ""
+}
+
+// printTestSummary displays the status of every test container and how long it took
+func printTestSummary(ioCtrl *io.Controller, results []*testResult, elapsed time.Duration) {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 1, 1, 2, ' ', 0)
+	fmt.Fprintf(w, "Test\tStatus\tDuration\n")
+	for _, r := range results {
+		duration := "-"
+		if r.status != testSkipped {
+			duration = r.duration.Round(time.Millisecond).String()
+		}
+		status := string(r.status)
+		if r.status == testFailed && r.allowFailure {
+			status += " (allowed)"
+		}
+		if r.attempts > 1 {
+			status += fmt.Sprintf(" after %d attempts", r.attempts)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", r.name, status, duration)
+	}
+	w.Flush()
+	ioCtrl.Out().Print(buf.String())
+	ioCtrl.Out().Infof("Ran %d test containers in %s", len(results), elapsed.Round(time.Millisecond))
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeManifestTests returns the tests of a manifest, adding an empty test for each name
+func newFakeManifestTests(tests map[string]*model.Test, names ...string) model.ManifestTests {
+	result := model.ManifestTests{}
+	for name, test := range tests {
+		result[name] = test
+	}
+	for _, name := range names {
+		result[name] = &model.Test{}
+	}
+	return result
+}
+
+// fakeTestRunner simulates test containers that take some time and tracks how many run at the same time
+type fakeTestRunner struct {
+	fail       map[string]bool
+	failTimes  map[string]int
+	attempts   map[string]int
+	prefixes   map[string]string
+	started    []string
+	running    int
+	maxRunning int
+	mu         sync.Mutex
+	duration   time.Duration
+}
+
+func (r *fakeTestRunner) run(ctx context.Context, name string, ioCtrl *io.Controller) error {
+	r.mu.Lock()
+	r.running++
+	if r.running > r.maxRunning {
+		r.maxRunning = r.running
+	}
+	r.started = append(r.started, name)
+	r.prefixes[name] = ioCtrl.Prefix()
+	if r.attempts == nil {
+		r.attempts = map[string]int{}
+	}
+	r.attempts[name]++
+	attempt := r.attempts[name]
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		r.running--
+		r.mu.Unlock()
+	}()
+
+	select {
+	case <-time.After(r.duration):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if r.fail[name] || attempt <= r.failTimes[name] {
+		return errors.New("test failed")
+	}
+	return nil
+}
+
+func statuses(results []*testResult) map[string]testStatus {
+	s := map[string]testStatus{}
+	for _, r := range results {
+		s[r.name] = r.status
+	}
+	return s
+}
+
+func TestSchedulerRunsInParallel(t *testing.T) {
+	runner := &fakeTestRunner{prefixes: map[string]string{}, duration: 50 * time.Millisecond}
+	s := &testScheduler{
+		run:         runner.run,
+		ioCtrl:      io.NewIOController(),
+		tests:       newFakeManifestTests(map[string]*model.Test{"e2e": {DependsOn: []string{"unit"}}}, "integration", "unit"),
+		parallelism: 2,
+		failFast:    true,
+	}
+
+	results := s.Run(context.Background(), []string{"integration", "unit", "e2e"})
+
+	require.Len(t, results, 3)
+	assert.Equal(t, map[string]testStatus{"integration": testPassed, "unit": testPassed, "e2e": testPassed}, statuses(results))
+	assert.Equal(t, 2, runner.maxRunning)
+	assert.Equal(t, "e2e", runner.started[2])
+	assert.Equal(t, "[unit] ", runner.prefixes["unit"])
+}
+
+func TestSchedulerSkipsDependentsOfFailedTests(t *testing.T) {
+	runner := &fakeTestRunner{prefixes: map[string]string{}, fail: map[string]bool{"unit": true}}
+	s := &testScheduler{
+		run:         runner.run,
+		ioCtrl:      io.NewIOController(),
+		tests:       newFakeManifestTests(map[string]*model.Test{"e2e": {DependsOn: []string{"unit"}}}, "integration", "unit"),
+		parallelism: 3,
+		failFast:    false,
+	}
+
+	results := s.Run(context.Background(), []string{"integration", "unit", "e2e"})
+
+	assert.Equal(t, map[string]testStatus{"integration": testPassed, "unit": testFailed, "e2e": testSkipped}, statuses(results))
+	assert.NotContains(t, runner.started, "e2e")
+	assert.ErrorContains(t, testsError(results), "test failed")
+}
+
+func TestSchedulerFailFast(t *testing.T) {
+	runner := &fakeTestRunner{prefixes: map[string]string{}, fail: map[string]bool{"unit": true}, duration: 10 * time.Millisecond}
+	s := &testScheduler{
+		run:         runner.run,
+		ioCtrl:      io.NewIOController(),
+		tests:       newFakeManifestTests(nil, "unit", "integration"),
+		parallelism: 1,
+		failFast:    true,
+	}
+
+	results := s.Run(context.Background(), []string{"unit", "integration"})
+
+	assert.Equal(t, map[string]testStatus{"unit": testFailed, "integration": testSkipped}, statuses(results))
+	assert.Equal(t, []string{"unit"}, runner.started)
+	assert.Empty(t, runner.prefixes["unit"])
+
+	runner = &fakeTestRunner{prefixes: map[string]string{}, fail: map[string]bool{"unit": true}, duration: 10 * time.Millisecond}
+	s.run = runner.run
+	s.failFast = false
+	results = s.Run(context.Background(), []string{"unit", "integration"})
+	assert.Equal(t, map[string]testStatus{"unit": testFailed, "integration": testPassed}, statuses(results))
+}
+
+func TestSchedulerRetries(t *testing.T) {
+	runner := &fakeTestRunner{prefixes: map[string]string{}, failTimes: map[string]int{"e2e": 2, "unit": 5}}
+	s := &testScheduler{
+		run:          runner.run,
+		ioCtrl:       io.NewIOController(),
+		tests:        model.ManifestTests{"e2e": {Retries: 2}, "unit": {Retries: 1}},
+		retryBackoff: time.Millisecond,
+		parallelism:  1,
+	}
+
+	results := s.Run(context.Background(), []string{"e2e", "unit"})
+
+	assert.Equal(t, map[string]testStatus{"e2e": testPassed, "unit": testFailed}, statuses(results))
+	assert.Equal(t, 3, results[0].attempts)
+	assert.Equal(t, 2, results[1].attempts)
+}
+
+func TestSchedulerTimeout(t *testing.T) {
+	runner := &fakeTestRunner{prefixes: map[string]string{}, duration: time.Second}
+	s := &testScheduler{
+		run:         runner.run,
+		ioCtrl:      io.NewIOController(),
+		tests:       model.ManifestTests{"e2e": {Timeout: 10 * time.Millisecond}},
+		parallelism: 1,
+	}
+
+	results := s.Run(context.Background(), []string{"e2e"})
+
+	assert.Equal(t, testFailed, results[0].status)
+	assert.ErrorContains(t, results[0].err, "test container 'e2e' didn't finish in 10ms")
+	assert.Less(t, results[0].duration, time.Second)
+}
+
+func TestSchedulerAllowFailure(t *testing.T) {
+	runner := &fakeTestRunner{prefixes: map[string]string{}, fail: map[string]bool{"lint": true}}
+	s := &testScheduler{
+		run:         runner.run,
+		ioCtrl:      io.NewIOController(),
+		tests:       model.ManifestTests{"lint": {AllowFailure: true}, "unit": {DependsOn: []string{"lint"}}, "e2e": {}},
+		parallelism: 2,
+		failFast:    true,
+	}
+
+	results := s.Run(context.Background(), []string{"lint", "e2e", "unit"})
+
+	assert.Equal(t, map[string]testStatus{"lint": testFailed, "e2e": testPassed, "unit": testPassed}, statuses(results))
+	assert.NoError(t, testsError(results))
+}
+
+func TestTestsError(t *testing.T) {
+	assert.NoError(t, testsError([]*testResult{{name: "unit", status: testPassed}}))
+
+	err := testsError([]*testResult{
+		{name: "unit", status: testFailed, err: errors.New("unit failed")},
+		{name: "e2e", status: testFailed, err: errors.New("e2e failed")},
+	})
+	assert.ErrorContains(t, err, "test containers 'unit', 'e2e' failed")
+}
@@ -0,0 +1,341 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/format"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// testServiceRunLabel identifies the pods of the services started by an execution of okteto test
+	testServiceRunLabel = "dev.okteto.com/test-service-run"
+
+	// testServiceLabel contains the name of the service in the manifest
+	testServiceLabel = "dev.okteto.com/test-service"
+
+	// testServiceTestLabel contains the name of the test container the service was started for
+	testServiceTestLabel = "dev.okteto.com/test-service-test"
+
+	// defaultTestServiceStartTimeout is the maximum time to wait for the services of a test container to be ready
+	defaultTestServiceStartTimeout = 5 * time.Minute
+
+	// testServiceCleanupTimeout is the maximum time to remove the services of a test container
+	testServiceCleanupTimeout = 30 * time.Second
+
+	testServicePollInterval = time.Second
+
+	testServiceSuffixLength = 5
+
+	testServiceRunIDLength = 8
+
+	// maxTestServicePrefixLength keeps the names of the pods under the 63 characters allowed by kubernetes
+	maxTestServicePrefixLength = 63 - testServiceSuffixLength - 1
+)
+
+// testServicesRunner starts the services of the test containers as pods in the namespace, and removes
+// them once the test container finishes
+type testServicesRunner struct {
+	c            kubernetes.Interface
+	namespace    string
+	devenv       string
+	runID        string
+	pollInterval time.Duration
+	startTimeout time.Duration
+
+	// started keeps the services started by startOnce, by test container
+	started map[string]*startedServices
+	mu      sync.Mutex
+}
+
+// startedServices are the services of a test container kept between the iterations of watch mode
+type startedServices struct {
+	stop  func()
+	hosts []model.Host
+}
+
+func newTestServicesRunner(c kubernetes.Interface, namespace, devenv, runID string) *testServicesRunner {
+	return &testServicesRunner{
+		c:            c,
+		namespace:    namespace,
+		devenv:       devenv,
+		runID:        runID,
+		pollInterval: testServicePollInterval,
+		startTimeout: defaultTestServiceStartTimeout,
+		started:      map[string]*startedServices{},
+	}
+}
+
+// startOnce starts the services of the test container the first time it runs, and returns the same
+// hosts on the next runs, so watch mode doesn't start them again on every change. They are removed by stopAll
+func (ts *testServicesRunner) startOnce(ctx context.Context, test string, services map[string]*model.TestService, ioCtrl *io.Controller) ([]model.Host, error) {
+	ts.mu.Lock()
+	started, ok := ts.started[test]
+	ts.mu.Unlock()
+	if ok {
+		return started.hosts, nil
+	}
+
+	hosts, stop, err := ts.start(ctx, test, services, ioCtrl)
+	if err != nil {
+		return nil, err
+	}
+	ts.mu.Lock()
+	ts.started[test] = &startedServices{hosts: hosts, stop: stop}
+	ts.mu.Unlock()
+	return hosts, nil
+}
+
+// stopAll removes the services started by startOnce
+func (ts *testServicesRunner) stopAll() {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for test, started := range ts.started {
+		started.stop()
+		delete(ts.started, test)
+	}
+}
+
+// start runs the services of the test container and waits until all of them are ready. It returns
+// the hosts to reach each service by its name, and the function removing them. If any service fails
+// to start, the ones already started are removed
+func (ts *testServicesRunner) start(ctx context.Context, test string, services map[string]*model.TestService, ioCtrl *io.Controller) ([]model.Host, func(), error) {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	suffix := utilrand.String(testServiceSuffixLength)
+	pods := make([]string, 0, len(names))
+	stop := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), testServiceCleanupTimeout)
+		defer cancel()
+		for _, pod := range pods {
+			err := ts.c.CoreV1().Pods(ts.namespace).Delete(ctx, pod, metav1.DeleteOptions{})
+			if err != nil && !oktetoErrors.IsNotFound(err) {
+				ioCtrl.Logger().Infof("failed to remove service pod '%s': %s", pod, err)
+			}
+		}
+	}
+
+	for _, name := range names {
+		pod := ts.translate(test, name, suffix, services[name])
+		ioCtrl.Logger().Infof("starting service '%s' of test container '%s'", name, test)
+		if _, err := ts.c.CoreV1().Pods(ts.namespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
+			stop()
+			return nil, nil, fmt.Errorf("failed to start service '%s': %w", name, err)
+		}
+		pods = append(pods, pod.Name)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, ts.startTimeout)
+	defer cancel()
+
+	hosts := make([]model.Host, 0, len(names))
+	for i, name := range names {
+		ip, err := ts.waitUntilReady(waitCtx, pods[i])
+		if err != nil {
+			stop()
+			return nil, nil, fmt.Errorf("service '%s' of test container '%s' is not ready: %w", name, test, err)
+		}
+		ioCtrl.Out().Success("Service '%s' of test container '%s' is ready", name, test)
+		hosts = append(hosts, model.Host{Hostname: name, IP: ip})
+	}
+	return hosts, stop, nil
+}
+
+// waitUntilReady waits until the pod is ready and returns its IP
+func (ts *testServicesRunner) waitUntilReady(ctx context.Context, name string) (string, error) {
+	ticker := time.NewTicker(ts.pollInterval)
+	defer ticker.Stop()
+	for {
+		pod, err := ts.c.CoreV1().Pods(ts.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return "", err
+		}
+		if err := podStartError(pod); err != nil {
+			return "", err
+		}
+		if isPodReady(pod) && pod.Status.PodIP != "" {
+			return pod.Status.PodIP, nil
+		}
+		select {
+		case <-ctx.Done():
+			if ctx.Err() == context.DeadlineExceeded {
+				return "", fmt.Errorf("timed out waiting for the healthcheck to pass")
+			}
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// translate returns the pod running the service. Services without a healthcheck are ready once
+// they accept connections on their first port
+func (ts *testServicesRunner) translate(test, name, suffix string, svc *model.TestService) *apiv1.Pod {
+	prefix := format.ResourceK8sMetaString(fmt.Sprintf("%s-%s-%s", ts.devenv, test, name))
+	if len(prefix) > maxTestServicePrefixLength {
+		prefix = strings.TrimSuffix(prefix[:maxTestServicePrefixLength], "-")
+	}
+
+	container := apiv1.Container{
+		Name:  name,
+		Image: svc.Image,
+	}
+	for _, v := range svc.Environment {
+		container.Env = append(container.Env, apiv1.EnvVar{Name: v.Name, Value: v.Value})
+	}
+	for _, port := range svc.Ports {
+		container.Ports = append(container.Ports, apiv1.ContainerPort{ContainerPort: port})
+	}
+
+	switch {
+	case svc.Healthcheck != nil && !svc.Healthcheck.Disable:
+		probe := translateTestServiceProbe(svc.Healthcheck)
+		if svc.Healthcheck.Readiness {
+			container.ReadinessProbe = probe
+		}
+		if svc.Healthcheck.Liveness {
+			container.LivenessProbe = probe
+		}
+	case svc.Healthcheck == nil && len(svc.Ports) > 0:
+		container.ReadinessProbe = &apiv1.Probe{
+			ProbeHandler: apiv1.ProbeHandler{
+				TCPSocket: &apiv1.TCPSocketAction{Port: intstr.FromInt32(svc.Ports[0])},
+			},
+			PeriodSeconds: 1,
+		}
+	}
+
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", prefix, suffix),
+			Namespace: ts.namespace,
+			Labels: map[string]string{
+				testServiceRunLabel:   ts.runID,
+				testServiceLabel:      name,
+				testServiceTestLabel:  format.ResourceK8sMetaString(test),
+				model.DeployedByLabel: format.ResourceK8sMetaString(ts.devenv),
+			},
+		},
+		Spec: apiv1.PodSpec{
+			Containers:                    []apiv1.Container{container},
+			RestartPolicy:                 apiv1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: new(int64),
+		},
+	}
+}
+
+// testServiceProbeCommand returns the command of a healthcheck test in the compose forms
+// ["CMD", "<bin>", "<args>..."] and ["CMD-SHELL", "<command>"], which runs in a shell
+func testServiceProbeCommand(test model.HealtcheckTest) []string {
+	switch test[0] {
+	case "CMD":
+		return test[1:]
+	case "CMD-SHELL":
+		return []string{"sh", "-c", strings.Join(test[1:], " ")}
+	}
+	return test
+}
+
+func translateTestServiceProbe(healthcheck *model.HealthCheck) *apiv1.Probe {
+	var handler apiv1.ProbeHandler
+	if len(healthcheck.Test) != 0 {
+		handler = apiv1.ProbeHandler{
+			Exec: &apiv1.ExecAction{Command: testServiceProbeCommand(healthcheck.Test)},
+		}
+	} else {
+		handler = apiv1.ProbeHandler{
+			HTTPGet: &apiv1.HTTPGetAction{
+				Path: healthcheck.HTTP.Path,
+				Port: intstr.FromInt32(healthcheck.HTTP.Port),
+			},
+		}
+	}
+	return &apiv1.Probe{
+		ProbeHandler:        handler,
+		TimeoutSeconds:      int32(healthcheck.Timeout.Seconds()),
+		PeriodSeconds:       int32(healthcheck.Interval.Seconds()),
+		FailureThreshold:    int32(healthcheck.Retries),
+		InitialDelaySeconds: int32(healthcheck.StartPeriod.Seconds()),
+	}
+}
+
+// cleanup removes the services started by the execution, for the ones that were not removed
+// because okteto test was interrupted
+func (ts *testServicesRunner) cleanup(ctx context.Context) error {
+	return ts.c.CoreV1().Pods(ts.namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", testServiceRunLabel, ts.runID),
+	})
+}
+
+func isPodReady(pod *apiv1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == apiv1.PodReady {
+			return condition.Status == apiv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// podStartError returns an error if the pod of a service won't ever be ready
+func podStartError(pod *apiv1.Pod) error {
+	switch pod.Status.Phase {
+	case apiv1.PodFailed, apiv1.PodSucceeded:
+		return fmt.Errorf("the container exited")
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting == nil {
+			continue
+		}
+		switch status.State.Waiting.Reason {
+		case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CreateContainerConfigError":
+			return fmt.Errorf("%s: %s", status.State.Waiting.Reason, status.State.Waiting.Message)
+		}
+	}
+	return nil
+}
+
+// cleanupTestServices removes the services started by the execution when okteto test is interrupted
+func cleanupTestServices(runID string, ioCtrl *io.Controller) {
+	if runID == "" || !okteto.IsContextInitialized() {
+		return
+	}
+	c, _, err := okteto.NewK8sClientProvider().Provide(okteto.GetContext().Cfg)
+	if err != nil {
+		ioCtrl.Logger().Infof("failed to remove the services of the test containers: %s", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testServiceCleanupTimeout)
+	defer cancel()
+	if err := newTestServicesRunner(c, okteto.GetContext().Namespace, "", runID).cleanup(ctx); err != nil {
+		ioCtrl.Logger().Infof("failed to remove the services of the test containers: %s", err)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/env"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newFakeServicesClient returns a client where the pods of the services get the given status
+func newFakeServicesClient(status func(pod *apiv1.Pod) apiv1.PodStatus) *fake.Clientset {
+	c := fake.NewClientset()
+	c.PrependReactor("get", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		get := action.(k8stesting.GetAction)
+		obj, err := c.Tracker().Get(apiv1.SchemeGroupVersion.WithResource("pods"), get.GetNamespace(), get.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		pod := obj.(*apiv1.Pod).DeepCopy()
+		pod.Status = status(pod)
+		return true, pod, nil
+	})
+	return c
+}
+
+func readyPodStatus(pod *apiv1.Pod) apiv1.PodStatus {
+	return apiv1.PodStatus{
+		Phase: apiv1.PodRunning,
+		PodIP: fmt.Sprintf("10.0.0.%d", len(pod.Labels[testServiceLabel])),
+		Conditions: []apiv1.PodCondition{
+			{Type: apiv1.PodReady, Status: apiv1.ConditionTrue},
+		},
+	}
+}
+
+func TestTestServicesStart(t *testing.T) {
+	c := newFakeServicesClient(readyPodStatus)
+	runner := newTestServicesRunner(c, "ns", "my-app", "run1")
+	runner.pollInterval = time.Millisecond
+
+	hosts, stop, err := runner.start(context.Background(), "integration", map[string]*model.TestService{
+		"db":     {Image: "postgres:16", Ports: []int32{5432}},
+		"broker": {Image: "redis:7"},
+	}, io.NewIOController())
+	require.NoError(t, err)
+	assert.Equal(t, []model.Host{
+		{Hostname: "broker", IP: "10.0.0.6"},
+		{Hostname: "db", IP: "10.0.0.2"},
+	}, hosts)
+
+	pods, err := c.CoreV1().Pods("ns").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 2)
+	for _, pod := range pods.Items {
+		assert.True(t, strings.HasPrefix(pod.Name, "my-app-integration-"))
+		assert.Equal(t, "run1", pod.Labels[testServiceRunLabel])
+		assert.Equal(t, "integration", pod.Labels[testServiceTestLabel])
+		assert.Equal(t, "my-app", pod.Labels[model.DeployedByLabel])
+	}
+
+	stop()
+	pods, err = c.CoreV1().Pods("ns").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+}
+
+func TestTestServicesStartOnce(t *testing.T) {
+	c := newFakeServicesClient(readyPodStatus)
+	runner := newTestServicesRunner(c, "ns", "my-app", "run1")
+	runner.pollInterval = time.Millisecond
+	services := map[string]*model.TestService{"db": {Image: "postgres:16"}}
+
+	hosts, err := runner.startOnce(context.Background(), "integration", services, io.NewIOController())
+	require.NoError(t, err)
+	again, err := runner.startOnce(context.Background(), "integration", services, io.NewIOController())
+	require.NoError(t, err)
+	assert.Equal(t, hosts, again)
+
+	pods, err := c.CoreV1().Pods("ns").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 1)
+
+	runner.stopAll()
+	pods, err = c.CoreV1().Pods("ns").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+}
+
+func TestTestServicesStartFails(t *testing.T) {
+	c := newFakeServicesClient(func(pod *apiv1.Pod) apiv1.PodStatus {
+		if pod.Labels[testServiceLabel] == "db" {
+			return apiv1.PodStatus{
+				Phase: apiv1.PodPending,
+				ContainerStatuses: []apiv1.ContainerStatus{
+					{State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "not found"}}},
+				},
+			}
+		}
+		return readyPodStatus(pod)
+	})
+	runner := newTestServicesRunner(c, "ns", "my-app", "run1")
+	runner.pollInterval = time.Millisecond
+
+	_, _, err := runner.start(context.Background(), "integration", map[string]*model.TestService{
+		"db":     {Image: "postgres:missing"},
+		"broker": {Image: "redis:7"},
+	}, io.NewIOController())
+	require.ErrorContains(t, err, "service 'db' of test container 'integration' is not ready: ErrImagePull: not found")
+
+	// the services already started are removed
+	pods, err := c.CoreV1().Pods("ns").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+}
+
+func TestTestServicesStartTimeout(t *testing.T) {
+	c := newFakeServicesClient(func(*apiv1.Pod) apiv1.PodStatus {
+		return apiv1.PodStatus{Phase: apiv1.PodRunning}
+	})
+	runner := newTestServicesRunner(c, "ns", "my-app", "run1")
+	runner.pollInterval = time.Millisecond
+	runner.startTimeout = 10 * time.Millisecond
+
+	_, _, err := runner.start(context.Background(), "integration", map[string]*model.TestService{
+		"db": {Image: "postgres:16"},
+	}, io.NewIOController())
+	require.ErrorContains(t, err, "timed out waiting for the healthcheck to pass")
+}
+
+func TestTestServicesTranslate(t *testing.T) {
+	runner := newTestServicesRunner(fake.NewClientset(), "ns", "my-app", "run1")
+
+	pod := runner.translate("integration", "db", "abcde", &model.TestService{
+		Image:       "postgres:16",
+		Environment: env.Environment{{Name: "POSTGRES_PASSWORD", Value: "password"}},
+		Ports:       []int32{5432},
+	})
+	assert.Equal(t, "my-app-integration-db-abcde", pod.Name)
+	require.Len(t, pod.Spec.Containers, 1)
+	container := pod.Spec.Containers[0]
+	assert.Equal(t, "db", container.Name)
+	assert.Equal(t, []apiv1.EnvVar{{Name: "POSTGRES_PASSWORD", Value: "password"}}, container.Env)
+	require.NotNil(t, container.ReadinessProbe)
+	assert.Equal(t, int32(5432), container.ReadinessProbe.TCPSocket.Port.IntVal)
+
+	pod = runner.translate(strings.Repeat("long-test-name", 5), "db", "abcde", &model.TestService{
+		Image: "postgres:16",
+		Healthcheck: &model.HealthCheck{
+			Test:      model.HealtcheckTest{"pg_isready"},
+			Interval:  5 * time.Second,
+			Retries:   10,
+			Readiness: true,
+		},
+	})
+	assert.LessOrEqual(t, len(pod.Name), 63)
+	assert.True(t, strings.HasSuffix(pod.Name, "-abcde"))
+	probe := pod.Spec.Containers[0].ReadinessProbe
+	require.NotNil(t, probe)
+	assert.Equal(t, []string{"pg_isready"}, probe.Exec.Command)
+	assert.Equal(t, int32(5), probe.PeriodSeconds)
+	assert.Equal(t, int32(10), probe.FailureThreshold)
+	assert.Nil(t, pod.Spec.Containers[0].LivenessProbe)
+}
+
+func TestTranslateTestServiceProbeCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		test     model.HealtcheckTest
+		expected []string
+	}{
+		{
+			name:     "exec",
+			test:     model.HealtcheckTest{"pg_isready", "-U", "postgres"},
+			expected: []string{"pg_isready", "-U", "postgres"},
+		},
+		{
+			name:     "CMD",
+			test:     model.HealtcheckTest{"CMD", "pg_isready", "-U", "postgres"},
+			expected: []string{"pg_isready", "-U", "postgres"},
+		},
+		{
+			name:     "CMD-SHELL",
+			test:     model.HealtcheckTest{"CMD-SHELL", "pg_isready -U postgres || exit 1"},
+			expected: []string{"sh", "-c", "pg_isready -U postgres || exit 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			probe := translateTestServiceProbe(&model.HealthCheck{Test: tt.test})
+			require.NotNil(t, probe.Exec)
+			assert.Equal(t, tt.expected, probe.Exec.Command)
+		})
+	}
+}
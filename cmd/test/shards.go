@@ -0,0 +1,169 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	stdio "io"
+	"maps"
+	"path"
+	"strconv"
+	"sync"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/remote"
+)
+
+// paramsRunner runs a test container in Remote Execution
+type paramsRunner interface {
+	Run(ctx context.Context, params *remote.Params) error
+}
+
+// newParamsRunnerFn returns the runner of a test container. concurrent is true when other
+// test containers run at the same time
+type newParamsRunnerFn func(ioCtrl *io.Controller, concurrent bool) paramsRunner
+
+// reusableRunners keeps the runner of every test container and shard, so the runs after the first
+// one, like the retries and the iterations of watch mode, reuse its Remote Execution environment
+// instead of bootstrapping a new one
+type reusableRunners struct {
+	newRunner newParamsRunnerFn
+	runners   map[string]*reusableRunner
+	mu        sync.Mutex
+}
+
+type reusableRunner struct {
+	runner  paramsRunner
+	capture *captureSwitch
+}
+
+func newReusableRunners(newRunner newParamsRunnerFn) *reusableRunners {
+	return &reusableRunners{
+		newRunner: newRunner,
+		runners:   map[string]*reusableRunner{},
+	}
+}
+
+// get returns the runner identified by key, creating it the first time. The runner records its
+// output into the captured output of ioCtrl, which changes on every run
+func (rr *reusableRunners) get(key string, ioCtrl *io.Controller, concurrent bool) paramsRunner {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+	r, ok := rr.runners[key]
+	if !ok {
+		r = &reusableRunner{capture: &captureSwitch{}}
+		r.runner = rr.newRunner(ioCtrl.WithCapture(r.capture), concurrent)
+		rr.runners[key] = r
+	}
+	r.capture.set(ioCtrl.Capture())
+	return r.runner
+}
+
+// captureSwitch writes into the captured output of the current run of a reused runner
+type captureSwitch struct {
+	w  stdio.Writer
+	mu sync.Mutex
+}
+
+func (c *captureSwitch) set(w stdio.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.w = w
+}
+
+func (c *captureSwitch) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.w == nil {
+		return len(p), nil
+	}
+	return c.w.Write(p)
+}
+
+// runShards runs every shard of a test container, at the same time unless sequential is set.
+// The test container fails if any of its shards fails, once all of them finished
+func runShards(ctx context.Context, name string, total int, params *remote.Params, ioCtrl *io.Controller, newRunner newParamsRunnerFn, sequential bool) error {
+	errs := make([]error, total)
+	parallelism := total
+	if sequential {
+		parallelism = 1
+	}
+	semaphore := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	wg.Add(total)
+	for i := 0; i < total; i++ {
+		go func(index int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			shardIO := ioCtrl.WithPrefix(fmt.Sprintf("[%s shard %d/%d] ", name, index+1, total))
+			errs[index] = newRunner(shardIO, !sequential).Run(ctx, shardParams(params, index, total))
+			if errs[index] != nil {
+				shardIO.Out().Warning("Shard %d/%d of test container '%s' failed", index+1, total, name)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shardParams returns the params to run the shard index of a test container split in total shards
+func shardParams(params *remote.Params, index, total int) *remote.Params {
+	shard := *params
+	shard.OktetoCommandSpecificEnvVars = maps.Clone(params.OktetoCommandSpecificEnvVars)
+	if shard.OktetoCommandSpecificEnvVars == nil {
+		shard.OktetoCommandSpecificEnvVars = map[string]string{}
+	}
+	shard.OktetoCommandSpecificEnvVars[constants.OktetoTestShardIndexEnvVar] = strconv.Itoa(index)
+	shard.OktetoCommandSpecificEnvVars[constants.OktetoTestShardTotalEnvVar] = strconv.Itoa(total)
+	shard.Artifacts = make([]model.Artifact, 0, len(params.Artifacts))
+	for _, artifact := range params.Artifacts {
+		shard.Artifacts = append(shard.Artifacts, model.Artifact{
+			Path:        artifact.Path,
+			Destination: shardDestination(artifact.Destination, index),
+		})
+	}
+	return &shard
+}
+
+// shardArtifacts returns where the artifacts of a test container are exported, taking its shards into account
+func shardArtifacts(artifacts []model.Artifact, shards int) []model.Artifact {
+	if shards <= 1 {
+		return artifacts
+	}
+	result := make([]model.Artifact, 0, len(artifacts)*shards)
+	for i := 0; i < shards; i++ {
+		for _, artifact := range artifacts {
+			result = append(result, model.Artifact{
+				Path:        artifact.Path,
+				Destination: shardDestination(artifact.Destination, i),
+			})
+		}
+	}
+	return result
+}
+
+// shardDestination returns the destination of an artifact exported by the shard index
+func shardDestination(destination string, index int) string {
+	return path.Join(fmt.Sprintf("shard-%d", index), destination)
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/remote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeShardRunner records the params of every shard and fails the shards in failIndex
+type fakeShardRunner struct {
+	params    map[string]*remote.Params
+	failIndex string
+	mu        sync.Mutex
+}
+
+func (r *fakeShardRunner) Run(_ context.Context, params *remote.Params) error {
+	index := params.OktetoCommandSpecificEnvVars[constants.OktetoTestShardIndexEnvVar]
+	r.mu.Lock()
+	r.params[index] = params
+	r.mu.Unlock()
+	if index == r.failIndex {
+		return errors.New("shard failed")
+	}
+	return nil
+}
+
+func TestRunShards(t *testing.T) {
+	runner := &fakeShardRunner{params: map[string]*remote.Params{}, failIndex: "-1"}
+	concurrent := true
+	var mu sync.Mutex
+	newRunner := func(ioCtrl *io.Controller, c bool) paramsRunner {
+		mu.Lock()
+		defer mu.Unlock()
+		concurrent = concurrent && c && ioCtrl.Prefix() != ""
+		return runner
+	}
+	params := &remote.Params{
+		TestName:                     "e2e",
+		OktetoCommandSpecificEnvVars: map[string]string{constants.CIEnvVar: "true"},
+		Artifacts:                    []model.Artifact{{Path: "reports", Destination: "reports"}},
+	}
+
+	require.NoError(t, runShards(context.Background(), "e2e", 3, params, io.NewIOController(), newRunner, false))
+
+	require.Len(t, runner.params, 3)
+	assert.True(t, concurrent)
+	shard := runner.params["2"]
+	assert.Equal(t, "3", shard.OktetoCommandSpecificEnvVars[constants.OktetoTestShardTotalEnvVar])
+	assert.Equal(t, "true", shard.OktetoCommandSpecificEnvVars[constants.CIEnvVar])
+	assert.Equal(t, []model.Artifact{{Path: "reports", Destination: "shard-2/reports"}}, shard.Artifacts)
+
+	// the original params are not modified
+	assert.Len(t, params.OktetoCommandSpecificEnvVars, 1)
+	assert.Equal(t, "reports", params.Artifacts[0].Destination)
+}
+
+func TestRunShardsFails(t *testing.T) {
+	runner := &fakeShardRunner{params: map[string]*remote.Params{}, failIndex: "1"}
+	newRunner := func(*io.Controller, bool) paramsRunner { return runner }
+
+	err := runShards(context.Background(), "e2e", 2, &remote.Params{}, io.NewIOController(), newRunner, false)
+
+	require.EqualError(t, err, "shard failed")
+	assert.Len(t, runner.params, 2)
+}
+
+// fakeCapturingRunner writes into the captured output of the controller it was created with
+type fakeCapturingRunner struct {
+	ioCtrl *io.Controller
+	runs   int
+}
+
+func (r *fakeCapturingRunner) Run(_ context.Context, _ *remote.Params) error {
+	r.runs++
+	fmt.Fprintf(r.ioCtrl.Capture(), "run %d\n", r.runs)
+	return nil
+}
+
+func TestReusableRunners(t *testing.T) {
+	created := 0
+	runners := newReusableRunners(func(ioCtrl *io.Controller, _ bool) paramsRunner {
+		created++
+		return &fakeCapturingRunner{ioCtrl: ioCtrl}
+	})
+
+	first := &bytes.Buffer{}
+	require.NoError(t, runners.get("unit", io.NewIOController().WithCapture(first), false).Run(context.Background(), &remote.Params{}))
+	second := &bytes.Buffer{}
+	require.NoError(t, runners.get("unit", io.NewIOController().WithCapture(second), false).Run(context.Background(), &remote.Params{}))
+	require.NoError(t, runners.get("e2e", io.NewIOController(), false).Run(context.Background(), &remote.Params{}))
+
+	assert.Equal(t, 2, created)
+	assert.Equal(t, "run 1\n", first.String())
+	assert.Equal(t, "run 2\n", second.String())
+}
+
+func TestShardArtifacts(t *testing.T) {
+	artifacts := []model.Artifact{{Path: "coverage.out", Destination: "coverage.out"}}
+	assert.Equal(t, artifacts, shardArtifacts(artifacts, 0))
+	assert.Equal(t, []model.Artifact{
+		{Path: "coverage.out", Destination: "shard-0/coverage.out"},
+		{Path: "coverage.out", Destination: "shard-1/coverage.out"},
+	}, shardArtifacts(artifacts, 2))
+}
@@ -0,0 +1,304 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/moby/patternmatcher"
+	"github.com/okteto/okteto/pkg/dag"
+	"github.com/okteto/okteto/pkg/ignore"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+)
+
+// defaultWatchDebounce is the time to wait for more changes before re-running the test containers
+const defaultWatchDebounce = 500 * time.Millisecond
+
+// fileWatcher abstracts fsnotify so the watch loop can be tested
+type fileWatcher interface {
+	Add(name string) error
+	Close() error
+	GetEventChannel() chan fsnotify.Event
+	GetErrorChannel() chan error
+}
+
+type fsnotifyFileWatcher struct {
+	watcher *fsnotify.Watcher
+}
+
+func newFsnotifyFileWatcher() (fileWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return fsnotifyFileWatcher{watcher: watcher}, nil
+}
+
+func (w fsnotifyFileWatcher) Add(name string) error {
+	return w.watcher.Add(name)
+}
+
+func (w fsnotifyFileWatcher) Close() error {
+	return w.watcher.Close()
+}
+
+func (w fsnotifyFileWatcher) GetEventChannel() chan fsnotify.Event {
+	return w.watcher.Events
+}
+
+func (w fsnotifyFileWatcher) GetErrorChannel() chan error {
+	return w.watcher.Errors
+}
+
+// watchedTest is a test container whose context is watched for changes
+type watchedTest struct {
+	matcher    *patternmatcher.PatternMatcher
+	name       string
+	contextDir string
+	// outputs are the paths written by okteto test (artifacts and reports). Changes on them don't
+	// re-run the test container, otherwise every run would trigger a new one
+	outputs []string
+}
+
+func newWatchedTest(name, contextDir string, ignoreRules, outputs []string) (*watchedTest, error) {
+	matcher, err := patternmatcher.New(ignoreRules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ignore rules for test container '%s': %w", name, err)
+	}
+	return &watchedTest{
+		name:       name,
+		contextDir: filepath.Clean(contextDir),
+		matcher:    matcher,
+		outputs:    outputs,
+	}, nil
+}
+
+// isAffectedBy returns if a change on path must re-run the test container
+func (wt *watchedTest) isAffectedBy(path string) bool {
+	rel, err := filepath.Rel(wt.contextDir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	if rel == ".git" || strings.HasPrefix(rel, ".git/") {
+		return false
+	}
+	for _, output := range wt.outputs {
+		if isSubPath(output, path) {
+			return false
+		}
+	}
+	if rel == "." {
+		return true
+	}
+	ignored, err := wt.matcher.MatchesOrParentMatches(rel)
+	if err != nil {
+		return true
+	}
+	return !ignored
+}
+
+// mustWatchDir returns if changes inside dir could affect the test container
+func (wt *watchedTest) mustWatchDir(dir string) bool {
+	if wt.isAffectedBy(dir) {
+		return true
+	}
+	// a dir in the context of the test could be ignored while some of its files are not
+	return wt.matcher.Exclusions() && isSubPath(wt.contextDir, dir) && !isSubPath(filepath.Join(wt.contextDir, ".git"), dir)
+}
+
+// isSubPath returns if path is parent or equal to child
+func isSubPath(parent, child string) bool {
+	rel, err := filepath.Rel(parent, child)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// testWatcher re-runs the test containers affected by the changes on their contexts
+type testWatcher struct {
+	watcher  fileWatcher
+	tree     *dag.Tree
+	ioCtrl   *io.Controller
+	tests    []*watchedTest
+	debounce time.Duration
+}
+
+// affected returns the test containers that must run again after the changes on paths, including
+// the ones depending on them, ordered by dependsOn
+func (tw *testWatcher) affected(paths []string) ([]string, error) {
+	var names []string
+	seen := map[string]bool{}
+	for _, p := range paths {
+		for _, test := range tw.tests {
+			if seen[test.name] || !test.isAffectedBy(p) {
+				continue
+			}
+			seen[test.name] = true
+			names = append(names, test.name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	return tw.tree.Descendants(names...)
+}
+
+// addDir watches dir and its subdirectories that could affect any of the test containers
+func (tw *testWatcher) addDir(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			// the dir could be removed while walking it
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if !tw.mustWatchDir(path) {
+			return filepath.SkipDir
+		}
+		if err := tw.watcher.Add(path); err != nil {
+			return fmt.Errorf("could not watch '%s': %w", path, err)
+		}
+		return nil
+	})
+}
+
+func (tw *testWatcher) mustWatchDir(dir string) bool {
+	for _, test := range tw.tests {
+		if test.mustWatchDir(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch calls run with the affected test containers every time their contexts change, until ctx is done
+func (tw *testWatcher) Watch(ctx context.Context, run func(ctx context.Context, tests []string)) error {
+	defer func() {
+		if err := tw.watcher.Close(); err != nil {
+			tw.ioCtrl.Logger().Infof("could not close test watcher: %s", err)
+		}
+	}()
+
+	contexts := map[string]bool{}
+	for _, test := range tw.tests {
+		if contexts[test.contextDir] {
+			continue
+		}
+		contexts[test.contextDir] = true
+		if err := tw.addDir(test.contextDir); err != nil {
+			return err
+		}
+	}
+
+	tw.ioCtrl.Out().Infof("Watching for changes...")
+	timer := time.NewTimer(tw.debounce)
+	timer.Stop()
+	changes := map[string]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case e, ok := <-tw.watcher.GetEventChannel():
+			if !ok {
+				return nil
+			}
+			if e.Op == fsnotify.Chmod {
+				continue
+			}
+			if e.Has(fsnotify.Create) {
+				if info, err := os.Stat(e.Name); err == nil && info.IsDir() {
+					if err := tw.addDir(e.Name); err != nil {
+						tw.ioCtrl.Logger().Infof("%s", err)
+					}
+				}
+			}
+			changes[e.Name] = true
+			timer.Reset(tw.debounce)
+		case err, ok := <-tw.watcher.GetErrorChannel():
+			if !ok {
+				return nil
+			}
+			tw.ioCtrl.Logger().Infof("error watching test contexts: %s", err)
+		case <-timer.C:
+			paths := make([]string, 0, len(changes))
+			for p := range changes {
+				paths = append(paths, p)
+			}
+			sort.Strings(paths)
+			changes = map[string]bool{}
+
+			tests, err := tw.affected(paths)
+			if err != nil {
+				return err
+			}
+			if len(tests) == 0 {
+				continue
+			}
+			tw.ioCtrl.Logger().Infof("changes detected: %s", strings.Join(paths, ", "))
+			tw.ioCtrl.Out().Infof("Changes detected, running test containers: %s", strings.Join(tests, ", "))
+			run(ctx, tests)
+			if ctx.Err() != nil {
+				return nil
+			}
+			tw.ioCtrl.Out().Infof("Watching for changes...")
+		}
+	}
+}
+
+// newTestWatcher returns a watcher for the contexts of tests, using the same ignore rules as okteto test
+func newTestWatcher(manifest *model.Manifest, tree *dag.Tree, tests []string, cwd string, options *Options, ioCtrl *io.Controller) (*testWatcher, error) {
+	tw := &testWatcher{
+		tree:     tree,
+		ioCtrl:   ioCtrl,
+		debounce: defaultWatchDebounce,
+	}
+	for _, name := range tests {
+		test := manifest.Test[name]
+		contextDir := filepath.Join(cwd, test.Context)
+
+		ig := ignore.NewOktetoIgnorer(filepath.Join(contextDir, model.IgnoreFilename))
+		ignoreRules, err := ig.Rules(ignore.RootSection, "test", fmt.Sprintf("test.%s", name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create ignore rules for %s: %w", name, err)
+		}
+
+		wt, err := newWatchedTest(name, contextDir, ignoreRules, testOutputs(test, contextDir, options.reportTargets))
+		if err != nil {
+			return nil, err
+		}
+		tw.tests = append(tw.tests, wt)
+	}
+
+	watcher, err := newFsnotifyFileWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("could not create test watcher: %w", err)
+	}
+	tw.watcher = watcher
+	return tw, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/okteto/okteto/pkg/dag"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFileWatcher struct {
+	events chan fsnotify.Event
+	errors chan error
+	added  []string
+}
+
+func (fw *fakeFileWatcher) Add(name string) error {
+	fw.added = append(fw.added, name)
+	return nil
+}
+
+func (*fakeFileWatcher) Close() error { return nil }
+
+func (fw *fakeFileWatcher) GetEventChannel() chan fsnotify.Event { return fw.events }
+
+func (fw *fakeFileWatcher) GetErrorChannel() chan error { return fw.errors }
+
+func newFakeTestWatcher(t *testing.T, root string) *testWatcher {
+	t.Helper()
+	tests := model.ManifestTests{
+		"unit":        &model.Test{Context: "api"},
+		"integration": &model.Test{Context: "api", DependsOn: []string{"unit"}},
+		"e2e":         &model.Test{Context: "frontend"},
+	}
+	var nodes []dag.Node
+	for name, test := range tests {
+		nodes = append(nodes, Node{test, name})
+	}
+	tree, err := dag.From(nodes...)
+	require.NoError(t, err)
+
+	unit, err := newWatchedTest("unit", filepath.Join(root, "api"), []string{"docs"}, []string{filepath.Join(root, "api", "reports")})
+	require.NoError(t, err)
+	integration, err := newWatchedTest("integration", filepath.Join(root, "api"), []string{"docs"}, nil)
+	require.NoError(t, err)
+	e2e, err := newWatchedTest("e2e", filepath.Join(root, "frontend"), nil, nil)
+	require.NoError(t, err)
+
+	return &testWatcher{
+		watcher:  &fakeFileWatcher{events: make(chan fsnotify.Event), errors: make(chan error)},
+		tree:     tree,
+		ioCtrl:   io.NewIOController(),
+		tests:    []*watchedTest{unit, integration, e2e},
+		debounce: 10 * time.Millisecond,
+	}
+}
+
+func TestTestWatcherAffected(t *testing.T) {
+	root := t.TempDir()
+	tw := newFakeTestWatcher(t, root)
+
+	tt := []struct {
+		name     string
+		paths    []string
+		expected []string
+	}{
+		{
+			name:     "change in shared context",
+			paths:    []string{filepath.Join(root, "api", "main.go")},
+			expected: []string{"unit", "integration"},
+		},
+		{
+			name:     "change in another context",
+			paths:    []string{filepath.Join(root, "frontend", "index.js")},
+			expected: []string{"e2e"},
+		},
+		{
+			name:  "ignored change",
+			paths: []string{filepath.Join(root, "api", "docs", "README.md")},
+		},
+		{
+			name:     "change in the artifacts of a test",
+			paths:    []string{filepath.Join(root, "api", "reports", "junit.xml")},
+			expected: []string{"integration"},
+		},
+		{
+			name:  "change in git metadata",
+			paths: []string{filepath.Join(root, "frontend", ".git", "index")},
+		},
+		{
+			name:  "change outside of the contexts",
+			paths: []string{filepath.Join(root, "okteto.yml")},
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tw.affected(tc.paths)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestTestWatcherWatch(t *testing.T) {
+	root := t.TempDir()
+	tw := newFakeTestWatcher(t, root)
+	fw := tw.watcher.(*fakeFileWatcher)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	runs := make(chan []string)
+	exit := make(chan error)
+	go func() {
+		exit <- tw.Watch(ctx, func(_ context.Context, tests []string) {
+			runs <- tests
+		})
+	}()
+
+	fw.events <- fsnotify.Event{Name: filepath.Join(root, "api", "docs", "index.md"), Op: fsnotify.Write}
+	fw.events <- fsnotify.Event{Name: filepath.Join(root, "frontend", "app.js"), Op: fsnotify.Write}
+	fw.events <- fsnotify.Event{Name: filepath.Join(root, "frontend", "app.js"), Op: fsnotify.Write}
+	assert.Equal(t, []string{"e2e"}, <-runs)
+
+	fw.events <- fsnotify.Event{Name: filepath.Join(root, "api", "main.go"), Op: fsnotify.Write}
+	assert.Equal(t, []string{"unit", "integration"}, <-runs)
+
+	cancel()
+	require.NoError(t, <-exit)
+}
@@ -57,6 +57,9 @@ const (
 func (up *upContext) activate() error {
 
 	oktetoLog.Infof("activating development container retry=%t", up.isRetry)
+	if !up.isRetry {
+		up.emitLifecycleEvent(lifecycleActivating, "Activating development container '%s'", up.Dev.Name)
+	}
 
 	if err := config.UpdateStateFile(up.Dev.Name, up.Namespace, config.Activating); err != nil {
 		return err
@@ -165,6 +168,7 @@ func (up *upContext) activate() error {
 	}
 	go up.cleanCommand(ctx)
 
+	up.emitLifecycleEvent(lifecycleSynchronizing, "Synchronizing the files of '%s'", up.Dev.Name)
 	if err := up.sync(ctx); err != nil {
 		if up.shouldRetry(ctx, err) {
 			return oktetoErrors.ErrLostSyncthing
@@ -212,6 +216,12 @@ func (up *upContext) activate() error {
 
 		go TrackLatestBranchOnDevContainer(ctx, up.Namespace, up.Manifest, up.Options.ManifestPathFlag, up.K8sClientProvider)
 
+		if up.Options.SyncOnly {
+			// the up command keeps running until it is stopped or the connection is lost
+			up.syncOnlyReady()
+			return
+		}
+
 		startRunCommand := time.Now()
 		up.CommandResult <- up.RunCommand(ctx, up.Dev.Command.Values)
 		up.analyticsMeta.ExecDuration(time.Since(startRunCommand))
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/okteto/okteto/pkg/config"
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
 	forwardk8s "github.com/okteto/okteto/pkg/k8s/forward"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
@@ -47,7 +48,9 @@ func (up *upContext) forwards(ctx context.Context) error {
 	}
 
 	oktetoLog.Infof("starting port forwards")
-	up.Forwarder = forwardk8s.NewPortForwardManager(ctx, up.Dev.Interface, restConfig, k8sClient, up.Namespace)
+	pf := forwardk8s.NewPortForwardManager(ctx, up.Dev.Interface, restConfig, k8sClient, up.Namespace)
+	pf.SetStatusTracker(up.newForwardStatusTracker())
+	up.Forwarder = pf
 
 	for idx, f := range up.Dev.Forward {
 		if f.Labels != nil {
@@ -76,6 +79,7 @@ func (up *upContext) forwards(ctx context.Context) error {
 		return err
 	}
 
+	resetGlobalForwards(up.Manifest.GlobalForward)
 	if isNeededGlobalForwarder(up.Manifest.GlobalForward) {
 		up.GlobalForwarderStatus = make(chan error, 1)
 		go up.setGlobalForwardsIfRequiredLoop(ctx)
@@ -101,7 +105,9 @@ func (up *upContext) sshForwards(ctx context.Context) error {
 		return err
 	}
 
-	up.Forwarder = ssh.NewForwardManager(ctx, fmt.Sprintf(":%d", up.Dev.RemotePort), up.Dev.Interface, "0.0.0.0", f, up.Namespace)
+	fm := ssh.NewForwardManager(ctx, fmt.Sprintf(":%d", up.Dev.RemotePort), up.Dev.Interface, "0.0.0.0", f, up.Namespace)
+	fm.SetStatusTracker(up.newForwardStatusTracker())
+	up.Forwarder = fm
 	if err := up.Forwarder.Add(forward.Forward{Local: up.Sy.RemotePort, Remote: syncthing.ClusterPort}); err != nil {
 		return err
 	}
@@ -114,6 +120,12 @@ func (up *upContext) sshForwards(ctx context.Context) error {
 		return err
 	}
 
+	if up.Dev.Socks > 0 {
+		if err := up.Forwarder.AddSocks(up.Dev.Socks); err != nil {
+			return err
+		}
+	}
+
 	err = up.Forwarder.Start(up.Pod.Name, up.Namespace)
 	if err != nil {
 		return err
@@ -124,6 +136,7 @@ func (up *upContext) sshForwards(ctx context.Context) error {
 		return fmt.Errorf("failed to add entry to your SSH config file")
 	}
 
+	resetGlobalForwards(up.Manifest.GlobalForward)
 	if isNeededGlobalForwarder(up.Manifest.GlobalForward) {
 		up.GlobalForwarderStatus = make(chan error, 1)
 		go up.setGlobalForwardsIfRequiredLoop(ctx)
@@ -136,14 +149,15 @@ func addToForwarder(up *upContext) error {
 	ticker := time.NewTicker(1 * time.Second)
 	to := time.NewTicker(10 * time.Second)
 	var forwardErr error
-	alreadyAdded := map[int]bool{}
+	alreadyAdded := map[string]bool{}
+	alreadyAddedReverse := map[string]bool{}
 	for {
 		select {
 		case <-ticker.C:
 			forwardErr = nil
 
 			for idx, f := range up.Dev.Forward {
-				if _, ok := alreadyAdded[f.Local]; ok {
+				if _, ok := alreadyAdded[f.String()]; ok {
 					continue
 				}
 				if f.Labels != nil {
@@ -155,21 +169,21 @@ func addToForwarder(up *upContext) error {
 					}
 					up.Dev.Forward[idx] = forwardWithServiceName
 					f = forwardWithServiceName
-					alreadyAdded[f.Local] = true
+					alreadyAdded[f.String()] = true
 				}
 				if err := up.Forwarder.Add(f); err != nil {
 					oktetoLog.Infof("could not create forward port: %s", err)
 					forwardErr = err
 					continue
 				}
-				alreadyAdded[f.Local] = true
+				alreadyAdded[f.String()] = true
 			}
 			if forwardErr != nil {
 				continue
 			}
 
 			for _, r := range up.Dev.Reverse {
-				if _, ok := alreadyAdded[r.Local]; ok {
+				if _, ok := alreadyAddedReverse[r.String()]; ok {
 					continue
 				}
 				if err := up.Forwarder.AddReverse(r); err != nil {
@@ -177,7 +191,7 @@ func addToForwarder(up *upContext) error {
 					forwardErr = err
 					continue
 				}
-				alreadyAdded[r.Local] = true
+				alreadyAddedReverse[r.String()] = true
 			}
 
 			if forwardErr != nil {
@@ -220,6 +234,39 @@ func (up *upContext) setGlobalForwardsIfRequiredLoop(ctx context.Context) {
 	}
 }
 
+// resetGlobalForwards marks the global forwards as not added. They belong to the forwarder, which is created again on every activation
+func resetGlobalForwards(globalForwards []forward.GlobalForward) {
+	for i := range globalForwards {
+		globalForwards[i].IsAdded = false
+	}
+}
+
+// newForwardStatusTracker returns a tracker that saves the health of the global forwards for 'okteto status --forwards'
+// and displays their state changes
+func (up *upContext) newForwardStatusTracker() *forward.StatusTracker {
+	var tracker *forward.StatusTracker
+	tracker = forward.NewStatusTracker(func(previous forward.State, current forward.Status) {
+		if err := config.UpdateForwardsFile(up.Dev.Name, up.Namespace, tracker.List()); err != nil {
+			oktetoLog.Infof("failed to update forwards file: %s", err)
+		}
+		printForwardStatus(previous, current)
+	})
+	return tracker
+}
+
+func printForwardStatus(previous forward.State, current forward.Status) {
+	switch current.State {
+	case forward.StateConnected:
+		if previous != "" {
+			oktetoLog.Success("Global forward %s reconnected", current.Forward)
+		}
+	case forward.StateReconnecting:
+		oktetoLog.Yellow("Global forward %s lost its connection, reconnecting...", current.Forward)
+	case forward.StateFailed:
+		oktetoLog.Warning("Global forward %s failed after %d attempts: %s. Okteto keeps retrying in the background", current.Forward, current.Retries, current.Error)
+	}
+}
+
 func isNeededGlobalForwarder(globalForwards []forward.GlobalForward) bool {
 	for _, f := range globalForwards {
 		if !f.IsAdded {
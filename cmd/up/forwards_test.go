@@ -59,6 +59,19 @@ func TestGlobalForwarderStartsWhenRequired(t *testing.T) {
 	}
 }
 
+func TestResetGlobalForwards(t *testing.T) {
+	globalForwards := []forward.GlobalForward{
+		{Local: 8080, Remote: 8080, ServiceName: "api", IsAdded: true},
+		{Local: 5432, Remote: 5432, ServiceName: "db", IsAdded: true},
+	}
+
+	resetGlobalForwards(globalForwards)
+	assert.True(t, isNeededGlobalForwarder(globalForwards))
+	for _, gf := range globalForwards {
+		assert.False(t, gf.IsAdded)
+	}
+}
+
 func TestGlobalForwarderAddsProperlyPortsToForward(t *testing.T) {
 	f := ssh.NewForwardManager(context.Background(), ":8080", "0.0.0.0", "0.0.0.0", nil, "test")
 
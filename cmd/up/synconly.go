@@ -0,0 +1,125 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/okteto/okteto/pkg/config"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+)
+
+// lifecycle events printed by 'okteto up --sync-only'. They are the stage of the logs in the 'json' log output
+const (
+	lifecycleActivating    = "activating"
+	lifecycleReconnecting  = "reconnecting"
+	lifecycleSynchronizing = "synchronizing"
+	lifecycleReady         = "ready"
+	lifecycleStopping      = "stopping"
+	lifecycleStopped       = "stopped"
+	lifecycleFailed        = "failed"
+
+	detachFlag = "--detach"
+)
+
+var errDetachWithoutSyncOnly = oktetoErrors.UserError{
+	E:    errors.New("'--detach' is only supported with '--sync-only'"),
+	Hint: "Run 'okteto up --sync-only --detach' to synchronize your files in the background",
+}
+
+// validateSyncOnly checks the options of the sync-only mode. command is the command given after '--'
+func validateSyncOnly(opts *Options, command []string) error {
+	if opts.Detach && !opts.SyncOnly {
+		return errDetachWithoutSyncOnly
+	}
+	if opts.SyncOnly && len(command) > 0 {
+		return oktetoErrors.UserError{
+			E:    errors.New("'--sync-only' doesn't run a command in your development container"),
+			Hint: "Remove the command or use 'okteto exec' to run it",
+		}
+	}
+	return nil
+}
+
+// emitLifecycleEvent prints a lifecycle event of the sync-only mode in the format of '--log-output'
+func (up *upContext) emitLifecycleEvent(event, format string, args ...interface{}) {
+	if up.Options == nil || !up.Options.SyncOnly {
+		return
+	}
+	oktetoLog.SetStage(event)
+	oktetoLog.Information(format, args...)
+}
+
+// syncOnlyReady marks the development container as ready instead of running its command
+func (up *upContext) syncOnlyReady() {
+	if err := config.UpdateStateFile(up.Dev.Name, up.Namespace, config.Ready); err != nil {
+		oktetoLog.Infof("failed to update state file: %s", err)
+	}
+	up.emitLifecycleEvent(lifecycleReady, "Files of '%s' are synchronized, watching for changes", up.Dev.Name)
+}
+
+// detach runs 'okteto up' again in the background, without '--detach', writing its logs to a file
+func detach(args []string) error {
+	logFile, err := os.CreateTemp(config.GetOktetoHome(), "up-*.log")
+	if err != nil {
+		return fmt.Errorf("failed to create log file: %w", err)
+	}
+	defer func() {
+		if err := logFile.Close(); err != nil {
+			oktetoLog.Infof("failed to close log file: %s", err)
+		}
+	}()
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to get the okteto executable: %w", err)
+	}
+
+	cmd := exec.Command(executable, withoutDetachFlag(args)...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.SysProcAttr = detachedProcAttr()
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start okteto up in the background: %w", err)
+	}
+
+	pid := cmd.Process.Pid
+	if err := cmd.Process.Release(); err != nil {
+		oktetoLog.Infof("failed to release process %d: %s", pid, err)
+	}
+
+	oktetoLog.Success("Running 'okteto up --sync-only' in the background with PID %d", pid)
+	oktetoLog.Information("Logs are available at %s", logFile.Name())
+	oktetoLog.Information("Stop it by terminating process %d", pid)
+	return nil
+}
+
+func withoutDetachFlag(args []string) []string {
+	result := []string{}
+	for i, arg := range args {
+		if arg == "--" {
+			return append(result, args[i:]...)
+		}
+		if arg == detachFlag || strings.HasPrefix(arg, detachFlag+"=") {
+			continue
+		}
+		result = append(result, arg)
+	}
+	return result
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package up
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/analytics"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSyncOnly(t *testing.T) {
+	tests := []struct {
+		opts      *Options
+		name      string
+		command   []string
+		expectErr bool
+	}{
+		{
+			name: "default",
+			opts: &Options{},
+		},
+		{
+			name:    "command-without-sync-only",
+			opts:    &Options{},
+			command: []string{"bash"},
+		},
+		{
+			name: "sync-only",
+			opts: &Options{SyncOnly: true},
+		},
+		{
+			name: "sync-only-detached",
+			opts: &Options{SyncOnly: true, Detach: true},
+		},
+		{
+			name:      "detach-without-sync-only",
+			opts:      &Options{Detach: true},
+			expectErr: true,
+		},
+		{
+			name:      "sync-only-with-command",
+			opts:      &Options{SyncOnly: true},
+			command:   []string{"npm", "start"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSyncOnly(tt.opts, tt.command)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWithoutDetachFlag(t *testing.T) {
+	args := []string{"up", "api", "--sync-only", "--detach", "--detach=true", "--log-output", "json", "--", "--detach"}
+	expected := []string{"up", "api", "--sync-only", "--log-output", "json", "--", "--detach"}
+	assert.Equal(t, expected, withoutDetachFlag(args))
+}
+
+func TestEmitLifecycleEvent(t *testing.T) {
+	b := bytes.NewBuffer(nil)
+	oktetoLog.SetOutputFormat(oktetoLog.JSONFormat)
+	oktetoLog.SetOutput(b)
+	t.Cleanup(func() {
+		oktetoLog.SetOutputFormat(oktetoLog.TTYFormat)
+		oktetoLog.SetOutput(os.Stdout)
+		oktetoLog.SetStage("")
+	})
+
+	up := &upContext{Options: &Options{}, Dev: &model.Dev{Name: "api"}}
+	up.emitLifecycleEvent(lifecycleReady, "Files of '%s' are synchronized", up.Dev.Name)
+	assert.Empty(t, b.String())
+
+	up.Options.SyncOnly = true
+	up.emitLifecycleEvent(lifecycleReady, "Files of '%s' are synchronized", up.Dev.Name)
+
+	event := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(b.Bytes(), &event))
+	assert.Equal(t, lifecycleReady, event["stage"])
+	assert.Contains(t, event["message"], "Files of 'api' are synchronized")
+}
+
+func TestShutdownBeforeActivation(t *testing.T) {
+	up := &upContext{
+		Options:       &Options{SyncOnly: true},
+		Dev:           &model.Dev{Name: "api"},
+		analyticsMeta: analytics.NewUpMetricsMetadata(),
+	}
+	done := make(chan struct{})
+	go func() {
+		up.shutdown()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("shutdown blocked before the first activation")
+	}
+}
@@ -93,6 +93,11 @@ func (up *upContext) sync(ctx context.Context) error {
 
 	go up.Sy.Monitor(ctx, up.Disconnect)
 	go up.Sy.MonitorStatus(ctx, up.Disconnect)
+	if up.Dev.Sync.KeepConflicts {
+		go up.Sy.MonitorConflicts(ctx, func(c syncthing.Conflict) {
+			oktetoLog.Warning("Synchronization conflict on '%s'. Run 'okteto sync conflicts' to resolve it", c.RelativePath())
+		})
+	}
 	oktetoLog.Infof("restarting syncthing to update sync mode to sendreceive")
 	return up.Sy.Restart(ctx)
 }
@@ -113,6 +113,7 @@ type upContext struct {
 type forwarder interface {
 	Add(forward.Forward) error
 	AddReverse(model.Reverse) error
+	AddSocks(int) error
 	Start(string, string) error
 	StartGlobalForwarding() error
 	Stop()
@@ -17,6 +17,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -34,6 +35,7 @@ import (
 	"github.com/okteto/okteto/cmd/utils"
 	"github.com/okteto/okteto/pkg/analytics"
 	buildCmd "github.com/okteto/okteto/pkg/cmd/build"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
 	"github.com/okteto/okteto/pkg/config"
 	"github.com/okteto/okteto/pkg/constants"
 	"github.com/okteto/okteto/pkg/devenvironment"
@@ -65,6 +67,9 @@ const (
 	ReconnectingMessage = "Trying to reconnect to your cluster. File synchronization will automatically resume when the connection improves."
 
 	composeVolumesUrl = "https://www.okteto.com/docs/reference/docker-compose/#volumes-string-optional"
+
+	// defaultSocksPort is the port of the SOCKS5 proxy when the --socks flag doesn't have a value
+	defaultSocksPort = 1080
 )
 
 var (
@@ -85,9 +90,12 @@ type Options struct {
 	DevName      string
 	Envs         []string
 	Remote       int
+	Socks        int
 	Deploy       bool
 	ForcePull    bool
 	Reset        bool
+	SyncOnly     bool
+	Detach       bool
 }
 
 // Up starts a development container
@@ -101,12 +109,33 @@ okteto up api --deploy
 
 # 'okteto up' replacing the command defined in the Okteto Manifest
 okteto up api -- echo this is a test
+
+# 'okteto up' synchronizing files and forwarding ports without opening a shell, for process managers and IDE tasks.
+# It prints lifecycle events in the '--log-output' format and shuts down cleanly on SIGTERM
+okteto up api --sync-only --log-output json
+
+# 'okteto up' synchronizing files in the background
+okteto up api --sync-only --detach
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if okteto.InDevContainer() {
 				return oktetoErrors.ErrNotInDevContainer
 			}
 
+			var command []string
+			if n := cmd.ArgsLenAtDash(); n >= 0 {
+				command = args[n:]
+			}
+			if err := validateSyncOnly(upOptions, command); err != nil {
+				return err
+			}
+			if upOptions.Detach {
+				return detach(os.Args[1:])
+			}
+			if upOptions.SyncOnly {
+				oktetoLog.DisableSpinner()
+			}
+
 			u := utils.UpgradeAvailable()
 			if len(u) > 0 {
 				warningFolder := filepath.Join(config.GetOktetoHome(), ".warnings")
@@ -241,7 +270,9 @@ okteto up api -- echo this is a test
 				builder:           buildv2.NewBuilderFromScratch(ioCtrl, onBuildFinish, buildCmd.GetBuildkitConnector(&okteto.ContextStateless{Store: okteto.GetContextStore()}, ioCtrl, at)),
 				autoDown:          newAutoDown(ioCtrl, k8sLogger, at, upMeta),
 			}
-			up.inFd, up.isTerm = term.GetFdInfo(os.Stdin)
+			if !upOptions.SyncOnly {
+				up.inFd, up.isTerm = term.GetFdInfo(os.Stdin)
+			}
 			if up.isTerm {
 				var err error
 				up.stateTerm, err = term.SaveState(up.inFd)
@@ -282,6 +313,12 @@ okteto up api -- echo this is a test
 			if err != nil {
 				return err
 			}
+			if upOptions.SyncOnly && dev.IsHybridModeEnabled() {
+				return oktetoErrors.UserError{
+					E:    errors.New("'--sync-only' is not supported in hybrid mode"),
+					Hint: "Remove the 'mode: hybrid' field of your development container or run 'okteto up' without '--sync-only'",
+				}
+			}
 
 			upStartedRepoURL, err := modelutils.GetRepositoryURL(oktetoManifest.ManifestPath)
 			if err != nil {
@@ -321,6 +358,10 @@ okteto up api -- echo this is a test
 				return err
 			}
 
+			if err := addOutputsToEnvironment(ctx, dev, up.Manifest.Name, k8sClient); err != nil {
+				oktetoLog.Infof("could not get the outputs of '%s': %s", up.Manifest.Name, err)
+			}
+
 			if err := loadManifestOverrides(dev, upOptions); err != nil {
 				return err
 			}
@@ -381,12 +422,16 @@ okteto up api -- echo this is a test
 	cmd.Flags().StringVarP(&upOptions.K8sContext, "context", "c", "", "overwrite the current Okteto Context")
 	cmd.Flags().StringArrayVarP(&upOptions.Envs, "env", "e", []string{}, "set environment variable in the Development Container")
 	cmd.Flags().IntVarP(&upOptions.Remote, "remote", "r", 0, "exposes the SSH server in a given port")
+	cmd.Flags().IntVarP(&upOptions.Socks, "socks", "", 0, fmt.Sprintf("exposes a SOCKS5 proxy to the namespace in a given port (%d by default)", defaultSocksPort))
+	cmd.Flags().Lookup("socks").NoOptDefVal = strconv.Itoa(defaultSocksPort)
 	cmd.Flags().BoolVarP(&upOptions.Deploy, "deploy", "d", false, "force the redeployment of your Development Environment")
 	cmd.Flags().BoolVarP(&upOptions.ForcePull, "pull", "", false, "force the Development Container image to be pulled")
 	if err := cmd.Flags().MarkHidden("pull"); err != nil {
 		oktetoLog.Infof("failed to mark 'pull' flag as hidden: %s", err)
 	}
 	cmd.Flags().BoolVarP(&upOptions.Reset, "reset", "", false, "resets the file synchronization service. Use it if the file synchronization service stops working")
+	cmd.Flags().BoolVarP(&upOptions.SyncOnly, "sync-only", "", false, "synchronize files and forward ports without running the command of the Development Container or attaching a terminal")
+	cmd.Flags().BoolVarP(&upOptions.Detach, "detach", "", false, "run 'okteto up --sync-only' in the background")
 	return cmd
 }
 
@@ -395,6 +440,10 @@ func loadManifestOverrides(dev *model.Dev, upOptions *Options) error {
 		dev.RemotePort = upOptions.Remote
 	}
 
+	if upOptions.Socks > 0 {
+		dev.Socks = upOptions.Socks
+	}
+
 	if dev.RemoteModeEnabled() {
 		if err := sshKeys(); err != nil {
 			return err
@@ -437,6 +486,28 @@ func setSyncDefaultsByDevMode(dev *model.Dev, getSyncTempDir func() (string, err
 	return nil
 }
 
+// addOutputsToEnvironment adds the outputs of the last deploy of the development environment to the environment
+// of the development container. The variables defined in the dev section take precedence over the outputs
+func addOutputsToEnvironment(ctx context.Context, dev *model.Dev, devenvName string, c kubernetes.Interface) error {
+	outputs, err := pipeline.GetOutputs(ctx, devenvName, okteto.GetContext().Namespace, c)
+	if err != nil {
+		return err
+	}
+	pipeline.MaskSensitiveOutputs(outputs)
+
+	defined := map[string]bool{}
+	for _, v := range dev.Environment {
+		defined[v.Name] = true
+	}
+	for _, o := range outputs {
+		if defined[o.Name] {
+			continue
+		}
+		dev.Environment = append(dev.Environment, env.Var{Name: o.Name, Value: o.Value})
+	}
+	return nil
+}
+
 func getOverridedEnvVarsFromCmd(manifestEnvVars env.Environment, commandEnvVariables []string) (*env.Environment, error) {
 	envVarsToValues := make(map[string]string)
 	for _, manifestEnv := range manifestEnvVars {
@@ -512,18 +583,21 @@ func (up *upContext) start() error {
 	select {
 	case <-stop:
 		oktetoLog.Infof("CTRL+C received, starting shutdown sequence")
+		up.emitLifecycleEvent(lifecycleStopping, "Stopping the synchronization of '%s'", up.Dev.Name)
 		up.interruptReceived = true
 		up.shutdown()
 
 		if err := up.autoDown.run(context.Background(), up.Dev, up.Namespace, up.Manifest.Name, k8sClient); err != nil {
 			return err
 		}
+		up.emitLifecycleEvent(lifecycleStopped, "Synchronization of '%s' stopped", up.Dev.Name)
 		oktetoLog.Println()
 	case err := <-up.Exit:
 		if up.Dev.IsHybridModeEnabled() {
 			up.shutdownHybridMode()
 		}
 		if err != nil {
+			up.emitLifecycleEvent(lifecycleFailed, "Synchronization of '%s' failed: %s", up.Dev.Name, err)
 			oktetoLog.Warning("Exited without running okteto down. Your dev environment is still active. Run okteto down to clean it up and free resources.")
 			oktetoLog.Infof("exit signal received due to error: %s", err)
 			return err
@@ -552,6 +626,9 @@ func (up *upContext) activateLoop() {
 		if err := config.DeleteStateFile(up.Dev.Name, up.Namespace); err != nil {
 			oktetoLog.Infof("failed to delete state file: %s", err)
 		}
+		if err := config.DeleteForwardsFile(up.Dev.Name, up.Namespace); err != nil {
+			oktetoLog.Infof("failed to delete forwards file: %s", err)
+		}
 	}()
 	for {
 		if up.isRetry || isTransientError {
@@ -573,6 +650,7 @@ func (up *upContext) activateLoop() {
 			}
 			if iter == 0 {
 				oktetoLog.Yellow("Connection lost to your development container, reconnecting...")
+				up.emitLifecycleEvent(lifecycleReconnecting, "Reconnecting to '%s'", up.Dev.Name)
 			}
 			iter++
 			iter = iter % 10
@@ -615,8 +693,12 @@ func (up *upContext) activateLoop() {
 
 // waitUntilExitOrInterruptOrApply blocks execution until a stop signal is sent, a disconnect event or an error or the app is modify
 func (up *upContext) waitUntilExitOrInterruptOrApply(ctx context.Context) error {
-	// only for unix because Windows does not support SIGTTIN and SIGTTOU
-	goToBackground := getSendToBackgroundSignals()
+	// only for unix because Windows does not support SIGTTIN and SIGTTOU.
+	// The sync-only mode doesn't use the terminal, so it can run in the background
+	var goToBackground chan os.Signal
+	if !up.Options.SyncOnly {
+		goToBackground = getSendToBackgroundSignals()
+	}
 	for {
 		select {
 		case err := <-up.CommandResult:
@@ -743,6 +825,10 @@ func (up *upContext) shutdown() {
 	}
 
 	oktetoLog.Info("completed shutdown sequence")
+	if up.ShutdownCompleted == nil {
+		// a signal was received before the first activation started
+		return
+	}
 	up.ShutdownCompleted <- true
 
 }
@@ -839,6 +925,10 @@ func printDisplayContext(up *upContext) {
 		}
 	}
 
+	if up.Dev.Socks > 0 {
+		oktetoLog.Println(fmt.Sprintf("    %s    %s", oktetoLog.BlueString("SOCKS5:"), net.JoinHostPort(up.Dev.Interface, strconv.Itoa(up.Dev.Socks))))
+	}
+
 	if len(up.Dev.Reverse) > 0 {
 		oktetoLog.Println(fmt.Sprintf("    %s   %d <- %d", oktetoLog.BlueString("Reverse:"), up.Dev.Reverse[0].Local, up.Dev.Reverse[0].Remote))
 		for i := 1; i < len(up.Dev.Reverse); i++ {
@@ -22,6 +22,7 @@ import (
 	"github.com/okteto/okteto/internal/test"
 	"github.com/okteto/okteto/internal/test/client"
 	buildCmd "github.com/okteto/okteto/pkg/cmd/build"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
 	"github.com/okteto/okteto/pkg/constants"
 	"github.com/okteto/okteto/pkg/env"
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
@@ -268,6 +269,42 @@ func TestEnvVarIsAddedProperlyToDevContainerWhenIsSetFromCmd(t *testing.T) {
 	}
 }
 
+func TestAddOutputsToEnvironment(t *testing.T) {
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: "test",
+			},
+		},
+		CurrentContext: "test",
+	}
+	cmap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pipeline.TranslatePipelineName("movies"),
+			Namespace: "test",
+		},
+	}
+	c := fake.NewSimpleClientset(cmap)
+	outputs := []pipeline.Output{
+		{Name: "DATABASE_HOST", Value: "postgres"},
+		{Name: "DATABASE_PASSWORD", Value: "s3cr3t-value", Sensitive: true},
+	}
+	require.NoError(t, pipeline.UpdateOutputs(context.Background(), "movies", "test", outputs, c))
+
+	dev := &model.Dev{
+		Environment: env.Environment{
+			{Name: "DATABASE_HOST", Value: "localhost"},
+		},
+	}
+	require.NoError(t, addOutputsToEnvironment(context.Background(), dev, "movies", c))
+
+	expected := env.Environment{
+		{Name: "DATABASE_HOST", Value: "localhost"},
+		{Name: "DATABASE_PASSWORD", Value: "s3cr3t-value"},
+	}
+	assert.Equal(t, expected, dev.Environment)
+}
+
 func TestEnvVarIsNotAddedWhenHasBuiltInOktetoEnvVarsFormat(t *testing.T) {
 	var tests = []struct {
 		dev                     *model.Dev
@@ -22,6 +22,11 @@ import (
 	"syscall"
 )
 
+// detachedProcAttr starts the process in a new session, so it doesn't receive the signals of the terminal
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
+
 func getSendToBackgroundSignals() chan os.Signal {
 	goToBg := make(chan os.Signal, 1)
 	signal.Notify(goToBg, syscall.SIGTTIN, syscall.SIGTTOU)
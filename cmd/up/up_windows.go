@@ -18,8 +18,17 @@ package up
 
 import (
 	"os"
+	"syscall"
 )
 
+// detachedProcessFlag starts the process without a console
+const detachedProcessFlag = 0x00000008
+
+// detachedProcAttr starts the process without a console, in its own process group
+func detachedProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP | detachedProcessFlag}
+}
+
 func getSendToBackgroundSignals() chan os.Signal {
 	return nil
 }
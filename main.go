@@ -28,8 +28,10 @@ import (
 	"github.com/okteto/okteto/cmd"
 	"github.com/okteto/okteto/cmd/build"
 	contextCMD "github.com/okteto/okteto/cmd/context"
+	"github.com/okteto/okteto/cmd/cp"
 	"github.com/okteto/okteto/cmd/deploy"
 	"github.com/okteto/okteto/cmd/destroy"
+	"github.com/okteto/okteto/cmd/divert"
 	"github.com/okteto/okteto/cmd/exec"
 	"github.com/okteto/okteto/cmd/kubetoken"
 	"github.com/okteto/okteto/cmd/logs"
@@ -38,6 +40,7 @@ import (
 	"github.com/okteto/okteto/cmd/preview"
 	"github.com/okteto/okteto/cmd/registrytoken"
 	"github.com/okteto/okteto/cmd/remoterun"
+	syncCMD "github.com/okteto/okteto/cmd/sync"
 	"github.com/okteto/okteto/cmd/test"
 	"github.com/okteto/okteto/cmd/up"
 	"github.com/okteto/okteto/pkg/analytics"
@@ -164,13 +167,17 @@ func main() {
 	root.AddCommand(up.Up(at, insights, ioController, k8sLogger, fs))
 	root.AddCommand(cmd.Down(at, k8sLogger, fs))
 	root.AddCommand(cmd.Status(fs))
+	root.AddCommand(syncCMD.Sync())
 	root.AddCommand(cmd.Doctor(k8sLogger, fs))
 	root.AddCommand(exec.NewExec(fs, ioController, k8sClientProvider).Cmd(ctx))
+	root.AddCommand(cp.Cp(ctx, k8sLogger, fs))
 	root.AddCommand(preview.Preview(ctx, at))
 	root.AddCommand(cmd.Restart(fs))
 	root.AddCommand(deploy.Deploy(ctx, at, insights, ioController, k8sLogger))
 	root.AddCommand(destroy.Destroy(ctx, at, insights, ioController, k8sLogger, fs))
 	root.AddCommand(deploy.Endpoints(ctx, k8sLogger))
+	root.AddCommand(deploy.Rollback(ctx, at, insights, ioController, k8sLogger))
+	root.AddCommand(divert.Divert(ctx, ioController, k8sLogger))
 	root.AddCommand(logs.Logs(ctx, k8sLogger, fs))
 	root.AddCommand(generateFigSpec.NewCmdGenFigSpec())
 	root.AddCommand(remoterun.RemoteRun(ctx, k8sLogger, ioController))
@@ -47,6 +47,10 @@ func (e CommandErr) Error() string {
 	return fmt.Sprintf("error on stage %s: %s", e.Stage, e.Err.Error())
 }
 
+func (e CommandErr) Unwrap() error {
+	return e.Err
+}
+
 var (
 	oktetoRemoteCLIRepository      = "okteto/okteto"
 	oktetoPipelineRunnerRepository = "okteto/pipeline-runner"
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/moby/buildkit/client"
+	"github.com/okteto/okteto/pkg/analytics"
 	"github.com/okteto/okteto/pkg/build"
 	"github.com/okteto/okteto/pkg/build/buildkit"
 	"github.com/okteto/okteto/pkg/build/buildkit/connector"
@@ -153,6 +154,12 @@ func NewOktetoBuilder(okCtx OktetoContextInterface, fs afero.Fs, logger *io.Cont
 	}
 }
 
+// Fork returns a new builder with the same context and filesystem but its own buildkit connection
+// and metadata. Each build starts and stops its connector, so builds running concurrently can't share it.
+func (ob *OktetoBuilder) Fork(logger *io.Controller) *OktetoBuilder {
+	return NewOktetoBuilder(ob.OktetoContext, ob.Fs, logger, GetBuildkitConnector(ob.OktetoContext, logger, analytics.NewAnalyticsTracker()))
+}
+
 func (ob *OktetoBuilder) GetBuilder() string {
 	return ob.OktetoContext.GetCurrentBuilder()
 }
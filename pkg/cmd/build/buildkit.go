@@ -133,7 +133,7 @@ func SolveBuild(ctx context.Context, c *client.Client, opt *client.SolveOpt, pro
 			}
 			return err
 		case DeployOutputModeOnBuild, DestroyOutputModeOnBuild, TestOutputModeOnBuild:
-			err := deployDisplayer(context.TODO(), plainChannel, &types.BuildOptions{OutputMode: progress})
+			err := deployDisplayer(context.TODO(), plainChannel, &types.BuildOptions{OutputMode: progress}, ioCtrl)
 			commandFailChannel <- err
 			return err
 		default:
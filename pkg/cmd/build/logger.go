@@ -17,13 +17,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	stdio "io"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/moby/buildkit/client"
 	"github.com/okteto/okteto/pkg/build/buildkit"
+	"github.com/okteto/okteto/pkg/deployable"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/log/io"
 	"github.com/okteto/okteto/pkg/types"
 	"github.com/tonistiigi/units"
 )
@@ -37,16 +40,21 @@ const (
 	largeContextThreshold = 50000000
 )
 
-func deployDisplayer(ctx context.Context, ch chan *client.SolveStatus, o *types.BuildOptions) error {
+// deployDisplayer displays the output of the commands executed remotely. When ioCtrl has a prefix,
+// the command runs concurrently with others: every line is prefixed and the shared spinner and stage
+// are left untouched. When ioCtrl captures the output, every line is also recorded
+func deployDisplayer(ctx context.Context, ch chan *client.SolveStatus, o *types.BuildOptions, ioCtrl *io.Controller) error {
 	// TODO: import build timeout
 	timeout := time.NewTicker(10 * time.Minute)
 	defer timeout.Stop()
 
-	oktetoLog.Spinner("Synchronizing context...")
-	oktetoLog.StartSpinner()
-	defer oktetoLog.StopSpinner()
-
-	t := newTrace()
+	t := newTrace(ioCtrl.Prefix())
+	t.capture = ioCtrl.Capture()
+	t.spinner("Synchronizing context...")
+	if t.prefix == "" {
+		oktetoLog.StartSpinner()
+		defer oktetoLog.StopSpinner()
+	}
 
 	var done bool
 	var outputMode string
@@ -65,7 +73,7 @@ func deployDisplayer(ctx context.Context, ch chan *client.SolveStatus, o *types.
 	for {
 		select {
 		case <-ctx.Done():
-			oktetoLog.StopSpinner()
+			t.stopSpinner()
 			return ctx.Err()
 		case <-timeout.C:
 		case ss, ok := <-ch:
@@ -80,7 +88,7 @@ func deployDisplayer(ctx context.Context, ch chan *client.SolveStatus, o *types.
 				done = true
 			}
 			if done {
-				oktetoLog.StopSpinner()
+				t.stopSpinner()
 				if t.err != nil {
 					return t.err
 				}
@@ -91,18 +99,55 @@ func deployDisplayer(ctx context.Context, ch chan *client.SolveStatus, o *types.
 }
 
 type trace struct {
-	err           error
-	ongoing       map[string]*vertexInfo
-	stages        map[string]bool
-	showCtxAdvice bool
+	err     error
+	ongoing map[string]*vertexInfo
+	stages  map[string]bool
+	capture stdio.Writer
+	prefix  string
+	// failedTestCommand is the command that made the test container fail
+	failedTestCommand string
+	showCtxAdvice     bool
 }
 
-func newTrace() *trace {
+func newTrace(prefix string) *trace {
 	return &trace{
 		ongoing:       map[string]*vertexInfo{},
 		stages:        map[string]bool{},
 		showCtxAdvice: true,
+		prefix:        prefix,
+	}
+}
+
+// spinner updates the message of the shared spinner, unless the output is prefixed
+func (t *trace) spinner(msg string) {
+	if t.prefix != "" {
+		return
+	}
+	oktetoLog.Spinner(msg)
+}
+
+// stopSpinner stops the shared spinner, unless the output is prefixed
+func (t *trace) stopSpinner() {
+	if t.prefix != "" {
+		return
 	}
+	oktetoLog.StopSpinner()
+}
+
+// record writes the message into the captured output, if any
+func (t *trace) record(msg string) {
+	if t.capture == nil {
+		return
+	}
+	fmt.Fprintln(t.capture, oktetoLog.Redact(msg))
+}
+
+// setStage sets the global stage, unless the output is prefixed
+func (t *trace) setStage(stage string) {
+	if t.prefix != "" {
+		return
+	}
+	oktetoLog.SetStage(stage)
 }
 
 func (t *trace) update(ss *client.SolveStatus) error {
@@ -155,17 +200,17 @@ func (t *trace) display(progress string) {
 					t.showCtxAdvice = false
 					oktetoLog.Information("You can use '.oktetoignore' file to optimize the context used to deploy your development environment.")
 				}
-				oktetoLog.Spinner(fmt.Sprintf("Synchronizing context: %.2f", currentLoadedCtx))
+				t.spinner(fmt.Sprintf("Synchronizing context: %.2f", currentLoadedCtx))
 			}
 		}
 		if hasCommandLogs(v) {
 			switch progress {
 			case DeployOutputModeOnBuild:
-				oktetoLog.Spinner("Deploying your development environment...")
+				t.spinner("Deploying your development environment...")
 			case DestroyOutputModeOnBuild:
-				oktetoLog.Spinner("Destroying your development environment...")
+				t.spinner("Destroying your development environment...")
 			case TestOutputModeOnBuild:
-				oktetoLog.Spinner("Running tests...")
+				t.spinner("Running tests...")
 			}
 
 			for _, log := range v.logs {
@@ -182,13 +227,16 @@ func (t *trace) display(progress string) {
 					oktetoLog.Infof("received log without stage: %s", text.Message)
 					continue
 				}
-				oktetoLog.SetStage(text.Stage)
+				t.setStage(text.Stage)
 				switch text.Stage {
 				case "done":
 					continue
+				case deployable.FailedTestCommandStage:
+					t.failedTestCommand = text.Message
 				case "Load manifest":
 					if text.Level == "error" {
-						oktetoLog.Fail("%s", text.Message)
+						t.record(text.Message)
+						oktetoLog.Fail("%s%s", t.prefix, text.Message)
 					}
 				default:
 					// Print the information message about the stage if needed
@@ -198,22 +246,27 @@ func (t *trace) display(progress string) {
 						}
 						t.stages[text.Stage] = true
 					}
+					t.record(text.Message)
 					if text.Level == "error" {
 						if text.Stage != "" {
-							t.err = buildkit.CommandErr{
+							cmdErr := buildkit.CommandErr{
 								Stage:  text.Stage,
 								Err:    fmt.Errorf("%s", text.Message),
 								Output: progress,
 							}
+							if t.failedTestCommand != "" {
+								cmdErr.Err = deployable.TestCommandError{Command: t.failedTestCommand, Err: cmdErr.Err}
+							}
+							t.err = cmdErr
 						}
 					} else {
-						oktetoLog.Println(text.Message)
+						oktetoLog.Println(t.prefix + text.Message)
 					}
 
 				}
 			}
 			v.logs = []string{}
-			oktetoLog.SetStage("")
+			t.setStage("")
 		}
 	}
 }
@@ -0,0 +1,169 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+)
+
+// ProgressTracker displays the progress of a file being copied
+type ProgressTracker interface {
+	TrackProgress(src string, currentSize, totalSize int64, stream io.ReadCloser) io.ReadCloser
+}
+
+// Copier copies files and directories from one Filesystem to another
+type Copier struct {
+	Src Filesystem
+	Dst Filesystem
+
+	// Progress is optional
+	Progress ProgressTracker
+
+	// Recursive copies directories and their content
+	Recursive bool
+
+	// Resume continues partial copies instead of starting over. A destination file smaller than its source
+	// is considered a partial copy, and a destination file of the same size is considered complete
+	Resume bool
+}
+
+// Copy copies the paths of Src matching the patterns sources to dst in Dst, with the semantics of 'cp':
+// if dst is an existing directory the sources are copied inside it, otherwise the single source is copied to dst
+func (c *Copier) Copy(ctx context.Context, sources []string, dst string) error {
+	entries := []Entry{}
+	for _, pattern := range sources {
+		matches, err := Glob(ctx, c.Src, pattern)
+		if err != nil {
+			return err
+		}
+		for _, m := range matches {
+			e, err := c.Src.Stat(ctx, m)
+			if err != nil {
+				return err
+			}
+			if e.IsDir && !c.Recursive {
+				return fmt.Errorf("'%s' is a directory, use '--recursive' to copy it", m)
+			}
+			entries = append(entries, e)
+		}
+	}
+
+	dstIsDir := false
+	d, err := c.Dst.Stat(ctx, dst)
+	switch {
+	case err == nil:
+		dstIsDir = d.IsDir
+	case !errors.Is(err, fs.ErrNotExist):
+		return err
+	}
+
+	if len(entries) > 1 && !dstIsDir {
+		return fmt.Errorf("'%s' is not a directory", dst)
+	}
+
+	for _, e := range entries {
+		target := dst
+		if dstIsDir {
+			target = path.Join(dst, e.Name())
+		}
+		if err := c.copy(ctx, e, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Copier) copy(ctx context.Context, src Entry, dst string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !src.IsDir {
+		return c.copyFile(ctx, src, dst)
+	}
+
+	if err := c.Dst.MkdirAll(ctx, dst, src.Mode); err != nil {
+		return fmt.Errorf("failed to create directory '%s': %w", dst, err)
+	}
+	children, err := c.Src.ReadDir(ctx, src.Path)
+	if err != nil {
+		return fmt.Errorf("failed to read directory '%s': %w", src.Path, err)
+	}
+	for _, child := range children {
+		if err := c.copy(ctx, child, path.Join(dst, child.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Copier) copyFile(ctx context.Context, src Entry, dst string) error {
+	offset, err := c.resumeOffset(ctx, src, dst)
+	if err != nil {
+		return err
+	}
+	if offset == src.Size && offset > 0 {
+		oktetoLog.Infof("skipping '%s', it was already copied", src.Path)
+		return nil
+	}
+
+	r, err := c.Src.Open(ctx, src.Path, offset)
+	if err != nil {
+		return fmt.Errorf("failed to open '%s': %w", src.Path, err)
+	}
+	if c.Progress != nil {
+		r = c.Progress.TrackProgress(src.Path, offset, src.Size, r)
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			oktetoLog.Infof("failed to close '%s': %s", src.Path, err)
+		}
+	}()
+
+	w, err := c.Dst.Create(ctx, dst, offset, src.Mode)
+	if err != nil {
+		return fmt.Errorf("failed to create '%s': %w", dst, err)
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return errors.Join(fmt.Errorf("failed to copy '%s': %w", src.Path, err), w.Close())
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to copy '%s': %w", src.Path, err)
+	}
+	return nil
+}
+
+// resumeOffset returns the size of the partial copy of src in dst
+func (c *Copier) resumeOffset(ctx context.Context, src Entry, dst string) (int64, error) {
+	d, err := c.Dst.Stat(ctx, dst)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if d.IsDir {
+		return 0, fmt.Errorf("cannot overwrite directory '%s' with file '%s'", dst, src.Path)
+	}
+	if !c.Resume || d.Size > src.Size {
+		return 0, nil
+	}
+	return d.Size, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.FromSlash(path.Join(dir, name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0700))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0640))
+	}
+}
+
+func readFiles(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	result := map[string]string{}
+	root := filepath.FromSlash(dir)
+	err := filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		result[filepath.ToSlash(rel)] = string(b)
+		return nil
+	})
+	require.NoError(t, err)
+	return result
+}
+
+type fakeProgress struct {
+	tracked map[string]int64
+}
+
+func (p *fakeProgress) TrackProgress(src string, currentSize, _ int64, stream io.ReadCloser) io.ReadCloser {
+	p.tracked[src] = currentSize
+	return stream
+}
+
+// testCopier runs the copier tests with the given filesystem as the remote side
+func testCopier(t *testing.T, newRemote func(t *testing.T) Filesystem) {
+	ctx := context.Background()
+	files := map[string]string{
+		"main.go":        "package main",
+		"README.md":      "# app",
+		"pkg/api/api.go": "package api",
+	}
+
+	t.Run("upload-file", func(t *testing.T) {
+		local := filepath.ToSlash(t.TempDir())
+		remote := filepath.ToSlash(t.TempDir())
+		writeFiles(t, local, files)
+
+		c := &Copier{Src: LocalFilesystem{}, Dst: newRemote(t)}
+		require.NoError(t, c.Copy(ctx, []string{local + "/main.go"}, remote+"/app.go"))
+		assert.Equal(t, map[string]string{"app.go": "package main"}, readFiles(t, remote))
+
+		info, err := os.Stat(filepath.Join(filepath.FromSlash(remote), "app.go"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0640), info.Mode().Perm())
+	})
+
+	t.Run("download-recursive", func(t *testing.T) {
+		local := filepath.ToSlash(t.TempDir())
+		remote := filepath.ToSlash(t.TempDir())
+		writeFiles(t, remote, files)
+
+		c := &Copier{Src: newRemote(t), Dst: LocalFilesystem{}, Recursive: true}
+		require.NoError(t, c.Copy(ctx, []string{remote + "/pkg"}, local))
+		assert.Equal(t, map[string]string{"pkg/api/api.go": "package api"}, readFiles(t, local))
+	})
+
+	t.Run("download-glob", func(t *testing.T) {
+		local := filepath.ToSlash(t.TempDir())
+		remote := filepath.ToSlash(t.TempDir())
+		writeFiles(t, remote, files)
+
+		c := &Copier{Src: newRemote(t), Dst: LocalFilesystem{}}
+		require.NoError(t, c.Copy(ctx, []string{remote + "/*.go", remote + "/*.md"}, local))
+		assert.Equal(t, map[string]string{"main.go": "package main", "README.md": "# app"}, readFiles(t, local))
+	})
+
+	t.Run("directory-without-recursive", func(t *testing.T) {
+		remote := filepath.ToSlash(t.TempDir())
+		writeFiles(t, remote, files)
+
+		c := &Copier{Src: newRemote(t), Dst: LocalFilesystem{}}
+		err := c.Copy(ctx, []string{remote + "/pkg"}, filepath.ToSlash(t.TempDir()))
+		assert.ErrorContains(t, err, "is a directory")
+	})
+
+	t.Run("multiple-sources-to-file", func(t *testing.T) {
+		local := filepath.ToSlash(t.TempDir())
+		remote := filepath.ToSlash(t.TempDir())
+		writeFiles(t, local, files)
+
+		c := &Copier{Src: LocalFilesystem{}, Dst: newRemote(t)}
+		err := c.Copy(ctx, []string{local + "/*.go", local + "/*.md"}, remote+"/file")
+		assert.ErrorContains(t, err, "is not a directory")
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		local := filepath.ToSlash(t.TempDir())
+		remote := filepath.ToSlash(t.TempDir())
+		content := strings.Repeat("okteto", 1000)
+		writeFiles(t, local, map[string]string{"big": content, "done": "complete"})
+		writeFiles(t, remote, map[string]string{"big": content[:1500], "done": "complete"})
+
+		progress := &fakeProgress{tracked: map[string]int64{}}
+		c := &Copier{Src: LocalFilesystem{}, Dst: newRemote(t), Resume: true, Progress: progress}
+		require.NoError(t, c.Copy(ctx, []string{local + "/big", local + "/done"}, remote))
+		assert.Equal(t, map[string]string{"big": content, "done": "complete"}, readFiles(t, remote))
+		assert.Equal(t, map[string]int64{local + "/big": 1500}, progress.tracked)
+	})
+
+	t.Run("overwrite-without-resume", func(t *testing.T) {
+		local := filepath.ToSlash(t.TempDir())
+		remote := filepath.ToSlash(t.TempDir())
+		writeFiles(t, local, map[string]string{"main.go": "new"})
+		writeFiles(t, remote, map[string]string{"main.go": "previous content"})
+
+		c := &Copier{Src: LocalFilesystem{}, Dst: newRemote(t)}
+		require.NoError(t, c.Copy(ctx, []string{local + "/main.go"}, remote))
+		assert.Equal(t, map[string]string{"main.go": "new"}, readFiles(t, remote))
+	})
+}
+
+func TestCopierLocal(t *testing.T) {
+	testCopier(t, func(*testing.T) Filesystem { return LocalFilesystem{} })
+}
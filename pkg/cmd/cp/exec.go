@@ -0,0 +1,187 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	// statFormat prints the size, permissions, type and name of a file
+	statFormat = "%s|%a|%F|%n"
+
+	statScript    = `[ -e "$1" ] && stat -L -c '` + statFormat + `' "$1" || true`
+	readDirScript = `find "$1" -mindepth 1 -maxdepth 1 -exec sh -c 'stat -L -c "` + statFormat + `" "$@" 2>/dev/null; true' sh {} +`
+)
+
+// ExecFunc runs a command in the development container without a TTY
+type ExecFunc func(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, command []string) error
+
+// ExecFilesystem is the filesystem of a development container accessed by streaming commands like 'cat' or 'stat'
+// through the Kubernetes exec API. It is used when there is no 'okteto up' session to reach the SFTP server
+type ExecFilesystem struct {
+	exec ExecFunc
+}
+
+// NewExecFilesystem returns a Filesystem that runs the commands of every operation with exec
+func NewExecFilesystem(exec ExecFunc) *ExecFilesystem {
+	return &ExecFilesystem{exec: exec}
+}
+
+// Stat returns the entry of the file name
+func (e *ExecFilesystem) Stat(ctx context.Context, name string) (Entry, error) {
+	out, err := e.output(ctx, shell(statScript, name))
+	if err != nil {
+		return Entry{}, err
+	}
+	out = strings.TrimSpace(out)
+	if out == "" {
+		return Entry{}, fmt.Errorf("'%s': %w", name, fs.ErrNotExist)
+	}
+	entry, err := parseStat(out)
+	if err != nil {
+		return Entry{}, err
+	}
+	entry.Path = name
+	return entry, nil
+}
+
+// ReadDir returns the entries of the directory name
+func (e *ExecFilesystem) ReadDir(ctx context.Context, name string) ([]Entry, error) {
+	out, err := e.output(ctx, shell(readDirScript, name))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Entry{}
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		entry, err := parseStat(line)
+		if err != nil {
+			return nil, err
+		}
+		entry.Path = path.Join(name, entry.Name())
+		result = append(result, entry)
+	}
+	return result, scanner.Err()
+}
+
+// Open streams the content of the file name from offset
+func (e *ExecFilesystem) Open(ctx context.Context, name string, offset int64) (io.ReadCloser, error) {
+	r, w := io.Pipe()
+	go func() {
+		stderr := &bytes.Buffer{}
+		err := e.exec(ctx, bytes.NewReader(nil), w, stderr, []string{"tail", "-c", fmt.Sprintf("+%d", offset+1), name})
+		w.CloseWithError(commandError(err, stderr))
+	}()
+	return r, nil
+}
+
+// Create streams the content written to the file name from offset
+func (e *ExecFilesystem) Create(ctx context.Context, name string, offset int64, mode fs.FileMode) (io.WriteCloser, error) {
+	script := fmt.Sprintf(`cat > "$1" && chmod %o "$1"`, mode)
+	if offset > 0 {
+		script = `cat >> "$1"`
+	}
+
+	r, w := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		stderr := &bytes.Buffer{}
+		err := commandError(e.exec(ctx, r, io.Discard, stderr, shell(script, name)), stderr)
+		r.CloseWithError(err)
+		done <- err
+	}()
+	return &execWriter{PipeWriter: w, done: done}, nil
+}
+
+// MkdirAll creates the directory name and its parents
+func (e *ExecFilesystem) MkdirAll(ctx context.Context, name string, mode fs.FileMode) error {
+	_, err := e.output(ctx, shell(fmt.Sprintf(`[ -d "$1" ] || mkdir -p -m %o "$1"`, mode), name))
+	return err
+}
+
+func (e *ExecFilesystem) output(ctx context.Context, command []string) (string, error) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	err := e.exec(ctx, bytes.NewReader(nil), stdout, stderr, command)
+	if err := commandError(err, stderr); err != nil {
+		return "", err
+	}
+	return stdout.String(), nil
+}
+
+// execWriter waits for the command to exit on Close
+type execWriter struct {
+	*io.PipeWriter
+	done chan error
+}
+
+func (w *execWriter) Close() error {
+	if err := w.PipeWriter.Close(); err != nil {
+		return err
+	}
+	return <-w.done
+}
+
+// shell runs script with name as its first argument, so it doesn't need to be quoted
+func shell(script, name string) []string {
+	return []string{"sh", "-c", script, "sh", name}
+}
+
+func commandError(err error, stderr *bytes.Buffer) error {
+	if err == nil {
+		return nil
+	}
+	if msg := strings.TrimSpace(stderr.String()); msg != "" {
+		return fmt.Errorf("%w: %s", err, msg)
+	}
+	return err
+}
+
+// parseStat parses a line printed with statFormat
+func parseStat(line string) (Entry, error) {
+	parts := strings.SplitN(line, "|", 4)
+	if len(parts) != 4 {
+		return Entry{}, fmt.Errorf("unexpected stat output: '%s'", line)
+	}
+
+	size, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return Entry{}, fmt.Errorf("unexpected stat size '%s': %w", parts[0], err)
+	}
+	mode, err := strconv.ParseUint(parts[1], 8, 32)
+	if err != nil {
+		return Entry{}, fmt.Errorf("unexpected stat permissions '%s': %w", parts[1], err)
+	}
+
+	return Entry{
+		Path:  parts[3],
+		Size:  size,
+		Mode:  fs.FileMode(mode).Perm(),
+		IsDir: parts[2] == "directory",
+	}, nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runLocally runs the commands of the exec filesystem in the local shell
+func runLocally(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, command []string) error {
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run()
+}
+
+func TestCopierExec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the exec filesystem runs POSIX commands")
+	}
+	testCopier(t, func(*testing.T) Filesystem {
+		return NewExecFilesystem(runLocally)
+	})
+}
+
+func TestExecFilesystemStat(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the exec filesystem runs POSIX commands")
+	}
+	ctx := context.Background()
+	dir := filepath.ToSlash(t.TempDir())
+	writeFiles(t, dir, map[string]string{"with | pipe.txt": "okteto"})
+	e := NewExecFilesystem(runLocally)
+
+	entry, err := e.Stat(ctx, dir+"/with | pipe.txt")
+	require.NoError(t, err)
+	assert.Equal(t, Entry{Path: dir + "/with | pipe.txt", Size: 6, Mode: 0640}, entry)
+
+	entries, err := e.ReadDir(ctx, dir)
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{entry}, entries)
+
+	_, err = e.Stat(ctx, dir+"/missing")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	_, err = e.ReadDir(ctx, dir+"/missing")
+	assert.Error(t, err)
+}
+
+func TestParseStat(t *testing.T) {
+	entry, err := parseStat("4096|755|directory|/app")
+	require.NoError(t, err)
+	assert.Equal(t, Entry{Path: "/app", Size: 4096, Mode: 0755, IsDir: true}, entry)
+
+	_, err = parseStat("not stat output")
+	assert.Error(t, err)
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+)
+
+// Entry is a file or directory of a Filesystem
+type Entry struct {
+	Path  string
+	Size  int64
+	Mode  fs.FileMode
+	IsDir bool
+}
+
+// Name returns the last element of the path of the entry
+func (e Entry) Name() string {
+	return path.Base(e.Path)
+}
+
+// Filesystem is one of the sides of a copy. Paths are always slash-separated.
+// Symbolic links are followed, and Stat returns an error wrapping fs.ErrNotExist when the path doesn't exist
+type Filesystem interface {
+	Stat(ctx context.Context, name string) (Entry, error)
+	ReadDir(ctx context.Context, name string) ([]Entry, error)
+
+	// Open returns the content of the file from the given offset
+	Open(ctx context.Context, name string, offset int64) (io.ReadCloser, error)
+
+	// Create truncates the file when offset is 0, and appends to it otherwise
+	Create(ctx context.Context, name string, offset int64, mode fs.FileMode) (io.WriteCloser, error)
+	MkdirAll(ctx context.Context, name string, mode fs.FileMode) error
+}
+
+// LocalFilesystem is the filesystem of the machine running the CLI
+type LocalFilesystem struct{}
+
+// Stat returns the entry of the file name
+func (LocalFilesystem) Stat(_ context.Context, name string) (Entry, error) {
+	info, err := os.Stat(filepath.FromSlash(name))
+	if err != nil {
+		return Entry{}, err
+	}
+	return newEntry(name, info), nil
+}
+
+// ReadDir returns the entries of the directory name
+func (LocalFilesystem) ReadDir(_ context.Context, name string) ([]Entry, error) {
+	dirEntries, err := os.ReadDir(filepath.FromSlash(name))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(dirEntries))
+	for _, d := range dirEntries {
+		p := path.Join(name, d.Name())
+		info, err := os.Stat(filepath.FromSlash(p))
+		if err != nil {
+			oktetoLog.Infof("skipping '%s': %s", p, err)
+			continue
+		}
+		result = append(result, newEntry(p, info))
+	}
+	return result, nil
+}
+
+// Open returns the content of the file name from offset
+func (LocalFilesystem) Open(_ context.Context, name string, offset int64) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.FromSlash(name))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	return f, nil
+}
+
+// Create opens the file name for writing from offset
+func (LocalFilesystem) Create(_ context.Context, name string, offset int64, mode fs.FileMode) (io.WriteCloser, error) {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if offset > 0 {
+		flags = os.O_WRONLY | os.O_APPEND
+	}
+	return os.OpenFile(filepath.FromSlash(name), flags, mode)
+}
+
+// MkdirAll creates the directory name and its parents
+func (LocalFilesystem) MkdirAll(_ context.Context, name string, mode fs.FileMode) error {
+	return os.MkdirAll(filepath.FromSlash(name), mode)
+}
+
+func newEntry(name string, info fs.FileInfo) Entry {
+	return Entry{
+		Path:  name,
+		Size:  info.Size(),
+		Mode:  info.Mode().Perm(),
+		IsDir: info.IsDir(),
+	}
+}
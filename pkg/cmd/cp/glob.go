@@ -0,0 +1,101 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Glob returns the paths of fsys matching pattern, with the syntax of path.Match.
+// Like shells, wildcards don't match names starting with a dot unless the pattern does.
+// Patterns without wildcards are returned as they are
+func Glob(ctx context.Context, fsys Filesystem, pattern string) ([]string, error) {
+	if !hasMeta(pattern) {
+		return []string{pattern}, nil
+	}
+
+	matches, err := glob(ctx, fsys, pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("'%s': %w", pattern, fs.ErrNotExist)
+	}
+	return matches, nil
+}
+
+func glob(ctx context.Context, fsys Filesystem, pattern string) ([]string, error) {
+	dir, file := path.Split(pattern)
+	dir = cleanGlobDir(dir)
+	if _, err := path.Match(file, ""); err != nil {
+		return nil, fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+	}
+
+	dirs := []string{dir}
+	if hasMeta(dir) {
+		var err error
+		dirs, err = glob(ctx, fsys, dir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := []string{}
+	for _, d := range dirs {
+		entries, err := fsys.ReadDir(ctx, d)
+		if err != nil {
+			// like filepath.Glob, directories that can't be read don't match
+			continue
+		}
+		for _, e := range entries {
+			name := e.Name()
+			if strings.HasPrefix(name, ".") && !strings.HasPrefix(file, ".") {
+				continue
+			}
+			if matched, _ := path.Match(file, name); matched {
+				result = append(result, joinGlob(d, name))
+			}
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func cleanGlobDir(dir string) string {
+	switch dir {
+	case "":
+		return "."
+	case "/":
+		return dir
+	default:
+		return dir[:len(dir)-1]
+	}
+}
+
+// joinGlob keeps relative patterns relative
+func joinGlob(dir, name string) string {
+	if dir == "." {
+		return name
+	}
+	return path.Join(dir, name)
+}
+
+func hasMeta(p string) bool {
+	return strings.ContainsAny(p, `*?[\`)
+}
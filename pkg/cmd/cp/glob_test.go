@@ -0,0 +1,98 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlob(t *testing.T) {
+	dir := filepath.ToSlash(t.TempDir())
+	writeFiles(t, dir, map[string]string{
+		"a.go":          "",
+		"b.go":          "",
+		".hidden.go":    "",
+		"README.md":     "",
+		"pkg/x/x.go":    "",
+		"pkg/y/y.go":    "",
+		"pkg/y/y.txt":   "",
+		"vendor/v/v.go": "",
+	})
+
+	tests := []struct {
+		name     string
+		pattern  string
+		expected []string
+		err      error
+	}{
+		{
+			name:     "no-wildcards",
+			pattern:  dir + "/missing.go",
+			expected: []string{dir + "/missing.go"},
+		},
+		{
+			name:     "files",
+			pattern:  dir + "/*.go",
+			expected: []string{dir + "/a.go", dir + "/b.go"},
+		},
+		{
+			name:     "hidden-files",
+			pattern:  dir + "/.*.go",
+			expected: []string{dir + "/.hidden.go"},
+		},
+		{
+			name:     "wildcard-dirs",
+			pattern:  dir + "/pkg/*/*.go",
+			expected: []string{dir + "/pkg/x/x.go", dir + "/pkg/y/y.go"},
+		},
+		{
+			name:     "character-class",
+			pattern:  dir + "/[ab].go",
+			expected: []string{dir + "/a.go", dir + "/b.go"},
+		},
+		{
+			name:    "no-matches",
+			pattern: dir + "/*.py",
+			err:     fs.ErrNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Glob(context.Background(), LocalFilesystem{}, tt.pattern)
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestGlobRelative(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, filepath.ToSlash(dir), map[string]string{"a.go": "", "b.txt": ""})
+	t.Chdir(dir)
+
+	got, err := Glob(context.Background(), LocalFilesystem{}, "*.go")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.go"}, got)
+}
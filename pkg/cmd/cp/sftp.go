@@ -0,0 +1,113 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/pkg/sftp"
+)
+
+// SFTPFilesystem is the filesystem of a development container accessed through its SFTP server
+type SFTPFilesystem struct {
+	client *sftp.Client
+}
+
+// NewSFTPFilesystem returns a Filesystem backed by an SFTP session
+func NewSFTPFilesystem(client *sftp.Client) *SFTPFilesystem {
+	return &SFTPFilesystem{client: client}
+}
+
+// Stat returns the entry of the file name
+func (s *SFTPFilesystem) Stat(_ context.Context, name string) (Entry, error) {
+	info, err := s.client.Stat(name)
+	if err != nil {
+		return Entry{}, err
+	}
+	return newEntry(name, info), nil
+}
+
+// ReadDir returns the entries of the directory name
+func (s *SFTPFilesystem) ReadDir(_ context.Context, name string) ([]Entry, error) {
+	infos, err := s.client.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Entry, 0, len(infos))
+	for _, info := range infos {
+		p := path.Join(name, info.Name())
+		if info.Mode()&fs.ModeSymlink != 0 {
+			info, err = s.client.Stat(p)
+			if err != nil {
+				oktetoLog.Infof("skipping '%s': %s", p, err)
+				continue
+			}
+		}
+		result = append(result, newEntry(p, info))
+	}
+	return result, nil
+}
+
+// Open returns the content of the file name from offset
+func (s *SFTPFilesystem) Open(_ context.Context, name string, offset int64) (io.ReadCloser, error) {
+	f, err := s.client.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	return f, nil
+}
+
+// Create opens the file name for writing from offset
+func (s *SFTPFilesystem) Create(_ context.Context, name string, offset int64, mode fs.FileMode) (io.WriteCloser, error) {
+	if offset > 0 {
+		f, err := s.client.OpenFile(name, os.O_WRONLY)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			return nil, errors.Join(err, f.Close())
+		}
+		return f, nil
+	}
+
+	f, err := s.client.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Chmod(mode); err != nil {
+		return nil, errors.Join(err, f.Close())
+	}
+	return f, nil
+}
+
+// MkdirAll creates the directory name and its parents. Like os.MkdirAll, mode only applies to new directories
+func (s *SFTPFilesystem) MkdirAll(_ context.Context, name string, mode fs.FileMode) error {
+	if _, err := s.client.Stat(name); err == nil {
+		return nil
+	}
+	if err := s.client.MkdirAll(name); err != nil {
+		return err
+	}
+	return s.client.Chmod(name, mode)
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cp
+
+import (
+	"io"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/require"
+)
+
+type pipeConn struct {
+	io.Reader
+	io.WriteCloser
+}
+
+func newTestSFTPClient(t *testing.T) *sftp.Client {
+	t.Helper()
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	server, err := sftp.NewServer(pipeConn{Reader: serverReader, WriteCloser: serverWriter})
+	require.NoError(t, err)
+	go server.Serve()
+
+	client, err := sftp.NewClientPipe(clientReader, clientWriter)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		// closing the server closes the pipe the client is reading from
+		server.Close()
+		client.Close()
+	})
+	return client
+}
+
+func TestCopierSFTP(t *testing.T) {
+	testCopier(t, func(t *testing.T) Filesystem {
+		return NewSFTPFilesystem(newTestSFTPClient(t))
+	})
+}
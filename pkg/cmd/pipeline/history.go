@@ -0,0 +1,126 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/okteto/okteto/pkg/compress"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/configmaps"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	historyField = "history"
+
+	// MaxRevisions is the number of deploys kept in the history of a dev environment
+	MaxRevisions = 10
+
+	// maxHistorySize is the maximum size of the encoded history. The oldest revisions are
+	// discarded to leave room in the configmap for the logs of the deploy
+	maxHistorySize = 100 * 1024
+)
+
+// Revision is a deploy kept in the history of a dev environment
+type Revision struct {
+	Timestamp time.Time `json:"timestamp"`
+	// BuildEnvVars are the OKTETO_BUILD_* variables with the images used by the deploy
+	BuildEnvVars map[string]string `json:"buildEnvs,omitempty"`
+	User         string            `json:"user,omitempty"`
+	Status       string            `json:"status"`
+	GitCommit    string            `json:"gitCommit,omitempty"`
+	Branch       string            `json:"branch,omitempty"`
+	Filename     string            `json:"filename,omitempty"`
+	// Manifest is the content of the Okteto Manifest. It is empty when the dev environment was deployed from a compose file
+	Manifest  []byte   `json:"manifest,omitempty"`
+	Variables []string `json:"variables,omitempty"`
+	Number    int      `json:"revision"`
+	// RollbackOf is the revision redeployed by this revision, if any
+	RollbackOf int `json:"rollbackOf,omitempty"`
+}
+
+// GetHistory returns the revisions of a dev environment, from the oldest to the newest
+func GetHistory(ctx context.Context, name, namespace string, c kubernetes.Interface) ([]Revision, error) {
+	cmap, err := configmaps.Get(ctx, TranslatePipelineName(name), namespace, c)
+	if err != nil {
+		if oktetoErrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return decodeHistory(cmap.Data[historyField])
+}
+
+// AddRevision adds a revision to the history of a dev environment and returns its number.
+// Only the last MaxRevisions revisions are kept
+func AddRevision(ctx context.Context, name, namespace string, rev Revision, c kubernetes.Interface) (int, error) {
+	cmap, err := configmaps.Get(ctx, TranslatePipelineName(name), namespace, c)
+	if err != nil {
+		return 0, err
+	}
+
+	history, err := decodeHistory(cmap.Data[historyField])
+	if err != nil {
+		return 0, err
+	}
+	rev.Number = 1
+	if len(history) > 0 {
+		rev.Number = history[len(history)-1].Number + 1
+	}
+	history = append(history, rev)
+	if len(history) > MaxRevisions {
+		history = history[len(history)-MaxRevisions:]
+	}
+
+	encoded, err := encodeHistory(history)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode history: %w", err)
+	}
+	for len(encoded) > maxHistorySize && len(history) > 1 {
+		history = history[1:]
+		if encoded, err = encodeHistory(history); err != nil {
+			return 0, fmt.Errorf("failed to encode history: %w", err)
+		}
+	}
+
+	if cmap.Data == nil {
+		cmap.Data = map[string]string{}
+	}
+	cmap.Data[historyField] = encoded
+	if err := configmaps.Deploy(ctx, cmap, cmap.Namespace, c); err != nil {
+		return 0, err
+	}
+	return rev.Number, nil
+}
+
+// encodeHistory returns the revisions as gzipped JSON encoded in base64, as each revision includes a manifest
+func encodeHistory(history []Revision) (string, error) {
+	return compress.EncodeJSON(history)
+}
+
+func decodeHistory(encoded string) ([]Revision, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	var history []Revision
+	if err := compress.DecodeJSON(encoded, &history); err != nil {
+		return nil, fmt.Errorf("invalid history: %w", err)
+	}
+	return history, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestHistory(t *testing.T) {
+	ctx := context.Background()
+	namespace := "test-namespace"
+
+	client := fake.NewSimpleClientset()
+	history, err := GetHistory(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	assert.Nil(t, history)
+
+	_, err = AddRevision(ctx, "test", namespace, Revision{}, client)
+	assert.True(t, k8sErrors.IsNotFound(err))
+
+	cmap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TranslatePipelineName("test"),
+			Namespace: namespace,
+		},
+	}
+	client = fake.NewSimpleClientset(cmap)
+
+	ts := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	rev := Revision{
+		Timestamp:    ts,
+		User:         "cindy",
+		Status:       DeployedStatus,
+		GitCommit:    "0123456789abcdef",
+		Branch:       "main",
+		Filename:     "okteto.yml",
+		Manifest:     []byte("deploy:\n  - kubectl apply -f k8s.yml\n"),
+		Variables:    []string{"A=1"},
+		BuildEnvVars: map[string]string{"OKTETO_BUILD_API_IMAGE": "okteto.dev/api@sha256:1"},
+	}
+	for i := 1; i <= MaxRevisions+2; i++ {
+		number, err := AddRevision(ctx, "test", namespace, rev, client)
+		require.NoError(t, err)
+		assert.Equal(t, i, number)
+	}
+
+	history, err = GetHistory(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	require.Len(t, history, MaxRevisions)
+	assert.Equal(t, 3, history[0].Number)
+	assert.Equal(t, MaxRevisions+2, history[MaxRevisions-1].Number)
+
+	rev.Number = 3
+	assert.Equal(t, rev, history[0])
+}
+
+func TestAddRevisionMaxSize(t *testing.T) {
+	ctx := context.Background()
+	namespace := "test-namespace"
+	cmap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TranslatePipelineName("test"),
+			Namespace: namespace,
+		},
+	}
+	client := fake.NewSimpleClientset(cmap)
+
+	// random content doesn't compress, so only a few revisions fit in the history
+	content := make([]byte, maxHistorySize/4)
+	_, err := rand.Read(content)
+	require.NoError(t, err)
+	rev := Revision{Manifest: []byte(hex.EncodeToString(content))}
+	for i := 0; i < 4; i++ {
+		_, err := AddRevision(ctx, "test", namespace, rev, client)
+		require.NoError(t, err)
+	}
+
+	history, err := GetHistory(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	require.NotEmpty(t, history)
+	assert.Less(t, len(history), 4)
+	assert.Equal(t, 4, history[len(history)-1].Number)
+}
+
+func TestDecodeHistoryInvalid(t *testing.T) {
+	_, err := decodeHistory("not-base64!")
+	assert.Error(t, err)
+}
@@ -0,0 +1,250 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/configmaps"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	outputsField = "outputs"
+
+	// outputsSecretSuffix is the suffix of the Secret that stores the values of the sensitive outputs
+	outputsSecretSuffix = "-outputs"
+)
+
+// Output is a value generated by a deploy command of a dev environment
+type Output struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	// Command is the name of the deploy command that generated the output
+	Command   string `json:"command,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
+
+// TranslateOutputsSecretName returns the name of the Secret that stores the values of the sensitive outputs of a dev environment
+func TranslateOutputsSecretName(name string) string {
+	return TranslatePipelineName(name) + outputsSecretSuffix
+}
+
+// GetOutputs returns the outputs of the last deploy of a dev environment.
+// It returns nil if the dev environment doesn't exist or it doesn't have outputs
+func GetOutputs(ctx context.Context, name, namespace string, c kubernetes.Interface) ([]Output, error) {
+	cmap, err := configmaps.Get(ctx, TranslatePipelineName(name), namespace, c)
+	if err != nil {
+		if oktetoErrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return ReadOutputs(ctx, cmap, c)
+}
+
+// ReadOutputs returns the outputs stored in the configmap of a dev environment, with the values of the
+// sensitive outputs read from their Secret
+func ReadOutputs(ctx context.Context, cmap *apiv1.ConfigMap, c kubernetes.Interface) ([]Output, error) {
+	outputs, err := DecodeOutputs(cmap)
+	if err != nil {
+		return nil, err
+	}
+	if !hasSensitiveOutputs(outputs) {
+		return outputs, nil
+	}
+
+	secret, err := c.CoreV1().Secrets(cmap.Namespace).Get(ctx, cmap.Name+outputsSecretSuffix, metav1.GetOptions{})
+	if err != nil {
+		// dev environments deployed by previous versions of okteto store the sensitive values in the configmap
+		if oktetoErrors.IsNotFound(err) {
+			return outputs, nil
+		}
+		return nil, fmt.Errorf("failed to get the sensitive outputs: %w", err)
+	}
+	for i := range outputs {
+		if value, ok := secret.Data[outputs[i].Name]; ok && outputs[i].Sensitive {
+			outputs[i].Value = string(value)
+		}
+	}
+	return outputs, nil
+}
+
+// DecodeOutputs returns the outputs stored in the configmap of a dev environment. The values of the sensitive
+// outputs are stored in a Secret, use ReadOutputs to get them
+func DecodeOutputs(cmap *apiv1.ConfigMap) ([]Output, error) {
+	if cmap == nil || cmap.Data[outputsField] == "" {
+		return nil, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(cmap.Data[outputsField])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode outputs: %w", err)
+	}
+	var outputs []Output
+	if err := json.Unmarshal(decoded, &outputs); err != nil {
+		return nil, fmt.Errorf("failed to decode outputs: %w", err)
+	}
+	return outputs, nil
+}
+
+// UpdateOutputs replaces the outputs stored in the configmap of a dev environment with the ones of the last deploy.
+// The values of the sensitive outputs are stored in a Secret owned by the configmap
+func UpdateOutputs(ctx context.Context, name, namespace string, outputs []Output, c kubernetes.Interface) error {
+	cmap, err := configmaps.Get(ctx, TranslatePipelineName(name), namespace, c)
+	if err != nil {
+		return err
+	}
+
+	if err := updateSensitiveOutputs(ctx, cmap, outputs, c); err != nil {
+		return err
+	}
+
+	if len(outputs) == 0 {
+		if _, ok := cmap.Data[outputsField]; !ok {
+			return nil
+		}
+		delete(cmap.Data, outputsField)
+		return configmaps.Deploy(ctx, cmap, cmap.Namespace, c)
+	}
+
+	stored := make([]Output, 0, len(outputs))
+	for _, o := range outputs {
+		if o.Sensitive {
+			o.Value = ""
+		}
+		stored = append(stored, o)
+	}
+	encoded, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to encode outputs: %w", err)
+	}
+	if cmap.Data == nil {
+		cmap.Data = map[string]string{}
+	}
+	cmap.Data[outputsField] = base64.StdEncoding.EncodeToString(encoded)
+	return configmaps.Deploy(ctx, cmap, cmap.Namespace, c)
+}
+
+// updateSensitiveOutputs stores the values of the sensitive outputs in a Secret owned by the configmap of the
+// dev environment, so it is deleted with it. The Secret is deleted when there are no sensitive outputs
+func updateSensitiveOutputs(ctx context.Context, cmap *apiv1.ConfigMap, outputs []Output, c kubernetes.Interface) error {
+	secrets := c.CoreV1().Secrets(cmap.Namespace)
+	name := cmap.Name + outputsSecretSuffix
+	if !hasSensitiveOutputs(outputs) {
+		if err := secrets.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !oktetoErrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete the sensitive outputs: %w", err)
+		}
+		return nil
+	}
+
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: cmap.Namespace,
+			Labels:    cmap.Labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Name:       cmap.Name,
+					UID:        cmap.UID,
+				},
+			},
+		},
+		Type: apiv1.SecretTypeOpaque,
+		Data: map[string][]byte{},
+	}
+	for _, o := range outputs {
+		if o.Sensitive {
+			secret.Data[o.Name] = []byte(o.Value)
+		}
+	}
+
+	_, err := secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	if oktetoErrors.IsNotFound(err) {
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store the sensitive outputs: %w", err)
+	}
+	return nil
+}
+
+func hasSensitiveOutputs(outputs []Output) bool {
+	for _, o := range outputs {
+		if o.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskSensitiveOutputs redacts the values of the sensitive outputs from the logs
+func MaskSensitiveOutputs(outputs []Output) {
+	for _, o := range outputs {
+		if o.Sensitive {
+			oktetoLog.AddMaskedWord(o.Value)
+		}
+	}
+}
+
+// RemoveSensitiveOutputs returns the 'NAME=VALUE' variables without the ones of the sensitive outputs,
+// so their values are only stored in the Secret of the dev environment
+func RemoveSensitiveOutputs(variables []string, outputs []Output) []string {
+	sensitive := map[string]bool{}
+	for _, o := range outputs {
+		if o.Sensitive {
+			sensitive[o.Name] = true
+		}
+	}
+	if len(sensitive) == 0 {
+		return variables
+	}
+
+	result := make([]string, 0, len(variables))
+	for _, v := range variables {
+		name, _, _ := strings.Cut(v, "=")
+		if sensitive[name] {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// OutputsToVariables returns the outputs as 'NAME=VALUE' variables, skipping the ones already defined in variables
+func OutputsToVariables(outputs []Output, variables []string) []string {
+	defined := map[string]bool{}
+	for _, v := range variables {
+		name, _, _ := strings.Cut(v, "=")
+		defined[name] = true
+	}
+
+	result := make([]string, 0, len(outputs))
+	for _, o := range outputs {
+		if defined[o.Name] {
+			continue
+		}
+		result = append(result, fmt.Sprintf("%s=%s", o.Name, o.Value))
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestOutputs(t *testing.T) {
+	ctx := context.Background()
+	namespace := "test-namespace"
+
+	client := fake.NewSimpleClientset()
+	outputs, err := GetOutputs(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	assert.Nil(t, outputs)
+
+	err = UpdateOutputs(ctx, "test", namespace, []Output{{Name: "A", Value: "1"}}, client)
+	assert.True(t, k8sErrors.IsNotFound(err))
+
+	cmap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TranslatePipelineName("test"),
+			Namespace: namespace,
+		},
+	}
+	client = fake.NewSimpleClientset(cmap)
+
+	expected := []Output{
+		{Name: "DATABASE_HOST", Value: "postgres", Command: "db"},
+		{Name: "DATABASE_PASSWORD", Value: "secret", Command: "db", Sensitive: true},
+	}
+	require.NoError(t, UpdateOutputs(ctx, "test", namespace, expected, client))
+	outputs, err = GetOutputs(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	assert.Equal(t, expected, outputs)
+
+	// the values of the sensitive outputs are only stored in the Secret
+	stored, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, TranslatePipelineName("test"), metav1.GetOptions{})
+	require.NoError(t, err)
+	decoded, err := DecodeOutputs(stored)
+	require.NoError(t, err)
+	assert.Equal(t, "", decoded[1].Value)
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, TranslateOutputsSecretName("test"), metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"DATABASE_PASSWORD": []byte("secret")}, secret.Data)
+	require.Len(t, secret.OwnerReferences, 1)
+	assert.Equal(t, TranslatePipelineName("test"), secret.OwnerReferences[0].Name)
+
+	// the outputs of a deploy replace the ones of the previous deploy
+	require.NoError(t, UpdateOutputs(ctx, "test", namespace, nil, client))
+	outputs, err = GetOutputs(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	assert.Nil(t, outputs)
+	_, err = client.CoreV1().Secrets(namespace).Get(ctx, TranslateOutputsSecretName("test"), metav1.GetOptions{})
+	assert.True(t, k8sErrors.IsNotFound(err))
+}
+
+func TestDecodeOutputsInvalid(t *testing.T) {
+	_, err := DecodeOutputs(&apiv1.ConfigMap{Data: map[string]string{outputsField: "not base64"}})
+	assert.ErrorContains(t, err, "failed to decode outputs")
+}
+
+func TestOutputsToVariables(t *testing.T) {
+	outputs := []Output{
+		{Name: "DATABASE_HOST", Value: "postgres"},
+		{Name: "DATABASE_URL", Value: "postgres://db:5432?sslmode=disable", Sensitive: true},
+	}
+	variables := OutputsToVariables(outputs, []string{"DATABASE_HOST=localhost"})
+	assert.Equal(t, []string{"DATABASE_URL=postgres://db:5432?sslmode=disable"}, variables)
+}
+
+func TestRemoveSensitiveOutputs(t *testing.T) {
+	outputs := []Output{
+		{Name: "DATABASE_HOST", Value: "postgres"},
+		{Name: "DATABASE_PASSWORD", Value: "s3cr3t", Sensitive: true},
+	}
+	variables := RemoveSensitiveOutputs([]string{"DATABASE_HOST=postgres", "DATABASE_PASSWORD=s3cr3t", "OTHER=1"}, outputs)
+	assert.Equal(t, []string{"DATABASE_HOST=postgres", "OTHER=1"}, variables)
+}
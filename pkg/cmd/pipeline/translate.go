@@ -31,6 +31,7 @@ import (
 	"github.com/okteto/okteto/pkg/format"
 	"github.com/okteto/okteto/pkg/k8s/apps"
 	"github.com/okteto/okteto/pkg/k8s/configmaps"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
 	"github.com/okteto/okteto/pkg/okteto"
@@ -56,6 +57,7 @@ const (
 	variablesField   = "variables"
 	buildEnvVarField = "buildEnvs"
 	devBranchField   = "dev-branch"
+	inventoryField   = "inventory"
 	PhasesField      = "phases"
 
 	actionDefaultName = "cli"
@@ -140,6 +142,38 @@ func GetConfigmapBuildEnvVars(ctx context.Context, name, namespace string, c kub
 	return envVars, nil
 }
 
+// GetInventory returns the resources created or applied by the last deploy of the dev environment.
+// It returns nil if the dev environment was deployed by a version of okteto without inventory
+func GetInventory(ctx context.Context, name, namespace string, c kubernetes.Interface) ([]inventory.Resource, error) {
+	cmap, err := configmaps.Get(ctx, TranslatePipelineName(name), namespace, c)
+	if err != nil {
+		if oktetoErrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return inventory.Decode(cmap.Data[inventoryField])
+}
+
+// UpdateInventory stores the resources created or applied by a deploy in the configmap of the dev environment
+func UpdateInventory(ctx context.Context, name, namespace string, resources []inventory.Resource, c kubernetes.Interface) error {
+	cmap, err := configmaps.Get(ctx, TranslatePipelineName(name), namespace, c)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := inventory.Encode(resources)
+	if err != nil {
+		return fmt.Errorf("failed to encode inventory: %w", err)
+	}
+	if cmap.Data == nil {
+		cmap.Data = map[string]string{}
+	}
+	cmap.Data[inventoryField] = encoded
+	return configmaps.Deploy(ctx, cmap, cmap.Namespace, c)
+}
+
 // TranslateConfigMapAndDeploy translates the app into a configMap.
 // Name param is the pipeline sanitized name
 func TranslateConfigMapAndDeploy(ctx context.Context, data *CfgData, c kubernetes.Interface) (*apiv1.ConfigMap, error) {
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	"github.com/okteto/okteto/pkg/model"
 	"github.com/okteto/okteto/pkg/okteto"
 	"github.com/stretchr/testify/assert"
@@ -493,3 +494,35 @@ func TestSetBuildEnvVars(t *testing.T) {
 		assert.False(t, exists)
 	})
 }
+
+func TestInventory(t *testing.T) {
+	ctx := context.Background()
+	namespace := "test-namespace"
+
+	client := fake.NewSimpleClientset()
+	resources, err := GetInventory(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	assert.Nil(t, resources)
+
+	err = UpdateInventory(ctx, "test", namespace, nil, client)
+	assert.True(t, k8sErrors.IsNotFound(err))
+
+	cmap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      TranslatePipelineName("test"),
+			Namespace: namespace,
+		},
+	}
+	client = fake.NewSimpleClientset(cmap)
+	resources, err = GetInventory(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	assert.Nil(t, resources)
+
+	expected := []inventory.Resource{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: namespace, Name: "api", UID: "1", Command: "helm", Operation: inventory.Created},
+	}
+	require.NoError(t, UpdateInventory(ctx, "test", namespace, expected, client))
+	resources, err = GetInventory(ctx, "test", namespace, client)
+	require.NoError(t, err)
+	assert.Equal(t, expected, resources)
+}
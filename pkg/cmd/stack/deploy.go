@@ -16,7 +16,6 @@ package stack
 import (
 	"context"
 	"encoding/base64"
-	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -371,68 +370,7 @@ func getEndpointsToDeployFromServicesToDeploy(endpoints model.EndpointSpec, serv
 }
 
 func deployServices(ctx context.Context, stack *model.Stack, k8sClient kubernetes.Interface, config *rest.Config, options *DeployOptions, divert Divert) error {
-	deployedSvcs := make(map[string]bool)
-	t := time.NewTicker(1 * time.Second)
-	to := time.NewTicker(options.Timeout)
-
-	// show an informational warning per service once
-	serviceWarnings := make(map[string]string)
-	restartsPerSvc := map[string]int{}
-	for {
-		select {
-		case <-to.C:
-			return fmt.Errorf("compose '%s' didn't finish after %s", stack.Name, options.Timeout.String())
-		case <-t.C:
-			for len(deployedSvcs) != len(options.ServicesToDeploy) {
-				for _, svcName := range options.ServicesToDeploy {
-					areAllDependenciesDeployed := true
-					for dependentSvc := range stack.Services[svcName].DependsOn {
-						if !deployedSvcs[dependentSvc] {
-							areAllDependenciesDeployed = false
-							break
-						}
-					}
-					if deployedSvcs[svcName] || !areAllDependenciesDeployed {
-						continue
-					}
-
-					if !canSvcBeDeployed(ctx, stack, svcName, k8sClient, config) {
-						if failedJobs := getDependingFailedJobs(ctx, stack, svcName, k8sClient); len(failedJobs) > 0 {
-							if len(failedJobs) == 1 {
-								return fmt.Errorf("service '%s' dependency '%s' failed", svcName, failedJobs[0])
-							}
-							return fmt.Errorf("service '%s' dependencies '%s' failed", svcName, strings.Join(failedJobs, ", "))
-						}
-						if failedServices := getServicesWithFailedProbes(ctx, stack, svcName, k8sClient); len(failedServices) > 0 {
-							for service, err := range failedServices {
-								errMessage := fmt.Errorf("service '%s' cannot be deployed because dependent service '%s' is failing its healthcheck probes: %s", svcName, service, err)
-								if errors.Is(err, oktetoErrors.ErrLivenessProbeFailed) {
-									return errMessage
-								} else if errors.Is(err, oktetoErrors.ErrReadinessProbeFailed) {
-									if _, ok := serviceWarnings[service]; !ok {
-										oktetoLog.Information("%s", errMessage.Error())
-										serviceWarnings[service] = errMessage.Error()
-									}
-								}
-							}
-						}
-						if err := getErrorDueToRestartLimit(ctx, stack, svcName, restartsPerSvc, k8sClient); err != nil {
-							return err
-						}
-						continue
-					}
-					oktetoLog.Spinner(fmt.Sprintf("Deploying service '%s'...", svcName))
-					err := deploySvc(ctx, stack, svcName, k8sClient, divert)
-					if err != nil {
-						return err
-					}
-					deployedSvcs[svcName] = true
-					oktetoLog.Spinner("Waiting for services to be ready...")
-				}
-			}
-			return nil
-		}
-	}
+	return newServicesScheduler(stack, k8sClient, config, divert).run(ctx, options.ServicesToDeploy, options.Timeout)
 }
 
 func deploySvc(ctx context.Context, stack *model.Stack, svcName string, client kubernetes.Interface, divert Divert) error {
@@ -0,0 +1,270 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/format"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// servicesResyncPeriod is the maximum time between evaluations of the depends_on conditions of the
+	// pending services. Some conditions, like the ports of a service accepting connections, don't
+	// generate events in the cluster
+	servicesResyncPeriod = 3 * time.Second
+)
+
+// svcDeployResult is the result of deploying a service
+type svcDeployResult struct {
+	err  error
+	name string
+}
+
+// servicesScheduler deploys the services of a compose stack as soon as the conditions of their
+// depends_on are met. Every service whose conditions are met is deployed at the same time, and the
+// conditions of the pending services are evaluated again each time the pods, deployments,
+// statefulsets or jobs of the stack change
+type servicesScheduler struct {
+	stack *model.Stack
+
+	// canBeDeployed returns true when the depends_on conditions of the service are met
+	canBeDeployed func(ctx context.Context, svcName string) bool
+
+	// deploy deploys the service
+	deploy func(ctx context.Context, svcName string) error
+
+	// dependenciesError returns an error when the service won't ever be deployed because one
+	// of its dependencies failed
+	dependenciesError func(ctx context.Context, svcName string) error
+
+	// watch returns a channel notified when the resources of the stack change
+	watch func(ctx context.Context) <-chan struct{}
+
+	resyncPeriod time.Duration
+}
+
+func newServicesScheduler(stack *model.Stack, c kubernetes.Interface, config *rest.Config, divert Divert) *servicesScheduler {
+	// show an informational warning per service once
+	serviceWarnings := map[string]string{}
+	restartsPerSvc := map[string]int{}
+	return &servicesScheduler{
+		stack: stack,
+		canBeDeployed: func(ctx context.Context, svcName string) bool {
+			return canSvcBeDeployed(ctx, stack, svcName, c, config)
+		},
+		deploy: func(ctx context.Context, svcName string) error {
+			return deploySvc(ctx, stack, svcName, c, divert)
+		},
+		dependenciesError: func(ctx context.Context, svcName string) error {
+			return getDependenciesError(ctx, stack, svcName, serviceWarnings, restartsPerSvc, c)
+		},
+		watch: func(ctx context.Context) <-chan struct{} {
+			return watchStackResources(ctx, stack, c)
+		},
+		resyncPeriod: servicesResyncPeriod,
+	}
+}
+
+// run deploys the services and returns once all of them are deployed, or one of them fails
+func (s *servicesScheduler) run(ctx context.Context, servicesToDeploy []string, timeout time.Duration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	toDeploy := map[string]bool{}
+	for _, svcName := range servicesToDeploy {
+		toDeploy[svcName] = true
+	}
+	deployed := map[string]bool{}
+	deploying := map[string]bool{}
+	// results is buffered so the deploys in progress never block when the scheduler returns early
+	results := make(chan svcDeployResult, len(toDeploy))
+
+	changes := s.watch(ctx)
+	resync := time.NewTicker(s.resyncPeriod)
+	defer resync.Stop()
+	to := time.NewTimer(timeout)
+	defer to.Stop()
+
+	for len(deployed) != len(toDeploy) {
+		candidates := s.candidates(servicesToDeploy, toDeploy, deployed, deploying)
+		ready := s.ready(ctx, candidates)
+		for _, svcName := range candidates {
+			if ready[svcName] {
+				continue
+			}
+			if err := s.dependenciesError(ctx, svcName); err != nil {
+				return err
+			}
+		}
+		for _, svcName := range candidates {
+			if !ready[svcName] {
+				continue
+			}
+			deploying[svcName] = true
+			go func(svcName string) {
+				results <- svcDeployResult{name: svcName, err: s.deploy(ctx, svcName)}
+			}(svcName)
+		}
+		s.showProgress(deploying, len(deployed), len(toDeploy))
+
+		select {
+		case <-to.C:
+			return fmt.Errorf("compose '%s' didn't finish after %s", s.stack.Name, timeout.String())
+		case <-ctx.Done():
+			return ctx.Err()
+		case r := <-results:
+			delete(deploying, r.name)
+			if r.err != nil {
+				return r.err
+			}
+			deployed[r.name] = true
+		case <-changes:
+		case <-resync.C:
+		}
+	}
+	return nil
+}
+
+// candidates returns the pending services whose dependencies in the deploy were already deployed
+func (s *servicesScheduler) candidates(servicesToDeploy []string, toDeploy, deployed, deploying map[string]bool) []string {
+	result := []string{}
+	for _, svcName := range servicesToDeploy {
+		if deployed[svcName] || deploying[svcName] {
+			continue
+		}
+		areAllDependenciesDeployed := true
+		for dependentSvc := range s.stack.Services[svcName].DependsOn {
+			if toDeploy[dependentSvc] && !deployed[dependentSvc] {
+				areAllDependenciesDeployed = false
+				break
+			}
+		}
+		if areAllDependenciesDeployed {
+			result = append(result, svcName)
+		}
+	}
+	return result
+}
+
+// ready evaluates at the same time the depends_on conditions of the services, as some of them
+// need a port-forward to the pods of the dependencies
+func (s *servicesScheduler) ready(ctx context.Context, candidates []string) map[string]bool {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	result := map[string]bool{}
+	for _, svcName := range candidates {
+		wg.Add(1)
+		go func(svcName string) {
+			defer wg.Done()
+			ok := s.canBeDeployed(ctx, svcName)
+			mu.Lock()
+			result[svcName] = ok
+			mu.Unlock()
+		}(svcName)
+	}
+	wg.Wait()
+	return result
+}
+
+func (*servicesScheduler) showProgress(deploying map[string]bool, deployed, total int) {
+	if len(deploying) == 0 {
+		oktetoLog.Spinner(fmt.Sprintf("Waiting for services to be ready (%d/%d deployed)...", deployed, total))
+		return
+	}
+	names := make([]string, 0, len(deploying))
+	for svcName := range deploying {
+		names = append(names, svcName)
+	}
+	sort.Strings(names)
+	oktetoLog.Spinner(fmt.Sprintf("Deploying services '%s' (%d/%d deployed)...", strings.Join(names, "', '"), deployed, total))
+}
+
+// getDependenciesError returns an error when a dependency of the service failed: a job that failed,
+// a service failing its liveness probe or restarting too many times. Services failing their
+// readiness probe are shown as a warning once
+func getDependenciesError(ctx context.Context, stack *model.Stack, svcName string, serviceWarnings map[string]string, restartsPerSvc map[string]int, c kubernetes.Interface) error {
+	if failedJobs := getDependingFailedJobs(ctx, stack, svcName, c); len(failedJobs) > 0 {
+		if len(failedJobs) == 1 {
+			return fmt.Errorf("service '%s' dependency '%s' failed", svcName, failedJobs[0])
+		}
+		return fmt.Errorf("service '%s' dependencies '%s' failed", svcName, strings.Join(failedJobs, ", "))
+	}
+	if failedServices := getServicesWithFailedProbes(ctx, stack, svcName, c); len(failedServices) > 0 {
+		for service, err := range failedServices {
+			errMessage := fmt.Errorf("service '%s' cannot be deployed because dependent service '%s' is failing its healthcheck probes: %s", svcName, service, err)
+			if errors.Is(err, oktetoErrors.ErrLivenessProbeFailed) {
+				return errMessage
+			} else if errors.Is(err, oktetoErrors.ErrReadinessProbeFailed) {
+				if _, ok := serviceWarnings[service]; !ok {
+					oktetoLog.Information("%s", errMessage.Error())
+					serviceWarnings[service] = errMessage.Error()
+				}
+			}
+		}
+	}
+	return getErrorDueToRestartLimit(ctx, stack, svcName, restartsPerSvc, c)
+}
+
+// watchStackResources returns a channel notified each time the pods, deployments, statefulsets or
+// jobs of the stack change. Notifications are coalesced while the previous one wasn't received
+func watchStackResources(ctx context.Context, stack *model.Stack, c kubernetes.Interface) <-chan struct{} {
+	changes := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { notify() },
+		UpdateFunc: func(interface{}, interface{}) { notify() },
+		DeleteFunc: func(interface{}) { notify() },
+	}
+
+	factory := informers.NewSharedInformerFactoryWithOptions(
+		c,
+		0,
+		informers.WithNamespace(stack.Namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = fmt.Sprintf("%s=%s", model.StackNameLabel, format.ResourceK8sMetaString(stack.Name))
+		}),
+	)
+	for _, informer := range []cache.SharedIndexInformer{
+		factory.Core().V1().Pods().Informer(),
+		factory.Apps().V1().Deployments().Informer(),
+		factory.Apps().V1().StatefulSets().Informer(),
+		factory.Batch().V1().Jobs().Informer(),
+	} {
+		if _, err := informer.AddEventHandler(handler); err != nil {
+			oktetoLog.Infof("could not watch the resources of compose '%s': %s", stack.Name, err)
+		}
+	}
+	factory.Start(ctx.Done())
+	return changes
+}
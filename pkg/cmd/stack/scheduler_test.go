@@ -0,0 +1,212 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeSchedulerCluster deploys services in memory. The depends_on conditions of a service are met
+// once its dependencies are deployed
+type fakeSchedulerCluster struct {
+	deployed    map[string]bool
+	started     chan string
+	release     chan struct{}
+	failed      string
+	order       []string
+	mu          sync.Mutex
+	concurrent  int
+	maxParallel int
+}
+
+func newFakeSchedulerCluster() *fakeSchedulerCluster {
+	return &fakeSchedulerCluster{
+		deployed: map[string]bool{},
+		started:  make(chan string, 10),
+	}
+}
+
+func (f *fakeSchedulerCluster) scheduler(stack *model.Stack) *servicesScheduler {
+	changes := make(chan struct{}, 1)
+	return &servicesScheduler{
+		stack: stack,
+		canBeDeployed: func(_ context.Context, svcName string) bool {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			for dependentSvc := range stack.Services[svcName].DependsOn {
+				if !f.deployed[dependentSvc] {
+					return false
+				}
+			}
+			return true
+		},
+		deploy: func(_ context.Context, svcName string) error {
+			f.mu.Lock()
+			f.concurrent++
+			if f.concurrent > f.maxParallel {
+				f.maxParallel = f.concurrent
+			}
+			f.mu.Unlock()
+			f.started <- svcName
+			if f.release != nil {
+				<-f.release
+			}
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.concurrent--
+			if svcName == f.failed {
+				return errors.New("error creating deployment")
+			}
+			f.deployed[svcName] = true
+			f.order = append(f.order, svcName)
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+			return nil
+		},
+		dependenciesError: func(context.Context, string) error { return nil },
+		watch:             func(context.Context) <-chan struct{} { return changes },
+		resyncPeriod:      time.Hour,
+	}
+}
+
+func TestServicesSchedulerDeploysIndependentServicesConcurrently(t *testing.T) {
+	stack := &model.Stack{
+		Name: "test",
+		Services: map[string]*model.Service{
+			"db":     {},
+			"cache":  {},
+			"api":    {DependsOn: model.DependsOn{"db": {Condition: model.DependsOnServiceRunning}}},
+			"worker": {DependsOn: model.DependsOn{"api": {Condition: model.DependsOnServiceRunning}, "cache": {Condition: model.DependsOnServiceRunning}}},
+		},
+	}
+	cluster := newFakeSchedulerCluster()
+	cluster.release = make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- cluster.scheduler(stack).run(context.Background(), []string{"api", "cache", "db", "worker"}, time.Minute)
+	}()
+
+	// db and cache don't depend on anything, so both are deployed at the same time
+	first := []string{<-cluster.started, <-cluster.started}
+	assert.ElementsMatch(t, []string{"cache", "db"}, first)
+	cluster.release <- struct{}{}
+	cluster.release <- struct{}{}
+
+	assert.Equal(t, "api", <-cluster.started)
+	cluster.release <- struct{}{}
+	assert.Equal(t, "worker", <-cluster.started)
+	cluster.release <- struct{}{}
+
+	require.NoError(t, <-done)
+	assert.Equal(t, 2, cluster.maxParallel)
+	assert.Equal(t, []string{"api", "worker"}, cluster.order[2:])
+}
+
+func TestServicesSchedulerDependencyOutsideTheDeploy(t *testing.T) {
+	stack := &model.Stack{
+		Name: "test",
+		Services: map[string]*model.Service{
+			"db":  {},
+			"api": {DependsOn: model.DependsOn{"db": {Condition: model.DependsOnServiceRunning}}},
+		},
+	}
+	cluster := newFakeSchedulerCluster()
+	cluster.deployed["db"] = true
+
+	require.NoError(t, cluster.scheduler(stack).run(context.Background(), []string{"api"}, time.Minute))
+	assert.Equal(t, []string{"api"}, cluster.order)
+}
+
+func TestServicesSchedulerDeployFails(t *testing.T) {
+	stack := &model.Stack{
+		Name: "test",
+		Services: map[string]*model.Service{
+			"db":  {},
+			"api": {DependsOn: model.DependsOn{"db": {Condition: model.DependsOnServiceRunning}}},
+		},
+	}
+	cluster := newFakeSchedulerCluster()
+	cluster.failed = "db"
+
+	err := cluster.scheduler(stack).run(context.Background(), []string{"api", "db"}, time.Minute)
+	require.EqualError(t, err, "error creating deployment")
+	assert.Empty(t, cluster.order)
+}
+
+func TestServicesSchedulerDependenciesError(t *testing.T) {
+	stack := &model.Stack{
+		Name: "test",
+		Services: map[string]*model.Service{
+			"api": {DependsOn: model.DependsOn{"migrations": {Condition: model.DependsOnServiceCompleted}}},
+		},
+	}
+	cluster := newFakeSchedulerCluster()
+	scheduler := cluster.scheduler(stack)
+	scheduler.dependenciesError = func(_ context.Context, svcName string) error {
+		return errors.New("service '" + svcName + "' dependency 'migrations' failed")
+	}
+
+	err := scheduler.run(context.Background(), []string{"api"}, time.Minute)
+	require.EqualError(t, err, "service 'api' dependency 'migrations' failed")
+}
+
+func TestServicesSchedulerTimeout(t *testing.T) {
+	stack := &model.Stack{
+		Name: "test",
+		Services: map[string]*model.Service{
+			"api": {DependsOn: model.DependsOn{"db": {Condition: model.DependsOnServiceHealthy}}},
+		},
+	}
+	cluster := newFakeSchedulerCluster()
+	scheduler := cluster.scheduler(stack)
+	scheduler.resyncPeriod = time.Millisecond
+
+	err := scheduler.run(context.Background(), []string{"api"}, 20*time.Millisecond)
+	require.EqualError(t, err, "compose 'test' didn't finish after 20ms")
+}
+
+func TestWatchStackResources(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := fake.NewClientset()
+	stack := &model.Stack{Name: "My Stack", Namespace: "ns"}
+
+	changes := watchStackResources(ctx, stack, c)
+	_, err := c.CoreV1().Pods("ns").Create(ctx, &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "api",
+			Labels: map[string]string{model.StackNameLabel: "my-stack"},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	select {
+	case <-changes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change of the pod was not notified")
+	}
+}
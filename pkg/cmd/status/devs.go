@@ -0,0 +1,174 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/okteto/okteto/pkg/config"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/model/forward"
+	"github.com/okteto/okteto/pkg/syncthing"
+)
+
+// Inactive is the state reported by Watch when a development container leaves dev mode
+const Inactive config.UpState = "inactive"
+
+// DevStatus is the status of a development container in dev mode
+type DevStatus struct {
+	Sync     *SyncStatus      `json:"sync,omitempty"`
+	Name     string           `json:"name"`
+	State    config.UpState   `json:"state"`
+	Forwards []forward.Status `json:"forwards"`
+}
+
+// SyncStatus is the status of the file synchronization of a development container
+type SyncStatus struct {
+	InProgressFile   string  `json:"inProgressFile,omitempty"`
+	LocalError       string  `json:"localError,omitempty"`
+	RemoteError      string  `json:"remoteError,omitempty"`
+	Error            string  `json:"error,omitempty"`
+	LocalCompletion  float64 `json:"localCompletion"`
+	RemoteCompletion float64 `json:"remoteCompletion"`
+	Completion       float64 `json:"completion"`
+}
+
+type syncthingClient interface {
+	GetCompletion(ctx context.Context, local bool, device string) (*syncthing.Completion, error)
+	GetFolderErrors(ctx context.Context, local bool) error
+	GetInSynchronizationFile(ctx context.Context) string
+}
+
+var loadSyncthing = func(dev *model.Dev, namespace string) (syncthingClient, error) {
+	return syncthing.Load(dev, namespace)
+}
+
+// GetSyncStatus returns the status of the file synchronization
+func GetSyncStatus(ctx context.Context, sy syncthingClient) *SyncStatus {
+	result := &SyncStatus{}
+	var err error
+	result.LocalCompletion, err = getCompletionProgress(ctx, sy, true)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.RemoteCompletion, err = getCompletionProgress(ctx, sy, false)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Completion = computeProgress(result.LocalCompletion, result.RemoteCompletion)
+
+	result.LocalError = folderError(sy.GetFolderErrors(ctx, true))
+	result.RemoteError = folderError(sy.GetFolderErrors(ctx, false))
+	if result.Completion != completedProgressValue {
+		result.InProgressFile = sy.GetInSynchronizationFile(ctx)
+	}
+	return result
+}
+
+// folderError returns the message of a folder error, ignoring the errors of a busy syncthing
+func folderError(err error) string {
+	if err == nil || errors.Is(err, oktetoErrors.ErrBusySyncthing) {
+		return ""
+	}
+	return err.Error()
+}
+
+// GetDevStatuses returns the status of the development containers in dev mode, sorted by name
+func GetDevStatuses(ctx context.Context, devs model.ManifestDevs, namespace string) []DevStatus {
+	names := devs.GetDevs()
+	sort.Strings(names)
+
+	result := []DevStatus{}
+	for _, name := range names {
+		state, err := config.GetState(name, namespace)
+		if err != nil {
+			continue
+		}
+
+		s := DevStatus{
+			Name:  name,
+			State: state,
+		}
+
+		if state == config.Synchronizing || state == config.Ready {
+			sy, err := loadSyncthing(devs[name], namespace)
+			if err != nil {
+				oktetoLog.Infof("error accessing the syncthing info file of '%s': %s", name, err)
+				s.Sync = &SyncStatus{Error: oktetoErrors.ErrNotInDevMode.Error()}
+			} else {
+				s.Sync = GetSyncStatus(ctx, sy)
+			}
+		}
+
+		s.Forwards, err = config.GetForwards(name, namespace)
+		if err != nil {
+			oktetoLog.Infof("error reading the forwards of '%s': %s", name, err)
+		}
+		if s.Forwards == nil {
+			s.Forwards = []forward.Status{}
+		}
+
+		result = append(result, s)
+	}
+	return result
+}
+
+// Watch polls the status of the development containers and calls emit with the status of every development container
+// that changed since the previous poll, until ctx is done
+func Watch(ctx context.Context, devs model.ManifestDevs, namespace string, interval time.Duration, emit func(DevStatus) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	previous := map[string]DevStatus{}
+	for {
+		current := map[string]DevStatus{}
+		for _, s := range GetDevStatuses(ctx, devs, namespace) {
+			current[s.Name] = s
+			if p, ok := previous[s.Name]; ok && reflect.DeepEqual(p, s) {
+				continue
+			}
+			if err := emit(s); err != nil {
+				return err
+			}
+		}
+
+		inactive := []string{}
+		for name := range previous {
+			if _, ok := current[name]; !ok {
+				inactive = append(inactive, name)
+			}
+		}
+		sort.Strings(inactive)
+		for _, name := range inactive {
+			if err := emit(DevStatus{Name: name, State: Inactive, Forwards: []forward.Status{}}); err != nil {
+				return err
+			}
+		}
+		previous = current
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/config"
+	"github.com/okteto/okteto/pkg/constants"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/model/forward"
+	"github.com/okteto/okteto/pkg/syncthing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSyncthing struct {
+	completion  map[bool]*syncthing.Completion
+	folderError map[bool]error
+	file        string
+}
+
+func (f *fakeSyncthing) GetCompletion(_ context.Context, local bool, _ string) (*syncthing.Completion, error) {
+	c, ok := f.completion[local]
+	if !ok {
+		return nil, oktetoErrors.ErrLostSyncthing
+	}
+	return c, nil
+}
+
+func (f *fakeSyncthing) GetFolderErrors(_ context.Context, local bool) error {
+	return f.folderError[local]
+}
+
+func (f *fakeSyncthing) GetInSynchronizationFile(_ context.Context) string {
+	return f.file
+}
+
+func TestGetSyncStatus(t *testing.T) {
+	tests := []struct {
+		sy       *fakeSyncthing
+		expected *SyncStatus
+		name     string
+	}{
+		{
+			name: "synchronizing",
+			sy: &fakeSyncthing{
+				completion: map[bool]*syncthing.Completion{
+					true:  {GlobalBytes: 100, NeedBytes: 50},
+					false: {GlobalBytes: 0},
+				},
+				folderError: map[bool]error{
+					true:  oktetoErrors.ErrBusySyncthing,
+					false: errors.New("app/main.go: permission denied"),
+				},
+				file: "app/main.go",
+			},
+			expected: &SyncStatus{
+				LocalCompletion:  50,
+				RemoteCompletion: 100,
+				Completion:       50,
+				RemoteError:      "app/main.go: permission denied",
+				InProgressFile:   "app/main.go",
+			},
+		},
+		{
+			name: "synchronized",
+			sy: &fakeSyncthing{
+				completion: map[bool]*syncthing.Completion{
+					true:  {GlobalBytes: 100},
+					false: {GlobalBytes: 100},
+				},
+				file: "app/main.go",
+			},
+			expected: &SyncStatus{
+				LocalCompletion:  100,
+				RemoteCompletion: 100,
+				Completion:       100,
+			},
+		},
+		{
+			name: "lost-syncthing",
+			sy:   &fakeSyncthing{},
+			expected: &SyncStatus{
+				Error: oktetoErrors.ErrLostSyncthing.Error(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetSyncStatus(context.Background(), tt.sy))
+		})
+	}
+}
+
+func setupDevs(t *testing.T) model.ManifestDevs {
+	t.Helper()
+	t.Setenv(constants.OktetoFolderEnvVar, t.TempDir())
+	loadSyncthing = func(_ *model.Dev, _ string) (syncthingClient, error) {
+		return &fakeSyncthing{
+			completion: map[bool]*syncthing.Completion{
+				true:  {GlobalBytes: 100},
+				false: {GlobalBytes: 100},
+			},
+		}, nil
+	}
+	t.Cleanup(func() {
+		loadSyncthing = func(dev *model.Dev, namespace string) (syncthingClient, error) {
+			return syncthing.Load(dev, namespace)
+		}
+	})
+
+	return model.ManifestDevs{
+		"api":      &model.Dev{Name: "api"},
+		"frontend": &model.Dev{Name: "frontend"},
+		"worker":   &model.Dev{Name: "worker"},
+	}
+}
+
+func TestGetDevStatuses(t *testing.T) {
+	devs := setupDevs(t)
+	require.NoError(t, config.UpdateStateFile("worker", "ns", config.Ready))
+	require.NoError(t, config.UpdateStateFile("api", "ns", config.Pulling))
+	forwards := []forward.Status{{Local: 8080, Forward: "8080:db:5432", State: forward.StateConnected}}
+	require.NoError(t, config.UpdateForwardsFile("worker", "ns", forwards))
+
+	statuses := GetDevStatuses(context.Background(), devs, "ns")
+
+	expected := []DevStatus{
+		{
+			Name:     "api",
+			State:    config.Pulling,
+			Forwards: []forward.Status{},
+		},
+		{
+			Name:     "worker",
+			State:    config.Ready,
+			Sync:     &SyncStatus{LocalCompletion: 100, RemoteCompletion: 100, Completion: 100},
+			Forwards: forwards,
+		},
+	}
+	assert.Equal(t, expected, statuses)
+}
+
+func TestWatch(t *testing.T) {
+	devs := setupDevs(t)
+	require.NoError(t, config.UpdateStateFile("api", "ns", config.Synchronizing))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := []DevStatus{}
+
+	// the status changes between polls: api is ready, then it leaves dev mode
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = config.UpdateStateFile("api", "ns", config.Ready)
+		time.Sleep(50 * time.Millisecond)
+		_ = config.DeleteStateFile("api", "ns")
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	require.NoError(t, Watch(ctx, devs, "ns", 10*time.Millisecond, func(s DevStatus) error {
+		events = append(events, s)
+		return nil
+	}))
+
+	states := []config.UpState{}
+	for _, e := range events {
+		assert.Equal(t, "api", e.Name)
+		states = append(states, e.State)
+	}
+	assert.Equal(t, []config.UpState{config.Synchronizing, config.Ready, Inactive}, states)
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/okteto/okteto/pkg/model/forward"
+)
+
+// PrintForwards writes a table with the health of the global forwards
+func PrintForwards(out io.Writer, statuses []forward.Status, now time.Time) error {
+	w := tabwriter.NewWriter(out, 1, 1, 2, ' ', 0)
+	fmt.Fprintf(w, "Forward\tState\tRetries\tSince\tError\n")
+	for _, s := range statuses {
+		errMsg := s.Error
+		if errMsg == "" {
+			errMsg = "-"
+		}
+		since := "-"
+		if !s.LastTransition.IsZero() {
+			since = now.Sub(s.LastTransition).Round(time.Second).String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", s.Forward, s.State, s.Retries, since, errMsg)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/model/forward"
+)
+
+func TestPrintForwards(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	statuses := []forward.Status{
+		{Local: 5432, Forward: "5432:db:5432", State: forward.StateReconnecting, Retries: 2, Error: "lost connection to pod", LastTransition: now.Add(-90 * time.Second)},
+		{Local: 8080, Forward: "8080:api:80", State: forward.StateConnected, LastTransition: now.Add(-time.Hour)},
+	}
+
+	var out bytes.Buffer
+	if err := PrintForwards(&out, statuses, now); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `Forward       State         Retries  Since   Error
+5432:db:5432  reconnecting  2        1m30s   lost connection to pod
+8080:api:80   connected     0        1h0m0s  -
+`
+	if out.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
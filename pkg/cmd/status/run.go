@@ -47,7 +47,7 @@ func Run(ctx context.Context, sy *syncthing.Syncthing) (float64, error) {
 	return computeProgress(progressLocal, progressRemote), nil
 }
 
-func getCompletionProgress(ctx context.Context, s *syncthing.Syncthing, local bool) (float64, error) {
+func getCompletionProgress(ctx context.Context, s syncthingClient, local bool) (float64, error) {
 	device := syncthing.DefaultRemoteDeviceID
 	if local {
 		device = syncthing.LocalDeviceID
@@ -0,0 +1,39 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeJSON(t *testing.T) {
+	values := map[string][]int{"a": {1, 2}, "b": {3}}
+
+	encoded, err := EncodeJSON(values)
+	require.NoError(t, err)
+
+	var decoded map[string][]int
+	require.NoError(t, DecodeJSON(encoded, &decoded))
+	assert.Equal(t, values, decoded)
+}
+
+func TestDecodeJSONInvalid(t *testing.T) {
+	var decoded []string
+	require.ErrorContains(t, DecodeJSON("not-base64!", &decoded), "invalid encoding")
+	require.ErrorContains(t, DecodeJSON(base64.StdEncoding.EncodeToString([]byte("not gzip")), &decoded), "invalid encoding")
+}
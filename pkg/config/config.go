@@ -14,6 +14,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -24,6 +25,7 @@ import (
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
 	"github.com/okteto/okteto/pkg/filesystem"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model/forward"
 	"github.com/spf13/afero"
 	"gopkg.in/yaml.v2"
 )
@@ -58,6 +60,8 @@ const (
 
 	stateFile string = "okteto.state"
 
+	forwardsFile string = "forwards.json"
+
 	// OktetoContextVariableName defines the kubeconfig context of okteto commands
 	OktetoContextVariableName = "OKTETO_CONTEXT"
 
@@ -194,6 +198,72 @@ func GetState(devName, devNamespace string) (UpState, error) {
 	return result, nil
 }
 
+// UpdateForwardsFile updates the file with the health of the global forwards of a given dev environment
+func UpdateForwardsFile(devName, devNamespace string, statuses []forward.Status) error {
+	if devNamespace == "" {
+		return fmt.Errorf("can't update forwards file, namespace is empty")
+	}
+
+	if devName == "" {
+		return fmt.Errorf("can't update forwards file, name is empty")
+	}
+
+	b, err := json.Marshal(statuses)
+	if err != nil {
+		return err
+	}
+
+	s := filepath.Join(GetAppHome(devNamespace, devName), forwardsFile)
+	if err := os.WriteFile(s, b, 0600); err != nil {
+		return fmt.Errorf("failed to update forwards file: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteForwardsFile deletes the file with the health of the global forwards of a given dev environment
+func DeleteForwardsFile(devName, devNamespace string) error {
+	if devNamespace == "" {
+		return fmt.Errorf("can't delete forwards file, namespace is empty")
+	}
+
+	if devName == "" {
+		return fmt.Errorf("can't delete forwards file, name is empty")
+	}
+
+	s := filepath.Join(GetAppHome(devNamespace, devName), forwardsFile)
+	if err := os.Remove(s); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// GetForwards returns the health of the global forwards of a given dev environment
+func GetForwards(devName, devNamespace string) ([]forward.Status, error) {
+	if devNamespace == "" {
+		return nil, fmt.Errorf("can't read forwards file, namespace is empty")
+	}
+
+	if devName == "" {
+		return nil, fmt.Errorf("can't read forwards file, name is empty")
+	}
+
+	s := filepath.Join(GetAppHome(devNamespace, devName), forwardsFile)
+	b, err := os.ReadFile(s)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []forward.Status{}, nil
+		}
+		return nil, fmt.Errorf("failed to read forwards file: %w", err)
+	}
+
+	result := []forward.Status{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse forwards file: %w", err)
+	}
+	return result, nil
+}
+
 // GetUserHomeDirWithFilesystem returns the OS home dir using the provided file system
 func GetUserHomeDirWithFilesystem(fs afero.Fs) string {
 	if v, ok := os.LookupEnv(constants.OktetoHomeEnvVar); ok {
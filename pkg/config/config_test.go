@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/model/forward"
 )
 
 func TestGetUserHomeDir(t *testing.T) {
@@ -135,3 +136,40 @@ func TestGetAppHome(t *testing.T) {
 		t.Errorf("expected %s, got %s", expected, got)
 	}
 }
+
+func TestForwardsFile(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Setenv(constants.OktetoFolderEnvVar, dir)
+
+	got, err := GetForwards("dp", "ns")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no forwards, got %v", got)
+	}
+
+	statuses := []forward.Status{
+		{Local: 8080, Forward: "8080:api:80", State: forward.StateConnected},
+		{Local: 5432, Forward: "5432:db:5432", State: forward.StateReconnecting, Retries: 2, Error: "connection refused"},
+	}
+	if err := UpdateForwardsFile("dp", "ns", statuses); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = GetForwards("dp", "ns")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[1].State != forward.StateReconnecting || got[1].Error != "connection refused" {
+		t.Errorf("unexpected forwards: %+v", got)
+	}
+
+	if err := DeleteForwardsFile("dp", "ns"); err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteForwardsFile("dp", "ns"); err != nil {
+		t.Errorf("deleting a missing forwards file returned an error: %s", err)
+	}
+}
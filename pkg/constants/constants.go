@@ -119,6 +119,9 @@ const (
 	// OktetoDivertIstioDriver is the divert driver for istio
 	OktetoDivertIstioDriver = "istio"
 
+	// OktetoDivertGatewayDriver is the divert driver for the Gateway API
+	OktetoDivertGatewayDriver = "gateway"
+
 	// OktetoDivertBaggageHeader represents the baggage header
 	OktetoDivertBaggageHeader = "baggage"
 
@@ -152,6 +155,12 @@ const (
 	// OktetoIsPreviewEnvVar Env variable containing a boolean indicating if the environment is a preview environment
 	OktetoIsPreviewEnvVar = "OKTETO_IS_PREVIEW_ENVIRONMENT"
 
+	// OktetoTestShardIndexEnvVar Env variable containing the index, starting at 0, of the shard running a test container
+	OktetoTestShardIndexEnvVar = "OKTETO_TEST_SHARD_INDEX"
+
+	// OktetoTestShardTotalEnvVar Env variable containing the number of shards of a test container
+	OktetoTestShardTotalEnvVar = "OKTETO_TEST_SHARD_TOTAL"
+
 	// OktetoIsRedeployEnvVar Env variable containing a boolean set by the installer indicating if the deploy is a redeploy of an existing development environment
 	OktetoIsRedeployEnvVar = "OKTETO_IS_REDEPLOY"
 
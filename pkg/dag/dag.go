@@ -76,6 +76,30 @@ func (tree *Tree) Subtree(nodeIds ...string) (*Tree, error) {
 	return From(subnodes...)
 }
 
+// Descendants returns the ids of the given nodes and all the nodes that depend on them, directly
+// or transitively, ordered by dependsOn
+func (tree *Tree) Descendants(nodeIds ...string) ([]string, error) {
+	selection := make(map[string]bool)
+	for _, nodeId := range nodeIds {
+		descendants, err := tree.graph.GetDescendants(nodeId)
+		if err != nil {
+			return nil, err
+		}
+		selection[nodeId] = true
+		for id := range descendants {
+			selection[id] = true
+		}
+	}
+
+	var result []string
+	for _, id := range tree.Ordered() {
+		if selection[id] {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
 func (tree *Tree) Traverse(fn func(n Node)) {
 	tree.graph.OrderedWalk(callback(fn))
 }
@@ -216,3 +216,26 @@ func TestSubtree(t *testing.T) {
 		})
 	}
 }
+
+func TestDescendants(t *testing.T) {
+	v99 := &testNode{id: "v99"}
+	v3 := &testNode{id: "v3", dependsOn: []string{"v1"}}
+	v2 := &testNode{id: "v2", dependsOn: []string{"v1"}}
+	v1 := &testNode{id: "v1", dependsOn: []string{"v0"}}
+	v0 := &testNode{id: "v0"}
+
+	tree, err := From(v99, v3, v2, v1, v0)
+	require.NoError(t, err)
+
+	result, err := tree.Descendants("v1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"v1", "v2", "v3"}, result)
+	assert.Equal(t, "v1", result[0])
+
+	result, err = tree.Descendants("v99", "v3")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"v99", "v3"}, result)
+
+	_, err = tree.Descendants("unknown")
+	require.Error(t, err)
+}
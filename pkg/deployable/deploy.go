@@ -23,12 +23,15 @@ import (
 	"time"
 
 	"github.com/okteto/okteto/cmd/utils/executor"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
 	"github.com/okteto/okteto/pkg/constants"
 	"github.com/okteto/okteto/pkg/devenvironment"
 	"github.com/okteto/okteto/pkg/divert"
 	"github.com/okteto/okteto/pkg/env"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
 	"github.com/okteto/okteto/pkg/externalresource"
 	"github.com/okteto/okteto/pkg/format"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	kconfig "github.com/okteto/okteto/pkg/k8s/kubeconfig"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/log/io"
@@ -50,6 +53,8 @@ type ProxyInterface interface {
 	GetToken() string
 	SetName(name string)
 	SetDivert(driver divert.Driver)
+	SetInventory(inv *inventory.Inventory)
+	SetDryRun(d *DryRun)
 	InitTranslator()
 }
 
@@ -65,6 +70,9 @@ type KubeConfigHandler interface {
 type ConfigMapHandler interface {
 	UpdateEnvsFromCommands(context.Context, string, string, []string) error
 	AddPhaseDuration(context.Context, string, string, string, time.Duration) error
+	GetInventory(context.Context, string, string) ([]inventory.Resource, error)
+	UpdateInventory(context.Context, string, string, []inventory.Resource) error
+	UpdateOutputs(context.Context, string, string, []pipeline.Output) error
 }
 
 // ExternalResourceInterface defines the operations to work with external resources
@@ -88,6 +96,8 @@ type DeployRunner struct {
 	k8sLogger          *io.K8sLogger
 	TempKubeconfigFile string
 	IOCtrl             *io.Controller
+	inventory          *inventory.Inventory
+	dryRun             *DryRun
 }
 
 // Entity represents a set of resources that can be deployed by the runner
@@ -109,6 +119,8 @@ type DeployParameters struct {
 	ManifestPath string
 	Deployable   Entity
 	Variables    []string
+	// DryRun previews the changes of the deploy without persisting them
+	DryRun bool
 }
 
 // PortGetterFunc is a function that retrieves a free port the port for specified interface
@@ -227,6 +239,18 @@ func (r *DeployRunner) RunDeploy(ctx context.Context, params DeployParameters) e
 	}
 	r.Proxy.InitTranslator()
 
+	if params.DryRun {
+		r.dryRun = NewDryRun()
+		r.Proxy.SetDryRun(r.dryRun)
+	} else {
+		previous, err := r.ConfigMapHandler.GetInventory(ctx, params.Name, params.Namespace)
+		if err != nil {
+			oktetoLog.Infof("could not get the inventory of the previous deploy: %s", err)
+		}
+		r.inventory = inventory.New(previous)
+		r.Proxy.SetInventory(r.inventory)
+	}
+
 	os.Setenv(constants.OktetoNameEnvVar, params.Name)
 
 	oktetoLog.SetStage("")
@@ -285,6 +309,11 @@ func (r *DeployRunner) RunDeploy(ctx context.Context, params DeployParameters) e
 
 	oktetoLog.EnableMasking()
 	err = r.runCommandsSection(ctx, params)
+	if params.DryRun {
+		r.printDryRun(params, err)
+		return err
+	}
+	r.recordInventory(ctx, params, err)
 	return err
 }
 
@@ -300,6 +329,8 @@ func (r *DeployRunner) runCommandsSection(ctx context.Context, params DeployPara
 	envStepper := NewEnvStepper(oktetoEnvFile.Name())
 	params.Variables = appendGatewayEnvVars(params.Variables)
 
+	var outputs []pipeline.Output
+
 	if len(params.Deployable.Commands) != 0 {
 		startTime := time.Now()
 		// deploy commands if any
@@ -307,13 +338,11 @@ func (r *DeployRunner) runCommandsSection(ctx context.Context, params DeployPara
 			oktetoLog.Information("Running '%s'", command.Name)
 			oktetoLog.SetStage(command.Name)
 			oktetoLog.AddToBuffer(oktetoLog.InfoLevel, "Executing command '%s'...", command.Name)
+			r.inventory.SetCommand(command.Name)
 
 			err := r.Executor.Execute(command, params.Variables)
 			if err != nil {
-				elapsedTime := time.Since(startTime)
-				if err := r.ConfigMapHandler.AddPhaseDuration(ctx, params.Name, params.Namespace, deployCommandsPhaseName, elapsedTime); err != nil {
-					oktetoLog.Infof("error adding phase to configmap: %s", err)
-				}
+				r.addCommandsPhaseDuration(ctx, params, time.Since(startTime))
 				oktetoLog.AddToBuffer(oktetoLog.ErrorLevel, "error executing command '%s': %s", command.Name, err.Error())
 				return fmt.Errorf("error executing command '%s': %s", command.Name, err.Error())
 			}
@@ -329,19 +358,34 @@ func (r *DeployRunner) runCommandsSection(ctx context.Context, params DeployPara
 			// variable, the executor will use in next command the last one added which
 			// corresponds to those coming from $OKTETO_ENV.
 			params.Variables = append(params.Variables, envsFromOktetoEnvFile...)
+
+			commandOutputs, err := getCommandOutputs(command, envStepper.StepMap())
+			if err != nil {
+				r.addCommandsPhaseDuration(ctx, params, time.Since(startTime))
+				oktetoLog.AddToBuffer(oktetoLog.ErrorLevel, "%s", err.Error())
+				return err
+			}
+			outputs = append(outputs, commandOutputs...)
 			oktetoLog.SetStage("")
 			oktetoLog.SetLevel("")
 		}
-		elapsedTime := time.Since(startTime)
-		if err := r.ConfigMapHandler.AddPhaseDuration(ctx, params.Name, params.Namespace, deployCommandsPhaseName, elapsedTime); err != nil {
-			oktetoLog.Infof("error adding phase to configmap: %s", err)
-		}
+		r.addCommandsPhaseDuration(ctx, params, time.Since(startTime))
 	}
-	err = r.ConfigMapHandler.UpdateEnvsFromCommands(ctx, params.Name, params.Namespace, params.Variables)
-	if err != nil {
-		oktetoLog.SetStage(oktetoLog.UnexpectedErrorStage)
-		oktetoLog.AddToBuffer(oktetoLog.ErrorLevel, "error persisting OKTETO_ENV: %s", err.Error())
-		return fmt.Errorf("could not update config map with environment variables: %w", err)
+	// the ConfigMap of the dev environment isn't updated in dry run mode
+	if !params.DryRun {
+		// the values of the sensitive outputs are only stored in the Secret of the outputs
+		envs := pipeline.RemoveSensitiveOutputs(params.Variables, outputs)
+		err = r.ConfigMapHandler.UpdateEnvsFromCommands(ctx, params.Name, params.Namespace, envs)
+		if err != nil {
+			oktetoLog.SetStage(oktetoLog.UnexpectedErrorStage)
+			oktetoLog.AddToBuffer(oktetoLog.ErrorLevel, "error persisting OKTETO_ENV: %s", err.Error())
+			return fmt.Errorf("could not update config map with environment variables: %w", err)
+		}
+		if err := r.ConfigMapHandler.UpdateOutputs(ctx, params.Name, params.Namespace, outputs); err != nil {
+			oktetoLog.SetStage(oktetoLog.UnexpectedErrorStage)
+			oktetoLog.AddToBuffer(oktetoLog.ErrorLevel, "error persisting outputs: %s", err.Error())
+			return fmt.Errorf("could not update config map with outputs: %w", err)
+		}
 	}
 
 	// deploy externals if any
@@ -362,6 +406,39 @@ func (r *DeployRunner) runCommandsSection(ctx context.Context, params DeployPara
 	return nil
 }
 
+// getCommandOutputs returns the outputs declared by a command from the variables it wrote to $OKTETO_ENV.
+// The values of the sensitive outputs are masked in the logs from now on
+func getCommandOutputs(command model.DeployCommand, envs map[string]string) ([]pipeline.Output, error) {
+	outputs := make([]pipeline.Output, 0, len(command.Outputs))
+	for _, o := range command.Outputs {
+		value, ok := envs[o.Name]
+		if !ok {
+			return nil, oktetoErrors.UserError{
+				E:    fmt.Errorf("command '%s' didn't set the output '%s'", command.Name, o.Name),
+				Hint: fmt.Sprintf("Write 'echo %s=<value> >> $%s' in the command to set its value", o.Name, constants.OktetoEnvFile),
+			}
+		}
+		outputs = append(outputs, pipeline.Output{
+			Name:      o.Name,
+			Value:     value,
+			Command:   command.Name,
+			Sensitive: o.Sensitive,
+		})
+	}
+	pipeline.MaskSensitiveOutputs(outputs)
+	return outputs, nil
+}
+
+// addCommandsPhaseDuration stores in the ConfigMap of the dev environment the time spent running the deploy commands
+func (r *DeployRunner) addCommandsPhaseDuration(ctx context.Context, params DeployParameters, elapsedTime time.Duration) {
+	if params.DryRun {
+		return
+	}
+	if err := r.ConfigMapHandler.AddPhaseDuration(ctx, params.Name, params.Namespace, deployCommandsPhaseName, elapsedTime); err != nil {
+		oktetoLog.Infof("error adding phase to configmap: %s", err)
+	}
+}
+
 // deployExternals deploys the external resources defined in the deployable entity
 func (r *DeployRunner) deployExternals(ctx context.Context, params DeployParameters, dynamicEnvs map[string]string) error {
 	_, cfg, err := r.K8sClientProvider.ProvideWithLogger(kconfig.Get([]string{r.TempKubeconfigFile}), r.k8sLogger)
@@ -374,6 +451,7 @@ func (r *DeployRunner) deployExternals(ctx context.Context, params DeployParamet
 		oktetoLog.Spinner(fmt.Sprintf("Deploying external resource '%s'...", externalName))
 		oktetoLog.StartSpinner()
 		defer oktetoLog.StopSpinner()
+		r.inventory.SetCommand(fmt.Sprintf("external/%s", externalName))
 		if err := externalInfo.SetURLUsingEnvironFile(externalName, dynamicEnvs); err != nil {
 			return err
 		}
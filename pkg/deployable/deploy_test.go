@@ -15,13 +15,17 @@ package deployable
 
 import (
 	"context"
+	"encoding/base64"
+	"os"
 	"testing"
 	"time"
 
 	"github.com/okteto/okteto/internal/test"
+	"github.com/okteto/okteto/pkg/cmd/pipeline"
 	"github.com/okteto/okteto/pkg/constants"
 	"github.com/okteto/okteto/pkg/divert"
 	"github.com/okteto/okteto/pkg/externalresource"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	"github.com/okteto/okteto/pkg/log/io"
 	"github.com/okteto/okteto/pkg/model"
 	"github.com/okteto/okteto/pkg/okteto"
@@ -29,12 +33,18 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
 )
 
 type fakeCmapHandler struct {
 	errUpdatingWithEnvs error
 	errAddingPhase      error
+	inventory           []inventory.Resource
+	outputs             []pipeline.Output
 }
 
 func (f *fakeCmapHandler) UpdateEnvsFromCommands(context.Context, string, string, []string) error {
@@ -45,6 +55,34 @@ func (f *fakeCmapHandler) AddPhaseDuration(context.Context, string, string, stri
 	return f.errAddingPhase
 }
 
+func (f *fakeCmapHandler) GetInventory(context.Context, string, string) ([]inventory.Resource, error) {
+	return f.inventory, nil
+}
+
+func (f *fakeCmapHandler) UpdateInventory(_ context.Context, _, _ string, resources []inventory.Resource) error {
+	f.inventory = resources
+	return nil
+}
+
+func (f *fakeCmapHandler) UpdateOutputs(_ context.Context, _, _ string, outputs []pipeline.Output) error {
+	f.outputs = outputs
+	return nil
+}
+
+// k8sCmapHandler stores the variables and the outputs in the ConfigMap of the dev environment
+type k8sCmapHandler struct {
+	fakeCmapHandler
+	client kubernetes.Interface
+}
+
+func (h *k8sCmapHandler) UpdateEnvsFromCommands(ctx context.Context, name, namespace string, envs []string) error {
+	return pipeline.UpdateEnvs(ctx, name, namespace, envs, h.client)
+}
+
+func (h *k8sCmapHandler) UpdateOutputs(ctx context.Context, name, namespace string, outputs []pipeline.Output) error {
+	return pipeline.UpdateOutputs(ctx, name, namespace, outputs, h.client)
+}
+
 type fakeKubeconfigHandler struct {
 	mock.Mock
 }
@@ -89,7 +127,9 @@ func (f *fakeProxy) SetName(name string) {
 func (f *fakeProxy) SetDivert(driver divert.Driver) {
 	f.Called(driver)
 }
-func (f *fakeProxy) InitTranslator() {}
+func (f *fakeProxy) SetInventory(*inventory.Inventory) {}
+func (f *fakeProxy) SetDryRun(*DryRun)                 {}
+func (f *fakeProxy) InitTranslator()                   {}
 
 type fakeExecutor struct {
 	mock.Mock
@@ -305,6 +345,157 @@ func TestRunCommandsSectionWithCommands(t *testing.T) {
 	executor.AssertExpectations(t)
 }
 
+func TestRunCommandsSectionWithOutputs(t *testing.T) {
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: "test",
+			},
+		},
+		CurrentContext: "test",
+	}
+	executor := &fakeExecutor{}
+	cmapHandler := &fakeCmapHandler{}
+	fs := afero.NewOsFs()
+	r := DeployRunner{
+		TempKubeconfigFile: "temp-kubeconfig",
+		Fs:                 fs,
+		ConfigMapHandler:   cmapHandler,
+		Executor:           executor,
+	}
+
+	createDB := model.DeployCommand{
+		Name:    "create db",
+		Command: "./create-db.sh",
+		Outputs: []model.DeployOutput{
+			{Name: "DATABASE_HOST"},
+			{Name: "DATABASE_PASSWORD", Sensitive: true},
+		},
+	}
+	params := DeployParameters{
+		Name:      "movies",
+		Namespace: "test",
+		Deployable: Entity{
+			Commands: []model.DeployCommand{createDB},
+		},
+	}
+	executor.On("Execute", createDB, mock.Anything).Run(func(_ mock.Arguments) {
+		content := "DATABASE_HOST=postgres\nDATABASE_PASSWORD=s3cr3t-value\nOTHER=1\n"
+		require.NoError(t, afero.WriteFile(fs, os.Getenv(constants.OktetoEnvFile), []byte(content), 0600))
+	}).Return(nil).Once()
+
+	require.NoError(t, r.runCommandsSection(context.Background(), params))
+	expected := []pipeline.Output{
+		{Name: "DATABASE_HOST", Value: "postgres", Command: "create db"},
+		{Name: "DATABASE_PASSWORD", Value: "s3cr3t-value", Command: "create db", Sensitive: true},
+	}
+	assert.Equal(t, expected, cmapHandler.outputs)
+}
+
+func TestRunCommandsSectionDoesNotStoreSensitiveOutputsInConfigMap(t *testing.T) {
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: "test",
+			},
+		},
+		CurrentContext: "test",
+	}
+	ctx := context.Background()
+	cmap := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: pipeline.TranslatePipelineName("movies"), Namespace: "test"},
+		Data:       map[string]string{},
+	}
+	client := k8sfake.NewClientset(cmap)
+	executor := &fakeExecutor{}
+	fs := afero.NewOsFs()
+	r := DeployRunner{
+		TempKubeconfigFile: "temp-kubeconfig",
+		Fs:                 fs,
+		ConfigMapHandler:   &k8sCmapHandler{client: client},
+		Executor:           executor,
+	}
+
+	createDB := model.DeployCommand{
+		Name:    "create db",
+		Command: "./create-db.sh",
+		Outputs: []model.DeployOutput{
+			{Name: "DATABASE_HOST"},
+			{Name: "DATABASE_PASSWORD", Sensitive: true},
+		},
+	}
+	params := DeployParameters{
+		Name:      "movies",
+		Namespace: "test",
+		Deployable: Entity{
+			Commands: []model.DeployCommand{createDB},
+		},
+	}
+	executor.On("Execute", createDB, mock.Anything).Run(func(_ mock.Arguments) {
+		content := "DATABASE_HOST=postgres\nDATABASE_PASSWORD=s3cr3t-value\n"
+		require.NoError(t, afero.WriteFile(fs, os.Getenv(constants.OktetoEnvFile), []byte(content), 0600))
+	}).Return(nil).Once()
+
+	require.NoError(t, r.runCommandsSection(ctx, params))
+
+	stored, err := client.CoreV1().ConfigMaps("test").Get(ctx, cmap.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	for key, value := range stored.Data {
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		require.NoError(t, err)
+		assert.NotContains(t, string(decoded), "s3cr3t-value", "ConfigMap field '%s' contains a sensitive value", key)
+	}
+	assert.Contains(t, stored.Data, constants.OktetoDependencyEnvsKey)
+
+	outputs, err := pipeline.GetOutputs(ctx, "movies", "test", client)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t-value", outputs[1].Value)
+}
+
+func TestRunCommandsSectionWithMissingOutput(t *testing.T) {
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: "test",
+			},
+		},
+		CurrentContext: "test",
+	}
+	executor := &fakeExecutor{}
+	cmapHandler := &fakeCmapHandler{}
+	fs := afero.NewOsFs()
+	r := DeployRunner{
+		TempKubeconfigFile: "temp-kubeconfig",
+		Fs:                 fs,
+		ConfigMapHandler:   cmapHandler,
+		Executor:           executor,
+	}
+
+	// a variable written by a previous command isn't an output of the next one
+	setHost := model.DeployCommand{
+		Name:    "set host",
+		Command: "echo DATABASE_HOST=postgres >> $OKTETO_ENV",
+	}
+	createDB := model.DeployCommand{
+		Name:    "create db",
+		Command: "./create-db.sh",
+		Outputs: []model.DeployOutput{{Name: "DATABASE_HOST"}},
+	}
+	params := DeployParameters{
+		Deployable: Entity{
+			Commands: []model.DeployCommand{setHost, createDB},
+		},
+	}
+	executor.On("Execute", setHost, mock.Anything).Run(func(_ mock.Arguments) {
+		require.NoError(t, afero.WriteFile(fs, os.Getenv(constants.OktetoEnvFile), []byte("DATABASE_HOST=postgres\n"), 0600))
+	}).Return(nil).Once()
+	executor.On("Execute", createDB, mock.Anything).Return(nil).Once()
+
+	err := r.runCommandsSection(context.Background(), params)
+	assert.ErrorContains(t, err, "command 'create db' didn't set the output 'DATABASE_HOST'")
+	assert.Nil(t, cmapHandler.outputs)
+}
+
 func TestRunCommandsSectionWithErrorInCommands(t *testing.T) {
 	okteto.CurrentStore = &okteto.ContextStore{
 		Contexts: map[string]*okteto.Context{
@@ -0,0 +1,360 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployable
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/okteto/okteto/pkg/k8s/inventory"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	dryRunParam          = "dryRun"
+	dryRunAll            = "All"
+	lastAppliedConfigKey = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// dryRunUnsupportedSubresources are the pod subresources that run commands or open streams in the containers.
+// The cluster can't run them in dry run mode
+var dryRunUnsupportedSubresources = []string{"exec", "attach", "portforward"}
+
+// DryRun records the changes that a deploy would make to the resources of the cluster. When it is set in the
+// proxy, the requests that modify resources are sent to the cluster with dryRun=All, so the cluster validates
+// them and returns the resulting objects without persisting anything
+type DryRun struct {
+	changes map[string]*Change
+	lock    sync.Mutex
+}
+
+// Change is the change that a deploy would make to a resource
+type Change struct {
+	// Live is the object in the cluster, nil when the deploy would create it
+	Live map[string]interface{}
+	// Desired is the object after the deploy, nil when the deploy would delete it
+	Desired  map[string]interface{}
+	Resource inventory.Resource
+}
+
+// NewDryRun returns an empty DryRun
+func NewDryRun() *DryRun {
+	return &DryRun{
+		changes: map[string]*Change{},
+	}
+}
+
+// record adds the change made to a resource. The live object of a resource changed several times during the
+// deploy is the one before its first change, as none of the changes are persisted
+func (d *DryRun) record(r inventory.Resource, live, desired map[string]interface{}) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	k := fmt.Sprintf("%s/%s", r.Namespace, r)
+	if current, ok := d.changes[k]; ok {
+		current.Desired = desired
+		return
+	}
+	d.changes[k] = &Change{Resource: r, Live: live, Desired: desired}
+}
+
+// Changes returns the resources that the deploy would create, modify or delete, sorted by namespace and name
+func (d *DryRun) Changes() []Change {
+	if d == nil {
+		return nil
+	}
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	result := make([]Change, 0, len(d.changes))
+	for _, c := range d.changes {
+		if c.Live == nil && c.Desired == nil {
+			continue
+		}
+		if reflect.DeepEqual(c.Live, c.Desired) {
+			continue
+		}
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(a, b int) bool {
+		if result[a].Resource.Namespace != result[b].Resource.Namespace {
+			return result[a].Resource.Namespace < result[b].Resource.Namespace
+		}
+		return result[a].Resource.String() < result[b].Resource.String()
+	})
+	return result
+}
+
+// Diff returns the unified diff between the live and the desired objects in YAML
+func (c Change) Diff() (string, error) {
+	live, err := toYAML(c.Live)
+	if err != nil {
+		return "", err
+	}
+	desired, err := toYAML(c.Desired)
+	if err != nil {
+		return "", err
+	}
+
+	name := c.Resource.String()
+	if c.Resource.Namespace != "" {
+		name = fmt.Sprintf("%s/%s", c.Resource.Namespace, name)
+	}
+	from, to := fmt.Sprintf("live/%s", name), fmt.Sprintf("deploy/%s", name)
+	if c.Live == nil {
+		from = "/dev/null"
+	}
+	if c.Desired == nil {
+		to = "/dev/null"
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(live),
+		B:        splitLines(desired),
+		FromFile: from,
+		ToFile:   to,
+		Context:  3,
+	})
+}
+
+// splitLines splits s in lines keeping their line endings
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func toYAML(obj map[string]interface{}) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	b, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// isMutatingRequest returns true if the request can modify a resource of the cluster
+func isMutatingRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return strings.HasPrefix(r.URL.Path, "/api/") || strings.HasPrefix(r.URL.Path, "/apis/")
+	default:
+		return false
+	}
+}
+
+// isUnsupportedInDryRun returns true if the request upgrades the connection (SPDY or websockets) or runs a command
+// in a container, because the cluster would run it for real
+func isUnsupportedInDryRun(r *http.Request) bool {
+	if r.Header.Get(headerUpgrade) != "" {
+		return true
+	}
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 3 || parts[len(parts)-3] != "pods" {
+		return false
+	}
+	return slices.Contains(dryRunUnsupportedSubresources, parts[len(parts)-1])
+}
+
+// rejectDryRunRequest answers the request with a Status error, so kubectl and the client libraries show the reason
+func rejectDryRunRequest(rw http.ResponseWriter, r *http.Request) {
+	status := metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  fmt.Sprintf("%s %s is not supported in dry run mode: exec, attach, port-forward and streaming requests would run in the cluster", r.Method, r.URL.Path),
+		Reason:   metav1.StatusReasonMethodNotAllowed,
+		Code:     http.StatusMethodNotAllowed,
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusMethodNotAllowed)
+	if err := json.NewEncoder(rw).Encode(status); err != nil {
+		oktetoLog.Infof("could not write the dry run error: %s", err)
+	}
+}
+
+// setDryRun sends the request to the cluster in dry run mode
+func setDryRun(r *http.Request) {
+	query := r.URL.Query()
+	if query.Has(dryRunParam) {
+		return
+	}
+	query.Set(dryRunParam, dryRunAll)
+	r.URL.RawQuery = query.Encode()
+}
+
+// recordDryRun adds to the dry run the object that the cluster returns for a request sent in dry run mode
+// and the live object it would replace
+func (ph *proxyHandler) recordDryRun(resp *http.Response) error {
+	if resp.Request == nil {
+		return nil
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil
+	}
+
+	req := resp.Request
+	if !isMutatingRequest(req) || !req.URL.Query().Has(dryRunParam) {
+		return nil
+	}
+	r, ok := inventory.ParsePath(req.URL.Path)
+	if !ok {
+		return nil
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read the response body: %w", err)
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+
+	var desired map[string]interface{}
+	if req.Method != http.MethodDelete {
+		if _, err := readResource(&r, b, resp.Header); err != nil {
+			oktetoLog.Infof("could not preview '%s %s': %s", req.Method, req.URL.Path, err)
+			return nil
+		}
+		if desired, err = readObject(b, resp.Header); err != nil {
+			oktetoLog.Infof("could not preview '%s %s': %s", req.Method, req.URL.Path, err)
+			return nil
+		}
+	}
+	if r.Name == "" {
+		return nil
+	}
+
+	var live map[string]interface{}
+	created := req.Method == http.MethodPost || resp.StatusCode == http.StatusCreated
+	if !created {
+		live, err = ph.getLiveObject(req)
+		if err != nil {
+			oktetoLog.Infof("could not get the live object of '%s': %s", r, err)
+			return nil
+		}
+	}
+	// the response of a delete request doesn't always include the object
+	if desired == nil && live != nil {
+		u := unstructured.Unstructured{Object: live}
+		r.Kind = u.GetKind()
+		r.UID = string(u.GetUID())
+	}
+
+	ph.dryRun.record(r, normalizeObject(live), normalizeObject(desired))
+	return nil
+}
+
+// getLiveObject returns the object stored in the cluster at the path of a request, or nil if it doesn't exist
+func (ph *proxyHandler) getLiveObject(req *http.Request) (map[string]interface{}, error) {
+	u := *req.URL
+	u.RawQuery = ""
+	get, err := http.NewRequestWithContext(req.Context(), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		get.Header.Set("Authorization", auth)
+	}
+	get.Header.Set("Accept", "application/json")
+
+	resp, err := ph.transport.RoundTrip(get)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return readObject(b, resp.Header)
+}
+
+// readObject returns the object in the body of a response as a map
+func readObject(body []byte, header http.Header) (map[string]interface{}, error) {
+	body, err := decompressBody(body, header)
+	if err != nil {
+		return nil, err
+	}
+	contentType, _, _ := strings.Cut(header.Get("Content-Type"), ";")
+	obj, err := decodeObject(body, contentType)
+	if err != nil {
+		return nil, err
+	}
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.Object, nil
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+}
+
+// normalizeObject removes the fields managed by the cluster, which change on every request
+// and would hide the changes made by the deploy
+func normalizeObject(obj map[string]interface{}) map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+
+	for _, field := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(obj, "metadata", field)
+	}
+	unstructured.RemoveNestedField(obj, "metadata", "annotations", lastAppliedConfigKey)
+	if annotations, ok, _ := unstructured.NestedMap(obj, "metadata", "annotations"); ok && len(annotations) == 0 {
+		unstructured.RemoveNestedField(obj, "metadata", "annotations")
+	}
+	unstructured.RemoveNestedField(obj, "status")
+	return obj
+}
+
+// printDryRun shows the changes that the deploy would make to the resources of the cluster
+func (r *DeployRunner) printDryRun(params DeployParameters, deployErr error) {
+	changes := r.dryRun.Changes()
+	if len(changes) == 0 {
+		if deployErr == nil {
+			oktetoLog.Information("Deploying '%s' doesn't change any resource", params.Name)
+		}
+		return
+	}
+
+	oktetoLog.Information("Changes of '%s':", params.Name)
+	for _, c := range changes {
+		diff, err := c.Diff()
+		if err != nil {
+			oktetoLog.Infof("could not compute the diff of '%s': %s", c.Resource, err)
+			continue
+		}
+		oktetoLog.Println(diff)
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployable
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/k8s/inventory"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// liveTransport returns the objects of the cluster indexed by path
+func liveTransport(objects map[string]string) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, ok := objects[req.URL.Path]
+		status := http.StatusOK
+		if !ok {
+			body = `{"kind":"Status","code":404}`
+			status = http.StatusNotFound
+		}
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			Request:    req,
+		}, nil
+	})
+}
+
+func TestSetDryRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		expected string
+		mutating bool
+	}{
+		{
+			name:     "create",
+			method:   http.MethodPost,
+			path:     "/api/v1/namespaces/test/configmaps?fieldManager=kubectl",
+			mutating: true,
+			expected: "dryRun=All&fieldManager=kubectl",
+		},
+		{
+			name:     "delete",
+			method:   http.MethodDelete,
+			path:     "/apis/apps/v1/namespaces/test/deployments/api",
+			mutating: true,
+			expected: "dryRun=All",
+		},
+		{
+			name:     "already in dry run",
+			method:   http.MethodPatch,
+			path:     "/apis/apps/v1/namespaces/test/deployments/api?dryRun=All",
+			mutating: true,
+			expected: "dryRun=All",
+		},
+		{
+			name:   "read",
+			method: http.MethodGet,
+			path:   "/apis/apps/v1/namespaces/test/deployments/api",
+		},
+		{
+			name:   "not an API request",
+			method: http.MethodPost,
+			path:   "/version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.path)
+			require.NoError(t, err)
+			req := &http.Request{Method: tt.method, URL: u}
+			require.Equal(t, tt.mutating, isMutatingRequest(req))
+			if tt.mutating {
+				setDryRun(req)
+				assert.Equal(t, tt.expected, req.URL.RawQuery)
+			}
+		})
+	}
+}
+
+func TestIsUnsupportedInDryRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		upgrade  string
+		expected bool
+	}{
+		{
+			name:     "exec",
+			path:     "/api/v1/namespaces/test/pods/api-0/exec",
+			expected: true,
+		},
+		{
+			name:     "attach",
+			path:     "/api/v1/namespaces/test/pods/api-0/attach",
+			expected: true,
+		},
+		{
+			name:     "port forward",
+			path:     "/api/v1/namespaces/test/pods/api-0/portforward",
+			expected: true,
+		},
+		{
+			name:     "websocket",
+			path:     "/api/v1/namespaces/test/pods/api-0/log",
+			upgrade:  "websocket",
+			expected: true,
+		},
+		{
+			name:     "spdy",
+			path:     "/api/v1/namespaces/test/pods/api-0/log",
+			upgrade:  "SPDY/3.1",
+			expected: true,
+		},
+		{
+			name: "pod named exec",
+			path: "/api/v1/namespaces/test/pods/exec",
+		},
+		{
+			name: "logs",
+			path: "/api/v1/namespaces/test/pods/api-0/log",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			if tt.upgrade != "" {
+				req.Header.Set(headerUpgrade, tt.upgrade)
+			}
+			assert.Equal(t, tt.expected, isUnsupportedInDryRun(req))
+		})
+	}
+}
+
+func TestRejectDryRunRequest(t *testing.T) {
+	rw := httptest.NewRecorder()
+	rejectDryRunRequest(rw, httptest.NewRequest(http.MethodPost, "/api/v1/namespaces/test/pods/api-0/exec", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rw.Code)
+	assert.Contains(t, rw.Body.String(), `"kind":"Status"`)
+	assert.Contains(t, rw.Body.String(), "is not supported in dry run mode")
+}
+
+func TestRecordDryRun(t *testing.T) {
+	live := `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"settings","namespace":"test","uid":"1","resourceVersion":"10","managedFields":[{"manager":"kubectl"}]},"data":{"key":"a"}}`
+	configMap := inventory.Resource{Version: "v1", Kind: "ConfigMap", Resource: "configmaps", Namespace: "test", Name: "settings", UID: "1"}
+
+	tests := []struct {
+		name     string
+		response *http.Response
+		expected []Change
+	}{
+		{
+			name:     "create",
+			response: newResponse(t, http.MethodPost, "/api/v1/namespaces/test/configmaps?dryRun=All", http.StatusCreated, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"new","namespace":"test","uid":"2","resourceVersion":"11"},"data":{"key":"b"}}`),
+			expected: []Change{
+				{
+					Resource: inventory.Resource{Version: "v1", Kind: "ConfigMap", Resource: "configmaps", Namespace: "test", Name: "new", UID: "2", ResourceVersion: "11"},
+					Desired: map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "new", "namespace": "test"},
+						"data":       map[string]interface{}{"key": "b"},
+					},
+				},
+			},
+		},
+		{
+			name:     "update",
+			response: newResponse(t, http.MethodPatch, "/api/v1/namespaces/test/configmaps/settings?dryRun=All", http.StatusOK, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"settings","namespace":"test","uid":"1","resourceVersion":"12","annotations":{"kubectl.kubernetes.io/last-applied-configuration":"{}"}},"data":{"key":"b"}}`),
+			expected: []Change{
+				{
+					Resource: withRV(configMap, "12", "", ""),
+					Live: map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "settings", "namespace": "test"},
+						"data":       map[string]interface{}{"key": "a"},
+					},
+					Desired: map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "settings", "namespace": "test"},
+						"data":       map[string]interface{}{"key": "b"},
+					},
+				},
+			},
+		},
+		{
+			name:     "apply without changes",
+			response: newResponse(t, http.MethodPut, "/api/v1/namespaces/test/configmaps/settings?dryRun=All", http.StatusOK, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"settings","namespace":"test","uid":"1","resourceVersion":"12"},"data":{"key":"a"}}`),
+			expected: []Change{},
+		},
+		{
+			name:     "delete",
+			response: newResponse(t, http.MethodDelete, "/api/v1/namespaces/test/configmaps/settings?dryRun=All", http.StatusOK, `{"kind":"Status","status":"Success"}`),
+			expected: []Change{
+				{
+					Resource: configMap,
+					Live: map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "settings", "namespace": "test"},
+						"data":       map[string]interface{}{"key": "a"},
+					},
+				},
+			},
+		},
+		{
+			name:     "failed request",
+			response: newResponse(t, http.MethodPost, "/api/v1/namespaces/test/configmaps?dryRun=All", http.StatusUnprocessableEntity, `{"kind":"Status"}`),
+			expected: []Change{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ph := &proxyHandler{
+				dryRun:    NewDryRun(),
+				transport: liveTransport(map[string]string{"/api/v1/namespaces/test/configmaps/settings": live}),
+			}
+			body, err := io.ReadAll(tt.response.Body)
+			require.NoError(t, err)
+			tt.response.Body = io.NopCloser(bytes.NewReader(body))
+
+			require.NoError(t, ph.recordResponse(tt.response))
+			assert.Equal(t, tt.expected, ph.dryRun.Changes())
+
+			// the response is forwarded to the client untouched
+			forwarded, err := io.ReadAll(tt.response.Body)
+			require.NoError(t, err)
+			assert.Equal(t, body, forwarded)
+		})
+	}
+}
+
+func TestChangeDiff(t *testing.T) {
+	c := Change{
+		Resource: inventory.Resource{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "test", Name: "api"},
+		Live: map[string]interface{}{
+			"kind":     "Deployment",
+			"metadata": map[string]interface{}{"name": "api"},
+			"spec":     map[string]interface{}{"replicas": int64(1)},
+		},
+		Desired: map[string]interface{}{
+			"kind":     "Deployment",
+			"metadata": map[string]interface{}{"name": "api"},
+			"spec":     map[string]interface{}{"replicas": int64(2)},
+		},
+	}
+	diff, err := c.Diff()
+	require.NoError(t, err)
+	expected := `--- live/test/deployment.apps/api
++++ deploy/test/deployment.apps/api
+@@ -2,4 +2,4 @@
+ metadata:
+   name: api
+ spec:
+-  replicas: 1
++  replicas: 2
+`
+	assert.Equal(t, expected, diff)
+
+	c.Live = nil
+	diff, err = c.Diff()
+	require.NoError(t, err)
+	assert.Contains(t, diff, "--- /dev/null\n+++ deploy/test/deployment.apps/api\n")
+}
+
+func TestRunCommandsSectionDryRun(t *testing.T) {
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: "test",
+			},
+		},
+		CurrentContext: "test",
+	}
+	executor := &fakeExecutor{}
+	r := DeployRunner{
+		TempKubeconfigFile: "temp-kubeconfig",
+		Fs:                 afero.NewMemMapFs(),
+		// the ConfigMap of the dev environment isn't updated in dry run mode
+		ConfigMapHandler: &fakeCmapHandler{
+			errUpdatingWithEnvs: assert.AnError,
+			errAddingPhase:      assert.AnError,
+		},
+		Executor: executor,
+	}
+
+	command := model.DeployCommand{
+		Name:    "test command",
+		Command: "echo",
+	}
+	params := DeployParameters{
+		Deployable: Entity{
+			Commands: []model.DeployCommand{command},
+		},
+		DryRun: true,
+	}
+	executor.On("Execute", command, []string(nil)).Return(nil).Once()
+
+	require.NoError(t, r.runCommandsSection(context.Background(), params))
+	executor.AssertExpectations(t)
+}
@@ -27,7 +27,9 @@ import (
 type envStepper struct {
 	fs       afero.Fs
 	env      map[string]string
+	stepEnv  map[string]string
 	filename string
+	offset   int
 	sync.RWMutex
 }
 
@@ -35,6 +37,10 @@ func (es *envStepper) WithFS(fs afero.Fs) {
 	es.fs = fs
 }
 func (es *envStepper) Step() ([]string, error) {
+	es.Lock()
+	es.stepEnv = map[string]string{}
+	es.Unlock()
+
 	data, err := afero.ReadFile(es.fs, es.filename)
 	if err != nil {
 		return nil, err
@@ -45,10 +51,22 @@ func (es *envStepper) Step() ([]string, error) {
 		return nil, err
 	}
 
+	// the lines written since the previous step. If the file was overwritten, all its lines are new
+	offset := es.offset
+	if offset > len(data) {
+		offset = 0
+	}
+	step, err := godotenv.Unmarshal(string(data[offset:]))
+	if err != nil {
+		return nil, err
+	}
+
 	list := mapToEnvList(current)
 
 	es.Lock()
 	es.env = current
+	es.stepEnv = step
+	es.offset = len(data)
 	es.Unlock()
 
 	return list, nil
@@ -61,11 +79,20 @@ func (es *envStepper) Map() (env map[string]string) {
 	return
 }
 
+// StepMap returns the variables written to the env file since the previous step
+func (es *envStepper) StepMap() (env map[string]string) {
+	es.RLock()
+	env = es.stepEnv
+	es.RUnlock()
+	return
+}
+
 func NewEnvStepper(filename string) *envStepper {
 	return &envStepper{
 		RWMutex:  sync.RWMutex{},
 		filename: filename,
 		env:      make(map[string]string),
+		stepEnv:  make(map[string]string),
 		fs:       afero.NewOsFs(),
 	}
 }
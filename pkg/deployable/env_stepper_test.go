@@ -55,4 +55,9 @@ func TestEnvStepper(t *testing.T) {
 		require.Equal(t, fmt.Sprintf("%v", i), val)
 	}
 
+	require.Equal(t, map[string]string{"ENV09": "9"}, stepper.StepMap())
+
+	_, err = stepper.Step()
+	require.NoError(t, err)
+	require.Empty(t, stepper.StepMap())
 }
@@ -0,0 +1,153 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployable
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/okteto/okteto/pkg/k8s/inventory"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// recordResponse adds to the inventory the object returned by the cluster when a request creates,
+// updates, deletes or reads a resource. Reads are only recorded for the objects labeled as deployed by
+// the dev environment. Dry run requests, watches and subresources are ignored. When the proxy runs in
+// dry run mode, the response is added to the dry run instead
+func (ph *proxyHandler) recordResponse(resp *http.Response) error {
+	if ph.dryRun != nil {
+		return ph.recordDryRun(resp)
+	}
+	if ph.inventory == nil || resp.Request == nil {
+		return nil
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil
+	}
+
+	req := resp.Request
+	query := req.URL.Query()
+	if query.Has("dryRun") || query.Has("watch") {
+		return nil
+	}
+	r, ok := inventory.ParsePath(req.URL.Path)
+	if !ok {
+		return nil
+	}
+
+	switch req.Method {
+	case http.MethodDelete:
+		if r.Name != "" {
+			ph.inventory.Remove(r)
+		}
+		return nil
+	case http.MethodGet:
+		if r.Name == "" {
+			return nil
+		}
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return nil
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read the response body: %w", err)
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+
+	objLabels, err := readResource(&r, b, resp.Header)
+	if err != nil {
+		oktetoLog.Infof("could not add '%s %s' to the inventory: %s", req.Method, req.URL.Path, err)
+		return nil
+	}
+
+	if req.Method == http.MethodGet {
+		if ph.Name != "" && objLabels[model.DeployedByLabel] == ph.Name {
+			ph.inventory.Observe(r)
+		}
+		return nil
+	}
+
+	// POST only creates objects, while PUT and PATCH create them when they don't exist
+	ph.inventory.Record(r, req.Method == http.MethodPost || resp.StatusCode == http.StatusCreated)
+	return nil
+}
+
+// readResource sets the kind and the metadata of r from the object in the body of a response, and returns its labels
+func readResource(r *inventory.Resource, body []byte, header http.Header) (map[string]string, error) {
+	body, err := decompressBody(body, header)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType, _, _ := strings.Cut(header.Get("Content-Type"), ";")
+	obj, err := decodeObject(body, contentType)
+	if err != nil {
+		return nil, err
+	}
+	m, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Kind = obj.GetObjectKind().GroupVersionKind().Kind
+	r.Name = m.GetName()
+	if ns := m.GetNamespace(); ns != "" {
+		r.Namespace = ns
+	}
+	r.UID = string(m.GetUID())
+	r.ResourceVersion = m.GetResourceVersion()
+	r.Generation = m.GetGeneration()
+	return m.GetLabels(), nil
+}
+
+// decompressBody returns the body of a response compressed by the cluster
+func decompressBody(body []byte, header http.Header) ([]byte, error) {
+	if !strings.EqualFold(header.Get("Content-Encoding"), "gzip") {
+		return body, nil
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
+
+// recordInventory stores the resources recorded by the proxy during the deploy and reports the
+// changes made to each of them
+func (r *DeployRunner) recordInventory(ctx context.Context, params DeployParameters, deployErr error) {
+	resources := r.inventory.Resources()
+	if err := r.ConfigMapHandler.UpdateInventory(ctx, params.Name, params.Namespace, resources); err != nil {
+		oktetoLog.Infof("could not store the inventory of '%s': %s", params.Name, err)
+	}
+
+	if deployErr != nil || len(resources) == 0 {
+		return
+	}
+	oktetoLog.Information("Resources of '%s':", params.Name)
+	for _, res := range resources {
+		oktetoLog.Println(fmt.Sprintf("    %s %s", res, res.Operation))
+	}
+}
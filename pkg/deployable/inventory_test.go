@@ -0,0 +1,166 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployable
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/k8s/inventory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newResponse(t *testing.T, method, path string, status int, body string) *http.Response {
+	t.Helper()
+	u, err := url.Parse(path)
+	require.NoError(t, err)
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+		Request:    &http.Request{Method: method, URL: u},
+	}
+}
+
+func TestRecordResponse(t *testing.T) {
+	deployment := inventory.Resource{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "test", Name: "api", UID: "1"}
+	service := inventory.Resource{Version: "v1", Kind: "Service", Resource: "services", Namespace: "test", Name: "api", UID: "2", ResourceVersion: "5", Command: "helm"}
+
+	tests := []struct {
+		name     string
+		response *http.Response
+		expected []inventory.Resource
+	}{
+		{
+			name:     "create",
+			response: newResponse(t, http.MethodPost, "/apis/apps/v1/namespaces/test/deployments", http.StatusCreated, `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api","namespace":"test","uid":"1","resourceVersion":"10"}}`),
+			expected: []inventory.Resource{withRV(deployment, "10", "kubectl", inventory.Created), withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+		{
+			name:     "apply creating the object",
+			response: newResponse(t, http.MethodPatch, "/apis/apps/v1/namespaces/test/deployments/api", http.StatusCreated, `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api","namespace":"test","uid":"1","resourceVersion":"10"}}`),
+			expected: []inventory.Resource{withRV(deployment, "10", "kubectl", inventory.Created), withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+		{
+			name:     "apply updating the object",
+			response: newResponse(t, http.MethodPatch, "/api/v1/namespaces/test/services/api", http.StatusOK, `{"apiVersion":"v1","kind":"Service","metadata":{"name":"api","namespace":"test","uid":"2","resourceVersion":"6"}}`),
+			expected: []inventory.Resource{withRV(service, "6", "helm", inventory.Updated)},
+		},
+		{
+			name:     "apply without changes",
+			response: newResponse(t, http.MethodPut, "/api/v1/namespaces/test/services/api", http.StatusOK, `{"apiVersion":"v1","kind":"Service","metadata":{"name":"api","namespace":"test","uid":"2","resourceVersion":"5"}}`),
+			expected: []inventory.Resource{withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+		{
+			name:     "delete",
+			response: newResponse(t, http.MethodDelete, "/api/v1/namespaces/test/services/api", http.StatusOK, `{"kind":"Status"}`),
+			expected: []inventory.Resource{},
+		},
+		{
+			name:     "dry run",
+			response: newResponse(t, http.MethodPost, "/apis/apps/v1/namespaces/test/deployments?dryRun=All", http.StatusCreated, `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api","namespace":"test","uid":"1","resourceVersion":"10"}}`),
+			expected: []inventory.Resource{withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+		{
+			name:     "failed request",
+			response: newResponse(t, http.MethodPost, "/apis/apps/v1/namespaces/test/deployments", http.StatusConflict, `{"kind":"Status"}`),
+			expected: []inventory.Resource{withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+		{
+			name:     "subresource",
+			response: newResponse(t, http.MethodPut, "/apis/apps/v1/namespaces/test/deployments/api/scale", http.StatusOK, `{"apiVersion":"autoscaling/v1","kind":"Scale","metadata":{"name":"api","namespace":"test","uid":"1","resourceVersion":"10"}}`),
+			expected: []inventory.Resource{withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+		{
+			name:     "read an object of the deploy",
+			response: newResponse(t, http.MethodGet, "/apis/apps/v1/namespaces/test/deployments/api", http.StatusOK, `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api","namespace":"test","uid":"1","resourceVersion":"10","labels":{"dev.okteto.com/deployed-by":"app"}}}`),
+			expected: []inventory.Resource{withRV(deployment, "10", "kubectl", inventory.Unchanged), withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+		{
+			name:     "read an object of another deploy",
+			response: newResponse(t, http.MethodGet, "/apis/apps/v1/namespaces/test/deployments/api", http.StatusOK, `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"api","namespace":"test","uid":"1","resourceVersion":"10","labels":{"dev.okteto.com/deployed-by":"other"}}}`),
+			expected: []inventory.Resource{withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+		{
+			name:     "list",
+			response: newResponse(t, http.MethodGet, "/apis/apps/v1/namespaces/test/deployments", http.StatusOK, `{"apiVersion":"apps/v1","kind":"DeploymentList","items":[]}`),
+			expected: []inventory.Resource{withRV(service, "5", "helm", inventory.Unchanged)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ph := &proxyHandler{Name: "app", inventory: inventory.New([]inventory.Resource{service})}
+			ph.inventory.SetCommand("kubectl")
+			body, err := io.ReadAll(tt.response.Body)
+			require.NoError(t, err)
+			tt.response.Body = io.NopCloser(bytes.NewReader(body))
+
+			require.NoError(t, ph.recordResponse(tt.response))
+			assert.Equal(t, tt.expected, ph.inventory.Resources())
+
+			// the response is forwarded to the client untouched
+			forwarded, err := io.ReadAll(tt.response.Body)
+			require.NoError(t, err)
+			assert.Equal(t, body, forwarded)
+		})
+	}
+}
+
+func TestRecordResponseGzip(t *testing.T) {
+	var body bytes.Buffer
+	w := gzip.NewWriter(&body)
+	_, err := w.Write([]byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"settings","namespace":"test","uid":"3","resourceVersion":"1"}}`))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	resp := newResponse(t, http.MethodPost, "/api/v1/namespaces/test/configmaps", http.StatusCreated, body.String())
+	resp.Header.Set("Content-Encoding", "gzip")
+	resp.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	ph := &proxyHandler{inventory: inventory.New(nil)}
+	require.NoError(t, ph.recordResponse(resp))
+	expected := []inventory.Resource{
+		{Version: "v1", Kind: "ConfigMap", Resource: "configmaps", Namespace: "test", Name: "settings", UID: "3", ResourceVersion: "1", Operation: inventory.Created},
+	}
+	assert.Equal(t, expected, ph.inventory.Resources())
+}
+
+func TestRecordInventory(t *testing.T) {
+	previous := []inventory.Resource{
+		{Version: "v1", Kind: "Service", Resource: "services", Namespace: "test", Name: "api", UID: "2", ResourceVersion: "5", Command: "helm", Operation: inventory.Created},
+	}
+	cmap := &fakeCmapHandler{inventory: previous}
+	r := &DeployRunner{
+		ConfigMapHandler: cmap,
+		inventory:        inventory.New(previous),
+	}
+
+	r.recordInventory(context.Background(), DeployParameters{Name: "test", Namespace: "test"}, nil)
+	expected := previous[0]
+	expected.Operation = inventory.Unchanged
+	assert.Equal(t, []inventory.Resource{expected}, cmap.inventory)
+}
+
+func withRV(r inventory.Resource, resourceVersion, command string, op inventory.Operation) inventory.Resource {
+	r.ResourceVersion = resourceVersion
+	r.Command = command
+	r.Operation = op
+	return r
+}
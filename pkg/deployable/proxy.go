@@ -31,6 +31,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/okteto/okteto/pkg/divert"
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/okteto"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -62,6 +63,9 @@ type proxyHandler struct {
 	// Name is sanitized version of the pipeline name
 	Name       string
 	translator *Translator
+	inventory  *inventory.Inventory
+	dryRun     *DryRun
+	transport  http.RoundTripper
 }
 
 // NewProxy creates a new proxy
@@ -179,6 +183,16 @@ func (p *Proxy) SetDivert(driver divert.Driver) {
 	p.proxyHandler.SetDivert(driver)
 }
 
+// SetInventory sets the inventory where the resources created or applied through the proxy are recorded
+func (p *Proxy) SetInventory(inv *inventory.Inventory) {
+	p.proxyHandler.SetInventory(inv)
+}
+
+// SetDryRun runs the proxy in dry run mode, recording in d the changes that the requests would make
+func (p *Proxy) SetDryRun(d *DryRun) {
+	p.proxyHandler.SetDryRun(d)
+}
+
 func (p *Proxy) InitTranslator() {
 	p.proxyHandler.translator = newTranslator(p.proxyHandler.Name, p.proxyHandler.DivertDriver)
 }
@@ -257,6 +271,8 @@ func (ph *proxyHandler) getProxyHandler(token string, clusterConfig *rest.Config
 	}
 	proxy := httputil.NewSingleHostReverseProxy(destinationURL)
 	proxy.Transport = trans
+	ph.transport = trans
+	proxy.ModifyResponse = ph.recordResponse
 
 	oktetoLog.Debugf("forwarding host: %s", clusterConfig.Host)
 
@@ -277,6 +293,12 @@ func (ph *proxyHandler) getProxyHandler(token string, clusterConfig *rest.Config
 			r.Header.Del("Authorization")
 		}
 
+		if ph.dryRun != nil && isUnsupportedInDryRun(r) {
+			oktetoLog.Infof("rejecting request %s %s in dry run mode", r.Method, r.URL.String())
+			rejectDryRunRequest(rw, r)
+			return
+		}
+
 		reverseProxy := proxy
 		if isSPDY(r) {
 			oktetoLog.Debugf("detected SPDY request, disabling HTTP/2 for request %s %s", r.Method, r.URL.String())
@@ -292,6 +314,10 @@ func (ph *proxyHandler) getProxyHandler(token string, clusterConfig *rest.Config
 		}
 
 		r.Host = destinationURL.Host
+		// In dry run mode the cluster validates the changes without persisting them
+		if ph.dryRun != nil && isMutatingRequest(r) {
+			setDryRun(r)
+		}
 		// Modify all resources updated or created to include the label.
 		if shouldInterceptRequest(r) {
 			b, err := io.ReadAll(r.Body)
@@ -356,6 +382,14 @@ func (ph *proxyHandler) SetDivert(driver divert.Driver) {
 	ph.DivertDriver = driver
 }
 
+func (ph *proxyHandler) SetInventory(inv *inventory.Inventory) {
+	ph.inventory = inv
+}
+
+func (ph *proxyHandler) SetDryRun(d *DryRun) {
+	ph.dryRun = d
+}
+
 func newProtocolTransport(clusterConfig *rest.Config, disableHTTP2 bool) (http.RoundTripper, error) {
 	copiedConfig := &rest.Config{}
 	*copiedConfig = *clusterConfig
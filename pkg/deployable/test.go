@@ -22,6 +22,24 @@ import (
 	"github.com/spf13/afero"
 )
 
+// FailedTestCommandStage is the stage of the log sent by a test container running in Remote
+// Execution with the name of the command that failed
+const FailedTestCommandStage = "Failed test command"
+
+// TestCommandError is the error returned by RunTest when a command of the test fails
+type TestCommandError struct {
+	Err     error
+	Command string
+}
+
+func (e TestCommandError) Error() string {
+	return fmt.Sprintf("error executing command '%s': %s", e.Command, e.Err)
+}
+
+func (e TestCommandError) Unwrap() error {
+	return e.Err
+}
+
 // TestRunner is responsible for running the commands defined in a manifest when
 // running tests
 type TestRunner struct {
@@ -73,7 +91,7 @@ func (dr *TestRunner) RunTest(ctx context.Context, params TestParameters) error
 		execEnv = append(execEnv, params.Variables...)
 
 		if err := dr.Executor.Execute(command, execEnv); err != nil {
-			return err
+			return TestCommandError{Command: command.Name, Err: err}
 		}
 		oktetoLog.Success("Command '%s' successfully executed", command.Name)
 
@@ -15,6 +15,7 @@ package deployable
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/okteto/okteto/pkg/model"
@@ -54,3 +55,33 @@ func TestTestRunner(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, executor.AssertExpectations(t))
 }
+
+func TestTestRunnerCommandFails(t *testing.T) {
+	setFakeOktetoContext(t)
+
+	executor := &fakeExecutor{}
+	runner := TestRunner{
+		Executor: executor,
+		Fs:       afero.NewMemMapFs(),
+	}
+
+	cmd1 := model.DeployCommand{
+		Name:    "unit tests",
+		Command: "make test",
+	}
+	executor.On("Execute", cmd1, []string{}).Return(errors.New("exit status 2")).Once()
+
+	err := runner.RunTest(context.Background(), TestParameters{
+		Name:      "test",
+		Namespace: "ns",
+		Deployable: Entity{
+			Commands: []model.DeployCommand{cmd1},
+		},
+	})
+
+	require.EqualError(t, err, "error executing command 'unit tests': exit status 2")
+	var cmdErr TestCommandError
+	require.ErrorAs(t, err, &cmdErr)
+	require.Equal(t, "unit tests", cmdErr.Command)
+	require.EqualError(t, cmdErr.Err, "exit status 2")
+}
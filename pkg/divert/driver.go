@@ -18,11 +18,13 @@ import (
 	"fmt"
 
 	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/divert/gateway"
 	"github.com/okteto/okteto/pkg/divert/istio"
 	"github.com/okteto/okteto/pkg/divert/k8s"
 	"github.com/okteto/okteto/pkg/divert/nginx"
 	"github.com/okteto/okteto/pkg/divert/noop"
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/k8s/httproutes"
 	"github.com/okteto/okteto/pkg/k8s/virtualservices"
 	"github.com/okteto/okteto/pkg/log/io"
 	"github.com/okteto/okteto/pkg/model"
@@ -40,11 +42,11 @@ type Driver interface {
 }
 
 func New(divert *model.DivertDeploy, name, namespace string, c kubernetes.Interface, ioCtrl *io.Controller) (Driver, error) {
-	if !okteto.IsOkteto() {
-		return nil, oktetoErrors.ErrDivertNotSupported
-	}
-
 	if divert.Driver == constants.OktetoDivertNginxDriver {
+		// the nginx driver relies on the ingress controller and the divert resources managed by Okteto
+		if !okteto.IsOkteto() {
+			return nil, oktetoErrors.ErrDivertNotSupported
+		}
 		var err error
 		divertClient := k8s.GetNoopDivertClient(ioCtrl)
 
@@ -60,6 +62,14 @@ func New(divert *model.DivertDeploy, name, namespace string, c kubernetes.Interf
 		return nginx.New(divert, name, namespace, c, manager), nil
 	}
 
+	if divert.Driver == constants.OktetoDivertGatewayDriver {
+		gc, err := httproutes.GetGatewayClient()
+		if err != nil {
+			return nil, fmt.Errorf("error creating gateway client: %w", err)
+		}
+		return gateway.New(divert, name, namespace, c, gc), nil
+	}
+
 	ic, err := virtualservices.GetIstioClient()
 	if err != nil {
 		return nil, fmt.Errorf("error creating istio client: %w", err)
@@ -0,0 +1,61 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package divert
+
+import (
+	"testing"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/divert/gateway"
+	"github.com/okteto/okteto/pkg/divert/istio"
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	"github.com/okteto/okteto/pkg/log/io"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestNewWithoutOkteto(t *testing.T) {
+	okteto.CurrentStore = &okteto.ContextStore{
+		Contexts: map[string]*okteto.Context{
+			"kind": {
+				Name:      "kind",
+				Namespace: "cindy",
+				Cfg: &clientcmdapi.Config{
+					Clusters:       map[string]*clientcmdapi.Cluster{"kind": {Server: "https://127.0.0.1:6443"}},
+					AuthInfos:      map[string]*clientcmdapi.AuthInfo{"kind": {}},
+					Contexts:       map[string]*clientcmdapi.Context{"kind": {Cluster: "kind", AuthInfo: "kind"}},
+					CurrentContext: "kind",
+				},
+			},
+		},
+		CurrentContext: "kind",
+	}
+	defer func() { okteto.CurrentStore = nil }()
+
+	c := fake.NewClientset()
+	_, err := New(&model.DivertDeploy{Driver: constants.OktetoDivertNginxDriver, Namespace: "staging"}, "movies", "cindy", c, io.NewIOController())
+	require.ErrorIs(t, err, oktetoErrors.ErrDivertNotSupported)
+
+	driver, err := New(&model.DivertDeploy{Driver: constants.OktetoDivertIstioDriver}, "movies", "cindy", c, io.NewIOController())
+	require.NoError(t, err)
+	assert.IsType(t, &istio.Driver{}, driver)
+
+	driver, err = New(&model.DivertDeploy{Driver: constants.OktetoDivertGatewayDriver, Namespace: "staging"}, "movies", "cindy", c, io.NewIOController())
+	require.NoError(t, err)
+	assert.IsType(t, &gateway.Driver{}, driver)
+}
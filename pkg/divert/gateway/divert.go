@@ -0,0 +1,377 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/format"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	istioNetworkingV1beta1 "istio.io/api/networking/v1beta1"
+	apiv1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+const (
+	// referenceGrantPrefix is the prefix of the reference grant allowing the HTTPRoutes of the shared
+	// namespace to send traffic to the services of the developer namespace
+	referenceGrantPrefix = "okteto-divert"
+
+	// DivertedNamespaceLabel identifies the HTTPRoutes created in the shared namespace to divert the
+	// traffic to a developer namespace. Its value is the developer namespace
+	DivertedNamespaceLabel = "divert.okteto.com/diverted-namespace"
+
+	// DivertedHTTPRouteAnnotation is the name of the HTTPRoute of the shared namespace copied by a diverted HTTPRoute
+	DivertedHTTPRouteAnnotation = "divert.okteto.com/httproute"
+)
+
+// Driver gateway struct for the divert driver. For each HTTPRoute of the shared namespace, it creates
+// an HTTPRoute with the same parents and hostnames, and a copy of the rules targeting the services of
+// the developer namespace, matching only the requests with the divert header of the developer namespace.
+// The HTTPRoutes of the shared namespace are not modified, as they are shared by all the developers
+type Driver struct {
+	client        kubernetes.Interface
+	gatewayClient gatewayclientset.Interface
+	name          string
+	namespace     string
+	divert        model.DivertDeploy
+}
+
+func New(divert *model.DivertDeploy, name, namespace string, c kubernetes.Interface, gc gatewayclientset.Interface) *Driver {
+	return &Driver{
+		name:          name,
+		namespace:     namespace,
+		divert:        *divert,
+		client:        c,
+		gatewayClient: gc,
+	}
+}
+
+func (d *Driver) Deploy(ctx context.Context) error {
+	oktetoLog.Spinner(fmt.Sprintf("Diverting namespace %s...", d.divert.Namespace))
+	oktetoLog.StartSpinner()
+	defer oktetoLog.StopSpinner()
+
+	services, err := d.getDivertedServices(ctx)
+	if err != nil {
+		return err
+	}
+	if err := d.deployReferenceGrant(ctx); err != nil {
+		return fmt.Errorf("error creating the reference grant of the divert: %w", err)
+	}
+
+	routes, err := d.gatewayClient.GatewayV1().HTTPRoutes(d.divert.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing the HTTPRoutes of namespace '%s': %w", d.divert.Namespace, err)
+	}
+	previous := map[string]bool{}
+	deployed := map[string]bool{}
+	for i := range routes.Items {
+		select {
+		case <-ctx.Done():
+			oktetoLog.Infof("deployDivert context cancelled")
+			return ctx.Err()
+		default:
+			route := &routes.Items[i]
+			if ns, ok := route.Labels[DivertedNamespaceLabel]; ok {
+				if ns == d.namespace {
+					previous[route.Name] = true
+				}
+				continue
+			}
+			diverted, ok := d.translateDivertHTTPRoute(route, services)
+			if !ok {
+				continue
+			}
+			oktetoLog.Spinner(fmt.Sprintf("Diverting HTTPRoute %s/%s...", route.Namespace, route.Name))
+			if err := d.deployHTTPRoute(ctx, diverted); err != nil {
+				return fmt.Errorf("error diverting HTTPRoute '%s/%s': %w", route.Namespace, route.Name, err)
+			}
+			deployed[diverted.Name] = true
+			oktetoLog.StopSpinner()
+			oktetoLog.Success("HTTPRoute '%s/%s' successfully diverted", route.Namespace, route.Name)
+			oktetoLog.StartSpinner()
+		}
+	}
+
+	// the HTTPRoutes of the shared namespace that don't target diverted services anymore are not diverted
+	for name := range previous {
+		if deployed[name] {
+			continue
+		}
+		if err := d.deleteHTTPRoute(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *Driver) Destroy(ctx context.Context) error {
+	oktetoLog.Spinner(fmt.Sprintf("Restoring the HTTPRoutes of namespace %s...", d.divert.Namespace))
+	oktetoLog.StartSpinner()
+	defer oktetoLog.StopSpinner()
+
+	routes, err := d.gatewayClient.GatewayV1().HTTPRoutes(d.divert.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", DivertedNamespaceLabel, d.namespace),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing the HTTPRoutes of namespace '%s': %w", d.divert.Namespace, err)
+	}
+	for i := range routes.Items {
+		if err := d.deleteHTTPRoute(ctx, routes.Items[i].Name); err != nil {
+			return err
+		}
+	}
+
+	err = d.gatewayClient.GatewayV1().ReferenceGrants(d.namespace).Delete(ctx, d.referenceGrantName(), metav1.DeleteOptions{})
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting the reference grant of the divert: %w", err)
+	}
+	oktetoLog.StopSpinner()
+	oktetoLog.Success("Divert from '%s' successfully destroyed", d.divert.Namespace)
+	return nil
+}
+
+func (d *Driver) UpdatePod(pod apiv1.PodSpec) apiv1.PodSpec {
+	if pod.DNSConfig == nil {
+		pod.DNSConfig = &apiv1.PodDNSConfig{}
+	}
+	searches := []string{fmt.Sprintf("%s.svc.cluster.local", d.divert.Namespace)}
+	searches = append(searches, pod.DNSConfig.Searches...)
+	pod.DNSConfig.Searches = searches
+
+	// Add or update environment variables for all containers
+	for i := range pod.InitContainers {
+		updateEnvVar(&pod.InitContainers[i].Env, constants.OktetoSharedEnvironmentEnvVar, d.divert.Namespace)
+		updateEnvVar(&pod.InitContainers[i].Env, constants.OktetoDivertedEnvironmentEnvVar, d.namespace)
+	}
+
+	for i := range pod.Containers {
+		updateEnvVar(&pod.Containers[i].Env, constants.OktetoSharedEnvironmentEnvVar, d.divert.Namespace)
+		updateEnvVar(&pod.Containers[i].Env, constants.OktetoDivertedEnvironmentEnvVar, d.namespace)
+	}
+	return pod
+}
+
+func (*Driver) UpdateVirtualService(_ *istioNetworkingV1beta1.VirtualService) {}
+
+// getDivertedServices returns the services deployed in the developer namespace. Services copied
+// from the shared namespace are not diverted
+func (d *Driver) getDivertedServices(ctx context.Context) (map[string]bool, error) {
+	svcs, err := d.client.CoreV1().Services(d.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing the services of namespace '%s': %w", d.namespace, err)
+	}
+	result := map[string]bool{}
+	for _, svc := range svcs.Items {
+		if svc.Annotations[model.OktetoDivertedNamespaceAnnotation] != "" {
+			continue
+		}
+		result[svc.Name] = true
+	}
+	return result, nil
+}
+
+func (d *Driver) referenceGrantName() string {
+	return format.ResourceK8sMetaString(fmt.Sprintf("%s-%s", referenceGrantPrefix, d.divert.Namespace))
+}
+
+// deployReferenceGrant allows the HTTPRoutes of the shared namespace to route traffic to the
+// services of the developer namespace
+func (d *Driver) deployReferenceGrant(ctx context.Context) error {
+	rg := &gatewayv1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.referenceGrantName(),
+			Namespace: d.namespace,
+			Labels: map[string]string{
+				model.DeployedByLabel: format.ResourceK8sMetaString(d.name),
+			},
+		},
+		Spec: gatewayv1.ReferenceGrantSpec{
+			From: []gatewayv1.ReferenceGrantFrom{
+				{
+					Group:     gatewayv1.GroupName,
+					Kind:      "HTTPRoute",
+					Namespace: gatewayv1.Namespace(d.divert.Namespace),
+				},
+			},
+			To: []gatewayv1.ReferenceGrantTo{
+				{
+					Group: "",
+					Kind:  "Service",
+				},
+			},
+		},
+	}
+
+	old, err := d.gatewayClient.GatewayV1().ReferenceGrants(d.namespace).Get(ctx, rg.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			return err
+		}
+		_, err = d.gatewayClient.GatewayV1().ReferenceGrants(d.namespace).Create(ctx, rg, metav1.CreateOptions{})
+		return err
+	}
+	rg.ResourceVersion = old.ResourceVersion
+	_, err = d.gatewayClient.GatewayV1().ReferenceGrants(d.namespace).Update(ctx, rg, metav1.UpdateOptions{})
+	return err
+}
+
+// divertHTTPRouteName returns the name of the HTTPRoute diverting the HTTPRoute of the shared namespace
+func (d *Driver) divertHTTPRouteName(route string) string {
+	return fmt.Sprintf("%s-%s-%s", route, referenceGrantPrefix, d.namespace)
+}
+
+// deployHTTPRoute creates or updates the HTTPRoute diverting an HTTPRoute of the shared namespace
+func (d *Driver) deployHTTPRoute(ctx context.Context, route *gatewayv1.HTTPRoute) error {
+	old, err := d.gatewayClient.GatewayV1().HTTPRoutes(route.Namespace).Get(ctx, route.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			return err
+		}
+		_, err = d.gatewayClient.GatewayV1().HTTPRoutes(route.Namespace).Create(ctx, route, metav1.CreateOptions{})
+		return err
+	}
+	if old.Labels[DivertedNamespaceLabel] != d.namespace {
+		return fmt.Errorf("HTTPRoute '%s/%s' already exists and it is not managed by the divert of namespace '%s'", route.Namespace, route.Name, d.namespace)
+	}
+	route.ResourceVersion = old.ResourceVersion
+	_, err = d.gatewayClient.GatewayV1().HTTPRoutes(route.Namespace).Update(ctx, route, metav1.UpdateOptions{})
+	return err
+}
+
+func (d *Driver) deleteHTTPRoute(ctx context.Context, name string) error {
+	err := d.gatewayClient.GatewayV1().HTTPRoutes(d.divert.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8sErrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting HTTPRoute '%s/%s': %w", d.divert.Namespace, name, err)
+	}
+	return nil
+}
+
+// translateDivertHTTPRoute returns the HTTPRoute with the same parents and hostnames as the HTTPRoute
+// of the shared namespace, and a copy of its rules sending traffic to services deployed in the developer
+// namespace. It returns false if none of the rules target services of the developer namespace
+func (d *Driver) translateDivertHTTPRoute(route *gatewayv1.HTTPRoute, services map[string]bool) (*gatewayv1.HTTPRoute, bool) {
+	rules := []gatewayv1.HTTPRouteRule{}
+	for i := range route.Spec.Rules {
+		if rule, ok := d.divertRule(route.Spec.Rules[i], services); ok {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil, false
+	}
+	spec := route.Spec.DeepCopy()
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.divertHTTPRouteName(route.Name),
+			Namespace: route.Namespace,
+			Labels: map[string]string{
+				DivertedNamespaceLabel: d.namespace,
+			},
+			Annotations: map[string]string{
+				DivertedHTTPRouteAnnotation: route.Name,
+			},
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: spec.CommonRouteSpec,
+			Hostnames:       spec.Hostnames,
+			Rules:           rules,
+		},
+	}, true
+}
+
+// divertRule returns a copy of the rule sending traffic to the services of the developer namespace,
+// and matching only the requests with the divert baggage of the developer namespace, like the istio driver.
+// The expression is anchored because gateway implementations might not require a full match. It returns false
+// if none of the services of the rule are deployed in the developer namespace
+func (d *Driver) divertRule(rule gatewayv1.HTTPRouteRule, services map[string]bool) (gatewayv1.HTTPRouteRule, bool) {
+	result := *rule.DeepCopy()
+	result.Name = nil
+	diverted := false
+	for i := range result.BackendRefs {
+		ref := &result.BackendRefs[i].BackendObjectReference
+		if !isServiceRef(*ref) || !services[string(ref.Name)] {
+			continue
+		}
+		if ref.Namespace != nil && string(*ref.Namespace) != d.divert.Namespace {
+			continue
+		}
+		ns := gatewayv1.Namespace(d.namespace)
+		ref.Namespace = &ns
+		diverted = true
+	}
+	if !diverted {
+		return gatewayv1.HTTPRouteRule{}, false
+	}
+
+	if len(result.Matches) == 0 {
+		result.Matches = []gatewayv1.HTTPRouteMatch{{}}
+	}
+	regex := gatewayv1.HeaderMatchRegularExpression
+	for i := range result.Matches {
+		result.Matches[i].Headers = append(result.Matches[i].Headers, gatewayv1.HTTPHeaderMatch{
+			Type:  &regex,
+			Name:  constants.OktetoDivertBaggageHeader,
+			Value: fmt.Sprintf("^.*%s=%s(,.*)?$", constants.OktetoDivertHeaderName, d.namespace),
+		})
+	}
+	return result, true
+}
+
+// DivertedServices returns the services of the developer namespace targeted by a diverted HTTPRoute
+func DivertedServices(route *gatewayv1.HTTPRoute) []string {
+	namespace := route.Labels[DivertedNamespaceLabel]
+	result := []string{}
+	for _, rule := range route.Spec.Rules {
+		for _, ref := range rule.BackendRefs {
+			if !isServiceRef(ref.BackendObjectReference) || ref.Namespace == nil || string(*ref.Namespace) != namespace {
+				continue
+			}
+			if !slices.Contains(result, string(ref.Name)) {
+				result = append(result, string(ref.Name))
+			}
+		}
+	}
+	return result
+}
+
+func isServiceRef(ref gatewayv1.BackendObjectReference) bool {
+	if ref.Group != nil && *ref.Group != "" {
+		return false
+	}
+	return ref.Kind == nil || *ref.Kind == "Service"
+}
+
+// updateEnvVar adds or updates an environment variable in the given env var slice
+func updateEnvVar(envVars *[]apiv1.EnvVar, name, value string) {
+	for i := range *envVars {
+		if (*envVars)[i].Name == name {
+			(*envVars)[i].Value = value
+			return
+		}
+	}
+	*envVars = append(*envVars, apiv1.EnvVar{
+		Name:  name,
+		Value: value,
+	})
+}
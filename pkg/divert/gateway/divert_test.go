@@ -0,0 +1,209 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayfake "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func sharedHTTPRoute() *gatewayv1.HTTPRoute {
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "movies",
+			Namespace: "staging",
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			Rules: []gatewayv1.HTTPRouteRule{
+				{
+					Matches: []gatewayv1.HTTPRouteMatch{
+						{Path: &gatewayv1.HTTPPathMatch{Type: ptr(gatewayv1.PathMatchPathPrefix), Value: ptr("/api")}},
+					},
+					BackendRefs: []gatewayv1.HTTPBackendRef{
+						{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "api", Port: ptr(gatewayv1.PortNumber(8080))}}},
+					},
+				},
+				{
+					BackendRefs: []gatewayv1.HTTPBackendRef{
+						{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "frontend", Port: ptr(gatewayv1.PortNumber(80))}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTestDriver(route *gatewayv1.HTTPRoute, services ...string) (*Driver, *gatewayfake.Clientset) {
+	c := fake.NewClientset()
+	for _, name := range services {
+		_, _ = c.CoreV1().Services("cindy").Create(context.Background(), &apiv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "cindy"},
+		}, metav1.CreateOptions{})
+	}
+	// frontend is a copy of the service of the shared namespace
+	_, _ = c.CoreV1().Services("cindy").Create(context.Background(), &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "frontend",
+			Namespace:   "cindy",
+			Annotations: map[string]string{model.OktetoDivertedNamespaceAnnotation: "staging"},
+		},
+	}, metav1.CreateOptions{})
+	gc := gatewayfake.NewSimpleClientset(route)
+	return New(&model.DivertDeploy{Driver: constants.OktetoDivertGatewayDriver, Namespace: "staging"}, "movies", "cindy", c, gc), gc
+}
+
+func TestDeploy(t *testing.T) {
+	ctx := context.Background()
+	d, gc := newTestDriver(sharedHTTPRoute(), "api")
+
+	require.NoError(t, d.Deploy(ctx))
+
+	// the HTTPRoute of the shared namespace is not modified
+	route, err := gc.GatewayV1().HTTPRoutes("staging").Get(ctx, "movies", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, sharedHTTPRoute().Spec, route.Spec)
+
+	diverted, err := gc.GatewayV1().HTTPRoutes("staging").Get(ctx, "movies-okteto-divert-cindy", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "cindy", diverted.Labels[DivertedNamespaceLabel])
+	assert.Equal(t, "movies", diverted.Annotations[DivertedHTTPRouteAnnotation])
+	assert.Equal(t, sharedHTTPRoute().Spec.ParentRefs, diverted.Spec.ParentRefs)
+	assert.Equal(t, sharedHTTPRoute().Spec.Hostnames, diverted.Spec.Hostnames)
+	require.Len(t, diverted.Spec.Rules, 1)
+	rule := diverted.Spec.Rules[0]
+	require.Len(t, rule.Matches, 1)
+	assert.Equal(t, "/api", *rule.Matches[0].Path.Value)
+	assert.Equal(t, []gatewayv1.HTTPHeaderMatch{
+		{Type: ptr(gatewayv1.HeaderMatchRegularExpression), Name: constants.OktetoDivertBaggageHeader, Value: "^.*okteto-divert=cindy(,.*)?$"},
+	}, rule.Matches[0].Headers)
+	require.Len(t, rule.BackendRefs, 1)
+	assert.Equal(t, gatewayv1.ObjectName("api"), rule.BackendRefs[0].Name)
+	assert.Equal(t, gatewayv1.Namespace("cindy"), *rule.BackendRefs[0].Namespace)
+	assert.Equal(t, []string{"api"}, DivertedServices(diverted))
+
+	rg, err := gc.GatewayV1().ReferenceGrants("cindy").Get(ctx, "okteto-divert-staging", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, gatewayv1.Namespace("staging"), rg.Spec.From[0].Namespace)
+	assert.Equal(t, gatewayv1.Kind("Service"), rg.Spec.To[0].Kind)
+
+	// deploying again updates the diverted HTTPRoute
+	require.NoError(t, d.Deploy(ctx))
+	routes, err := gc.GatewayV1().HTTPRoutes("staging").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, routes.Items, 2)
+}
+
+func TestDeployKeepsOtherDiverts(t *testing.T) {
+	ctx := context.Background()
+	d, gc := newTestDriver(sharedHTTPRoute(), "api")
+	other := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "movies-okteto-divert-alice",
+			Namespace: "staging",
+			Labels:    map[string]string{DivertedNamespaceLabel: "alice"},
+		},
+	}
+	_, err := gc.GatewayV1().HTTPRoutes("staging").Create(ctx, other, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, d.Deploy(ctx))
+	routes, err := gc.GatewayV1().HTTPRoutes("staging").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, routes.Items, 3)
+
+	require.NoError(t, d.Destroy(ctx))
+	routes, err = gc.GatewayV1().HTTPRoutes("staging").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, routes.Items, 2)
+	_, err = gc.GatewayV1().HTTPRoutes("staging").Get(ctx, "movies-okteto-divert-alice", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestDeployRemovesStaleDiverts(t *testing.T) {
+	ctx := context.Background()
+	d, gc := newTestDriver(sharedHTTPRoute(), "api")
+	require.NoError(t, d.Deploy(ctx))
+
+	// the developer namespace doesn't deploy the api service anymore
+	require.NoError(t, d.client.CoreV1().Services("cindy").Delete(ctx, "api", metav1.DeleteOptions{}))
+	require.NoError(t, d.Deploy(ctx))
+	_, err := gc.GatewayV1().HTTPRoutes("staging").Get(ctx, "movies-okteto-divert-cindy", metav1.GetOptions{})
+	assert.True(t, k8sErrors.IsNotFound(err))
+}
+
+func TestDeployWithoutDivertedServices(t *testing.T) {
+	ctx := context.Background()
+	d, gc := newTestDriver(sharedHTTPRoute())
+
+	require.NoError(t, d.Deploy(ctx))
+	routes, err := gc.GatewayV1().HTTPRoutes("staging").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, routes.Items, 1)
+	assert.Equal(t, sharedHTTPRoute().Spec, routes.Items[0].Spec)
+}
+
+func TestDeployDoesNotOverwriteOtherHTTPRoutes(t *testing.T) {
+	ctx := context.Background()
+	d, gc := newTestDriver(sharedHTTPRoute(), "api")
+	_, err := gc.GatewayV1().HTTPRoutes("staging").Create(ctx, &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "movies-okteto-divert-cindy", Namespace: "staging"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	assert.Error(t, d.Deploy(ctx))
+}
+
+func TestDestroy(t *testing.T) {
+	ctx := context.Background()
+	d, gc := newTestDriver(sharedHTTPRoute(), "api")
+	require.NoError(t, d.Deploy(ctx))
+
+	require.NoError(t, d.Destroy(ctx))
+	routes, err := gc.GatewayV1().HTTPRoutes("staging").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, routes.Items, 1)
+	assert.Equal(t, sharedHTTPRoute().Spec, routes.Items[0].Spec)
+
+	_, err = gc.GatewayV1().ReferenceGrants("cindy").Get(ctx, "okteto-divert-staging", metav1.GetOptions{})
+	assert.True(t, k8sErrors.IsNotFound(err))
+}
+
+func TestUpdatePod(t *testing.T) {
+	d, _ := newTestDriver(sharedHTTPRoute())
+	pod := d.UpdatePod(apiv1.PodSpec{
+		Containers: []apiv1.Container{
+			{Env: []apiv1.EnvVar{{Name: constants.OktetoSharedEnvironmentEnvVar, Value: "old"}}},
+		},
+	})
+	assert.Equal(t, []string{"staging.svc.cluster.local"}, pod.DNSConfig.Searches)
+	assert.Equal(t, []apiv1.EnvVar{
+		{Name: constants.OktetoSharedEnvironmentEnvVar, Value: "staging"},
+		{Name: constants.OktetoDivertedEnvironmentEnvVar, Value: "cindy"},
+	}, pod.Containers[0].Env)
+}
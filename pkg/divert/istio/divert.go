@@ -21,10 +21,13 @@ import (
 	"github.com/okteto/okteto/pkg/k8s/virtualservices"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
+	"github.com/okteto/okteto/pkg/okteto"
 	istioNetworkingV1beta1 "istio.io/api/networking/v1beta1"
+	istioV1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	istioclientset "istio.io/client-go/pkg/clientset/versioned"
 	apiv1 "k8s.io/api/core/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -121,19 +124,56 @@ func (d *Driver) Destroy(ctx context.Context) error {
 }
 
 func (d *Driver) UpdatePod(pod apiv1.PodSpec) apiv1.PodSpec {
+	sharedNamespace := d.getSharedNamespace()
 	// Add or update environment variables for all containers
 	for i := range pod.InitContainers {
-		updateEnvVar(&pod.InitContainers[i].Env, constants.OktetoSharedEnvironmentEnvVar, d.divert.Namespace)
+		updateEnvVar(&pod.InitContainers[i].Env, constants.OktetoSharedEnvironmentEnvVar, sharedNamespace)
 		updateEnvVar(&pod.InitContainers[i].Env, constants.OktetoDivertedEnvironmentEnvVar, d.namespace)
 	}
 
 	for i := range pod.Containers {
-		updateEnvVar(&pod.Containers[i].Env, constants.OktetoSharedEnvironmentEnvVar, d.divert.Namespace)
+		updateEnvVar(&pod.Containers[i].Env, constants.OktetoSharedEnvironmentEnvVar, sharedNamespace)
 		updateEnvVar(&pod.Containers[i].Env, constants.OktetoDivertedEnvironmentEnvVar, d.namespace)
 	}
 	return pod
 }
 
+// getSharedNamespace returns the namespace of the diverted virtual services, as the istio driver
+// doesn't define the shared namespace in the manifest
+func (d *Driver) getSharedNamespace() string {
+	if d.divert.Namespace != "" {
+		return d.divert.Namespace
+	}
+	for _, vs := range d.divert.VirtualServices {
+		if vs.Namespace != "" {
+			return vs.Namespace
+		}
+	}
+	for _, host := range d.divert.Hosts {
+		if host.Namespace != "" {
+			return host.Namespace
+		}
+	}
+	return ""
+}
+
+// getDeveloperServices returns the services deployed in the developer namespace. Services copied
+// from the shared namespace are not diverted
+func (d *Driver) getDeveloperServices(ctx context.Context) (map[string]bool, error) {
+	svcs, err := d.client.CoreV1().Services(d.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing the services of namespace '%s': %w", d.namespace, err)
+	}
+	result := map[string]bool{}
+	for _, svc := range svcs.Items {
+		if svc.Annotations[model.OktetoDivertedNamespaceAnnotation] != "" {
+			continue
+		}
+		result[svc.Name] = true
+	}
+	return result, nil
+}
+
 func (d *Driver) UpdateVirtualService(vs *istioNetworkingV1beta1.VirtualService) {
 	d.injectDivertHeader(vs)
 }
@@ -149,6 +189,13 @@ func (d *Driver) retryTranslateDivertVirtualService(ctx context.Context, divertV
 		if err != nil {
 			return err
 		}
+		if !okteto.IsOkteto() {
+			services, err := d.getDeveloperServices(ctx)
+			if err != nil {
+				return err
+			}
+			d.injectDivertRoutes(translatedVS, divertVS.Routes, services)
+		}
 		err = virtualservices.Update(ctx, translatedVS, d.istioClient)
 		if err == nil {
 			return nil
@@ -211,3 +258,28 @@ func updateEnvVar(envVars *[]apiv1.EnvVar, name, value string) {
 		Value: value,
 	})
 }
+
+// RemoveDivertAnnotation removes a divert annotation from a virtual service, retrying on conflicts
+func RemoveDivertAnnotation(ctx context.Context, name, namespace, annotation string, ic istioclientset.Interface) error {
+	var err error
+	for retries := 0; retries < UPDATE_CONFLICT_RETRIES; retries++ {
+		var vs *istioV1beta1.VirtualService
+		vs, err = virtualservices.Get(ctx, name, namespace, ic)
+		if err != nil {
+			return err
+		}
+		if _, ok := vs.Annotations[annotation]; !ok {
+			return nil
+		}
+		restoredVS := vs.DeepCopy()
+		delete(restoredVS.Annotations, annotation)
+		err = virtualservices.Update(ctx, restoredVS, ic)
+		if err == nil {
+			return nil
+		}
+		if !k8sErrors.IsConflict(err) {
+			return err
+		}
+	}
+	return err
+}
@@ -187,6 +187,45 @@ func Test_UpdatePod(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "shared-namespace-from-virtual-services",
+			podSpec: apiv1.PodSpec{
+				Containers: []apiv1.Container{
+					{
+						Name: "app",
+					},
+				},
+			},
+			expected: apiv1.PodSpec{
+				Containers: []apiv1.Container{
+					{
+						Name: "app",
+						Env: []apiv1.EnvVar{
+							{
+								Name:  "OKTETO_SHARED_ENVIRONMENT",
+								Value: "staging",
+							},
+							{
+								Name:  "OKTETO_DIVERTED_ENVIRONMENT",
+								Value: "cindy",
+							},
+						},
+					},
+				},
+			},
+			driver: &Driver{
+				name:      "test",
+				namespace: "cindy",
+				divert: model.DivertDeploy{
+					VirtualServices: []model.DivertVirtualService{
+						{
+							Name:      "frontend",
+							Namespace: "staging",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "pod-with-multiple-containers",
 			podSpec: apiv1.PodSpec{
@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/okteto/okteto/pkg/constants"
@@ -64,6 +65,120 @@ func (d *Driver) restoreDivertVirtualService(vs *istioV1beta1.VirtualService) *i
 	}
 	delete(result.Annotations, d.getDivertAnnotationName())
 	delete(result.Annotations, d.getDeprecatedDivertAnnotationName())
+	if !okteto.IsOkteto() {
+		d.removeDivertRoutes(&result.Spec)
+	}
+	return result
+}
+
+// getDivertRoutePrefix returns the prefix of the routes diverted to the developer namespace. Namespaces
+// can't contain dots, so the prefix of a namespace is never the prefix of another namespace
+func (d *Driver) getDivertRoutePrefix() string {
+	return fmt.Sprintf("%s.%s.", constants.OktetoDivertHeaderName, d.namespace)
+}
+
+// injectDivertRoutes adds to the virtual service a copy of its routes sending traffic to the services
+// deployed in the developer namespace, matching only the requests with the divert header of the developer
+// namespace. In Okteto, the okteto mutation webhook does it from the divert annotation
+func (d *Driver) injectDivertRoutes(vs *istioV1beta1.VirtualService, routes []string, services map[string]bool) {
+	d.removeDivertRoutes(&vs.Spec)
+	diverted := []*istioNetworkingV1beta1.HTTPRoute{}
+	for _, http := range vs.Spec.Http {
+		if strings.HasPrefix(http.Name, constants.OktetoDivertHeaderName+".") {
+			continue
+		}
+		if len(routes) > 0 && !slices.Contains(routes, http.Name) {
+			continue
+		}
+		if route, ok := d.divertRoute(http, vs.Namespace, services); ok {
+			diverted = append(diverted, route)
+		}
+	}
+	// istio evaluates the routes in order, so the diverted routes must be evaluated first
+	vs.Spec.Http = append(diverted, vs.Spec.Http...)
+}
+
+// divertRoute returns a copy of the route sending traffic to the services of the developer namespace. It
+// returns false if none of the destinations of the route are deployed in the developer namespace
+func (d *Driver) divertRoute(http *istioNetworkingV1beta1.HTTPRoute, sharedNamespace string, services map[string]bool) (*istioNetworkingV1beta1.HTTPRoute, bool) {
+	result := http.DeepCopy()
+	result.Name = d.getDivertRoutePrefix() + http.Name
+	diverted := false
+	for _, route := range result.Route {
+		if route.Destination == nil {
+			continue
+		}
+		parts := strings.Split(route.Destination.Host, ".")
+		if len(parts) > 1 && parts[1] != sharedNamespace {
+			continue
+		}
+		if !services[parts[0]] {
+			continue
+		}
+		route.Destination.Host = fmt.Sprintf("%s.%s.svc.cluster.local", parts[0], d.namespace)
+		diverted = true
+	}
+	if !diverted {
+		return nil, false
+	}
+
+	if len(result.Match) == 0 {
+		result.Match = []*istioNetworkingV1beta1.HTTPMatchRequest{{}}
+	}
+	for _, match := range result.Match {
+		if match.Headers == nil {
+			match.Headers = map[string]*istioNetworkingV1beta1.StringMatch{}
+		}
+		match.Headers[constants.OktetoDivertBaggageHeader] = &istioNetworkingV1beta1.StringMatch{
+			MatchType: &istioNetworkingV1beta1.StringMatch_Regex{
+				Regex: fmt.Sprintf(".*%s=%s(,.*)?", constants.OktetoDivertHeaderName, d.namespace),
+			},
+		}
+	}
+	return result, true
+}
+
+// removeDivertRoutes removes the routes added by injectDivertRoutes
+func (d *Driver) removeDivertRoutes(vsSpec *istioNetworkingV1beta1.VirtualService) {
+	result := []*istioNetworkingV1beta1.HTTPRoute{}
+	for _, http := range vsSpec.Http {
+		if strings.HasPrefix(http.Name, d.getDivertRoutePrefix()) {
+			continue
+		}
+		result = append(result, http)
+	}
+	vsSpec.Http = result
+}
+
+// getDivertHosts returns the public hosts of the virtual service in the developer namespace. In contexts
+// without Okteto, they are derived from the hosts of the virtual service in the shared namespace that
+// contain the namespace as a label, or follow the '<name>-<namespace>' convention in their first label
+func (d *Driver) getDivertHosts(vs *istioV1beta1.VirtualService) []string {
+	if okteto.IsOkteto() {
+		return []string{fmt.Sprintf("%s-%s.%s", vs.Name, d.namespace, okteto.GetSubdomain())}
+	}
+	result := []string{}
+	for _, host := range vs.Spec.Hosts {
+		if !strings.Contains(host, ".") || strings.HasSuffix(host, ".svc.cluster.local") {
+			continue
+		}
+		parts := strings.Split(host, ".")
+		replaced := false
+		for i := range parts {
+			switch {
+			case parts[i] == vs.Namespace:
+				parts[i] = d.namespace
+			case i == 0 && parts[i] == fmt.Sprintf("%s-%s", vs.Name, vs.Namespace):
+				parts[i] = fmt.Sprintf("%s-%s", vs.Name, d.namespace)
+			default:
+				continue
+			}
+			replaced = true
+		}
+		if replaced {
+			result = append(result, strings.Join(parts, "."))
+		}
+	}
 	return result
 }
 
@@ -75,10 +190,7 @@ func (d *Driver) translateDivertHost(vs *istioV1beta1.VirtualService) *istioV1be
 	result.ResourceVersion = ""
 	result.UID = types.UID("")
 	result.Spec.Tls = nil
-	result.Spec.Hosts = []string{
-		fmt.Sprintf("%s-%s.%s", result.Name, d.namespace, okteto.GetSubdomain()),
-		fmt.Sprintf("%s.%s.svc.cluster.local", result.Name, d.namespace),
-	}
+	result.Spec.Hosts = append(d.getDivertHosts(vs), fmt.Sprintf("%s.%s.svc.cluster.local", result.Name, d.namespace))
 
 	d.injectDivertHeader(&result.Spec)
 
@@ -107,3 +219,20 @@ func (d *Driver) injectDivertHeader(vsSpec *istioNetworkingV1beta1.VirtualServic
 		vsSpec.Http[i].Headers.Request.Add[constants.OktetoDivertBaggageHeader] = fmt.Sprintf("%s=%s", constants.OktetoDivertHeaderName, d.namespace)
 	}
 }
+
+// DivertAnnotations returns the divert transformations of the virtual service, indexed by the name of their annotation
+func DivertAnnotations(vs *istioV1beta1.VirtualService) map[string]DivertTransformation {
+	result := map[string]DivertTransformation{}
+	prefix := strings.TrimSuffix(constants.OktetoDivertAnnotationTemplate, "%s")
+	for key, value := range vs.Annotations {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		var transformation DivertTransformation
+		if err := json.Unmarshal([]byte(value), &transformation); err != nil || transformation.Namespace == "" {
+			continue
+		}
+		result[key] = transformation
+	}
+	return result
+}
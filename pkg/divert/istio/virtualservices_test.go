@@ -24,6 +24,7 @@ import (
 	"github.com/okteto/okteto/pkg/okteto"
 	"github.com/okteto/okteto/pkg/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	istioNetworkingV1beta1 "istio.io/api/networking/v1beta1"
 	istioV1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -384,6 +385,8 @@ func Test_translateDivertHost(t *testing.T) {
 		},
 	}
 	okteto.AddOktetoContext("test", &types.User{Registry: "registry.demo.okteto.dev"}, "okteto", "cyndy")
+	okteto.GetContext().IsOkteto = true
+	defer func() { okteto.GetContext().IsOkteto = false }()
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -399,3 +402,100 @@ func Test_translateDivertHost(t *testing.T) {
 		})
 	}
 }
+
+func Test_injectDivertRoutes(t *testing.T) {
+	d := &Driver{
+		name:      "test",
+		namespace: "cindy",
+	}
+	destination := func(host string) []*istioNetworkingV1beta1.HTTPRouteDestination {
+		return []*istioNetworkingV1beta1.HTTPRouteDestination{{Destination: &istioNetworkingV1beta1.Destination{Host: host}}}
+	}
+	vs := &istioV1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "staging"},
+		Spec: istioNetworkingV1beta1.VirtualService{
+			Http: []*istioNetworkingV1beta1.HTTPRoute{
+				{
+					Name:  "api",
+					Match: []*istioNetworkingV1beta1.HTTPMatchRequest{{Uri: &istioNetworkingV1beta1.StringMatch{MatchType: &istioNetworkingV1beta1.StringMatch_Prefix{Prefix: "/api"}}}},
+					Route: destination("api.staging.svc.cluster.local"),
+				},
+				{Name: "frontend", Route: destination("frontend")},
+				{Name: "other", Route: destination("api.other.svc.cluster.local")},
+			},
+		},
+	}
+
+	d.injectDivertRoutes(vs, nil, map[string]bool{"api": true})
+	require.Len(t, vs.Spec.Http, 4)
+	diverted := vs.Spec.Http[0]
+	assert.Equal(t, "okteto-divert.cindy.api", diverted.Name)
+	assert.Equal(t, "api.cindy.svc.cluster.local", diverted.Route[0].Destination.Host)
+	require.Len(t, diverted.Match, 1)
+	assert.Equal(t, "/api", diverted.Match[0].Uri.GetPrefix())
+	assert.Equal(t, ".*okteto-divert=cindy(,.*)?", diverted.Match[0].Headers[constants.OktetoDivertBaggageHeader].GetRegex())
+	assert.Equal(t, "api.staging.svc.cluster.local", vs.Spec.Http[1].Route[0].Destination.Host)
+
+	// injecting the routes again doesn't duplicate them
+	d.injectDivertRoutes(vs, nil, map[string]bool{"api": true, "frontend": true})
+	require.Len(t, vs.Spec.Http, 5)
+	assert.Equal(t, "okteto-divert.cindy.api", vs.Spec.Http[0].Name)
+	assert.Equal(t, "okteto-divert.cindy.frontend", vs.Spec.Http[1].Name)
+	assert.Equal(t, "frontend.cindy.svc.cluster.local", vs.Spec.Http[1].Route[0].Destination.Host)
+
+	// only the routes of the manifest are diverted
+	d.injectDivertRoutes(vs, []string{"frontend"}, map[string]bool{"api": true, "frontend": true})
+	require.Len(t, vs.Spec.Http, 4)
+	assert.Equal(t, "okteto-divert.cindy.frontend", vs.Spec.Http[0].Name)
+
+	restored := d.restoreDivertVirtualService(vs)
+	require.Len(t, restored.Spec.Http, 3)
+	assert.Equal(t, "api", restored.Spec.Http[0].Name)
+}
+
+func Test_removeDivertRoutesOfNamespaceWithSamePrefix(t *testing.T) {
+	cindy := &Driver{name: "test", namespace: "cindy"}
+	cindyDev := &Driver{name: "test", namespace: "cindy-dev"}
+	vs := &istioV1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "staging"},
+		Spec: istioNetworkingV1beta1.VirtualService{
+			Http: []*istioNetworkingV1beta1.HTTPRoute{
+				{Name: "api", Route: []*istioNetworkingV1beta1.HTTPRouteDestination{{Destination: &istioNetworkingV1beta1.Destination{Host: "api"}}}},
+			},
+		},
+	}
+	cindy.injectDivertRoutes(vs, nil, map[string]bool{"api": true})
+	cindyDev.injectDivertRoutes(vs, nil, map[string]bool{"api": true})
+	require.Len(t, vs.Spec.Http, 3)
+
+	cindy.removeDivertRoutes(&vs.Spec)
+	require.Len(t, vs.Spec.Http, 2)
+	assert.Equal(t, "okteto-divert.cindy-dev.api", vs.Spec.Http[0].Name)
+	assert.Equal(t, "api", vs.Spec.Http[1].Name)
+}
+
+func Test_translateDivertHostWithoutOkteto(t *testing.T) {
+	d := &Driver{
+		name:      "test",
+		namespace: "cindy",
+	}
+	vs := &istioV1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "staging"},
+		Spec: istioNetworkingV1beta1.VirtualService{
+			Hosts: []string{"frontend", "frontend.staging.svc.cluster.local", "frontend-staging.example.com", "www.example.com"},
+		},
+	}
+
+	result := d.translateDivertHost(vs)
+	assert.Equal(t, []string{"frontend-cindy.example.com", "frontend.cindy.svc.cluster.local"}, result.Spec.Hosts)
+
+	// only the labels equal to the namespace are replaced
+	vs = &istioV1beta1.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "app"},
+		Spec: istioNetworkingV1beta1.VirtualService{
+			Hosts: []string{"app-api.app.example.com", "application.example.com"},
+		},
+	}
+	result = d.translateDivertHost(vs)
+	assert.Equal(t, []string{"app-api.cindy.example.com", "api.cindy.svc.cluster.local"}, result.Spec.Hosts)
+}
@@ -195,16 +195,22 @@ func (d *Driver) deployDivertResources(ctx context.Context) error {
 	return nil
 }
 
-// deleteOrphanDiverts checks for divert resources that are not associated with any developer service, and deletes them.
-func (d *Driver) deleteOrphanDiverts(ctx context.Context) error {
-	divertToDelete := make([]string, 0)
-	for _, div := range d.cache.divertResources {
-		if _, ok := d.cache.developerServices[div.Spec.Service]; ok {
+// OrphanDiverts returns the names of the divert resources that are not associated with any developer service
+func OrphanDiverts(diverts map[string]*k8s.Divert, developerServices map[string]*apiv1.Service) []string {
+	result := make([]string, 0)
+	for _, div := range diverts {
+		if _, ok := developerServices[div.Spec.Service]; ok {
 			continue
 		}
 
-		divertToDelete = append(divertToDelete, div.Name)
+		result = append(result, div.Name)
 	}
+	return result
+}
+
+// deleteOrphanDiverts checks for divert resources that are not associated with any developer service, and deletes them.
+func (d *Driver) deleteOrphanDiverts(ctx context.Context) error {
+	divertToDelete := OrphanDiverts(d.cache.divertResources, d.cache.developerServices)
 
 	for _, name := range divertToDelete {
 		if err := d.divertManager.Delete(ctx, name, d.namespace); err != nil {
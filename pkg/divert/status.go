@@ -0,0 +1,420 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package divert
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/divert/gateway"
+	"github.com/okteto/okteto/pkg/divert/istio"
+	"github.com/okteto/okteto/pkg/divert/k8s"
+	"github.com/okteto/okteto/pkg/divert/nginx"
+	"github.com/okteto/okteto/pkg/model"
+	istioclientset "istio.io/client-go/pkg/clientset/versioned"
+	apiv1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+const (
+	// StatusKindService is a service of the shared namespace diverted by the nginx driver
+	StatusKindService = "service"
+
+	// StatusKindHost is a host of the shared namespace exposed in the developer namespace
+	StatusKindHost = "host"
+
+	// StatusKindVirtualService is a virtual service of the shared namespace diverted by the istio driver
+	StatusKindVirtualService = "virtualservice"
+
+	// StatusKindHTTPRoute is an HTTPRoute of the shared namespace diverted by the gateway driver
+	StatusKindHTTPRoute = "httproute"
+)
+
+// Status is the state of a resource of the shared namespace diverted into a developer namespace
+type Status struct {
+	// prune removes the divert when it is orphaned
+	prune func(ctx context.Context) error
+
+	Driver    string `json:"driver"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Header    string `json:"header"`
+	Reason    string `json:"reason,omitempty"`
+	Healthy   bool   `json:"healthy"`
+	Orphan    bool   `json:"orphan"`
+}
+
+// Prune removes an orphaned divert
+func (s *Status) Prune(ctx context.Context) error {
+	if !s.Orphan || s.prune == nil {
+		return fmt.Errorf("divert '%s/%s' of namespace '%s' can't be pruned", s.Kind, s.Name, s.Namespace)
+	}
+	return s.prune(ctx)
+}
+
+// StatusReader reads the diverts of a shared namespace. Drivers without a client are not read
+type StatusReader struct {
+	client        kubernetes.Interface
+	divertClient  k8s.DivertV1Interface
+	istioClient   istioclientset.Interface
+	gatewayClient gatewayclientset.Interface
+}
+
+// NewStatusReader returns a reader for the diverts of the drivers with a client
+func NewStatusReader(c kubernetes.Interface, dc k8s.DivertV1Interface, ic istioclientset.Interface, gc gatewayclientset.Interface) *StatusReader {
+	return &StatusReader{
+		client:        c,
+		divertClient:  dc,
+		istioClient:   ic,
+		gatewayClient: gc,
+	}
+}
+
+// List returns the diverts of the shared namespace. The nginx driver keeps its diverts in the
+// developer namespaces, so only the given developer namespaces are inspected for it. The diverts of
+// namespaces that are not found in the cluster are orphaned
+func (r *StatusReader) List(ctx context.Context, sharedNamespace string, namespaces []string) ([]Status, error) {
+	result := []Status{}
+	sharedServices, err := r.listServices(ctx, sharedNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ns := range namespaces {
+		if ns == sharedNamespace {
+			continue
+		}
+		statuses, err := r.listNginx(ctx, sharedNamespace, ns, sharedServices)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, statuses...)
+	}
+
+	statuses, err := r.listIstio(ctx, sharedNamespace, namespaces)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, statuses...)
+
+	statuses, err = r.listGateway(ctx, sharedNamespace)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, statuses...)
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		if result[i].Kind != result[j].Kind {
+			return result[i].Kind < result[j].Kind
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// listNginx returns the divert resources and the ingresses of the developer namespace diverting the shared namespace
+func (r *StatusReader) listNginx(ctx context.Context, sharedNamespace, namespace string, sharedServices map[string]*apiv1.Service) ([]Status, error) {
+	result := []Status{}
+	diverts := map[string]*k8s.Divert{}
+	if r.divertClient != nil {
+		manager := NewManager(r.divertClient)
+		divertList, err := manager.List(ctx, namespace)
+		if err != nil && !isNotAvailable(err) {
+			return nil, fmt.Errorf("error listing the diverts of namespace '%s': %w", namespace, err)
+		}
+		for _, div := range divertList {
+			if div.Spec.SharedNamespace == sharedNamespace {
+				diverts[div.Name] = div
+			}
+		}
+	}
+
+	ingresses, err := r.client.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil && !isNotAvailable(err) {
+		return nil, fmt.Errorf("error listing the ingresses of namespace '%s': %w", namespace, err)
+	}
+	if len(diverts) == 0 && (ingresses == nil || len(ingresses.Items) == 0) {
+		return result, nil
+	}
+
+	developerServices, err := r.listServices(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	orphans := map[string]bool{}
+	for _, name := range nginx.OrphanDiverts(diverts, developerServices) {
+		orphans[name] = true
+	}
+
+	for name, div := range diverts {
+		status := Status{
+			Driver:    constants.OktetoDivertNginxDriver,
+			Kind:      StatusKindService,
+			Name:      div.Spec.Service,
+			Namespace: namespace,
+			Header:    baggageHeader(div.Spec.DivertKey),
+			Healthy:   true,
+		}
+		switch {
+		case orphans[name]:
+			status.Healthy = false
+			status.Orphan = true
+			status.Reason = fmt.Sprintf("service '%s' doesn't exist in namespace '%s'", div.Spec.Service, namespace)
+			divertName, divertNamespace := div.Name, div.Namespace
+			status.prune = func(ctx context.Context) error {
+				return NewManager(r.divertClient).Delete(ctx, divertName, divertNamespace)
+			}
+		case sharedServices[div.Spec.Service] == nil:
+			status.Healthy = false
+			status.Reason = fmt.Sprintf("service '%s' doesn't exist in namespace '%s'", div.Spec.Service, sharedNamespace)
+		}
+		result = append(result, status)
+	}
+
+	if ingresses == nil {
+		return result, nil
+	}
+	for i := range ingresses.Items {
+		in := &ingresses.Items[i]
+		if in.Annotations[model.OktetoDivertedNamespaceAnnotation] != sharedNamespace {
+			continue
+		}
+		hosts := []string{}
+		for _, rule := range in.Spec.Rules {
+			if rule.Host != "" {
+				hosts = append(hosts, rule.Host)
+			}
+		}
+		status := Status{
+			Driver:    constants.OktetoDivertNginxDriver,
+			Kind:      StatusKindHost,
+			Name:      strings.Join(hosts, ","),
+			Namespace: namespace,
+			Header:    baggageHeader(in.Annotations[model.OktetoDivertHeaderAnnotation]),
+			Healthy:   true,
+		}
+		if status.Name == "" {
+			status.Name = in.Name
+		}
+		_, err := r.client.NetworkingV1().Ingresses(sharedNamespace).Get(ctx, in.Name, metav1.GetOptions{})
+		if err != nil {
+			if !k8sErrors.IsNotFound(err) {
+				return nil, fmt.Errorf("error getting ingress '%s/%s': %w", sharedNamespace, in.Name, err)
+			}
+			status.Healthy = false
+			status.Reason = fmt.Sprintf("ingress '%s' doesn't exist in namespace '%s'", in.Name, sharedNamespace)
+		}
+		result = append(result, status)
+	}
+	return result, nil
+}
+
+// listIstio returns the virtual services of the shared namespace diverted by the istio driver, and
+// the hosts of the developer namespaces created from them
+func (r *StatusReader) listIstio(ctx context.Context, sharedNamespace string, namespaces []string) ([]Status, error) {
+	result := []Status{}
+	if r.istioClient == nil {
+		return result, nil
+	}
+	vsList, err := r.istioClient.NetworkingV1beta1().VirtualServices(sharedNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if isNotAvailable(err) {
+			return result, nil
+		}
+		return nil, fmt.Errorf("error listing the virtual services of namespace '%s': %w", sharedNamespace, err)
+	}
+
+	sharedVirtualServices := map[string]bool{}
+	for _, vs := range vsList.Items {
+		sharedVirtualServices[vs.Name] = true
+		for annotation, transformation := range istio.DivertAnnotations(vs) {
+			status := Status{
+				Driver:    constants.OktetoDivertIstioDriver,
+				Kind:      StatusKindVirtualService,
+				Name:      vs.Name,
+				Namespace: transformation.Namespace,
+				Header:    baggageHeader(transformation.Namespace),
+				Healthy:   true,
+			}
+			if !r.namespaceExists(ctx, transformation.Namespace) {
+				status.Healthy = false
+				status.Orphan = true
+				status.Reason = fmt.Sprintf("namespace '%s' doesn't exist", transformation.Namespace)
+				vsName, annotation := vs.Name, annotation
+				status.prune = func(ctx context.Context) error {
+					return istio.RemoveDivertAnnotation(ctx, vsName, sharedNamespace, annotation, r.istioClient)
+				}
+			}
+			result = append(result, status)
+		}
+	}
+
+	// hosts are copies of the virtual services of the shared namespace routing to its services
+	destinationSuffix := fmt.Sprintf(".%s.svc.cluster.local", sharedNamespace)
+	for _, ns := range namespaces {
+		if ns == sharedNamespace {
+			continue
+		}
+		hostList, err := r.istioClient.NetworkingV1beta1().VirtualServices(ns).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=true", model.OktetoAutoCreateAnnotation),
+		})
+		if err != nil {
+			if isNotAvailable(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error listing the virtual services of namespace '%s': %w", ns, err)
+		}
+		for _, vs := range hostList.Items {
+			isFromShared := false
+			for _, http := range vs.Spec.Http {
+				for _, route := range http.Route {
+					if route.Destination != nil && strings.HasSuffix(route.Destination.Host, destinationSuffix) {
+						isFromShared = true
+					}
+				}
+			}
+			if !isFromShared {
+				continue
+			}
+			status := Status{
+				Driver:    constants.OktetoDivertIstioDriver,
+				Kind:      StatusKindHost,
+				Name:      strings.Join(vs.Spec.Hosts, ","),
+				Namespace: ns,
+				Header:    baggageHeader(ns),
+				Healthy:   true,
+			}
+			if !sharedVirtualServices[vs.Name] {
+				status.Healthy = false
+				status.Reason = fmt.Sprintf("virtual service '%s' doesn't exist in namespace '%s'", vs.Name, sharedNamespace)
+			}
+			result = append(result, status)
+		}
+	}
+	return result, nil
+}
+
+// listGateway returns the HTTPRoutes of the shared namespace diverted by the gateway driver
+func (r *StatusReader) listGateway(ctx context.Context, sharedNamespace string) ([]Status, error) {
+	result := []Status{}
+	if r.gatewayClient == nil {
+		return result, nil
+	}
+	routes, err := r.gatewayClient.GatewayV1().HTTPRoutes(sharedNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if isNotAvailable(err) {
+			return result, nil
+		}
+		return nil, fmt.Errorf("error listing the HTTPRoutes of namespace '%s': %w", sharedNamespace, err)
+	}
+
+	sharedRoutes := map[string]bool{}
+	for i := range routes.Items {
+		if _, ok := routes.Items[i].Labels[gateway.DivertedNamespaceLabel]; !ok {
+			sharedRoutes[routes.Items[i].Name] = true
+		}
+	}
+
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		ns, ok := route.Labels[gateway.DivertedNamespaceLabel]
+		if !ok {
+			continue
+		}
+		status := Status{
+			Driver:    constants.OktetoDivertGatewayDriver,
+			Kind:      StatusKindHTTPRoute,
+			Name:      route.Annotations[gateway.DivertedHTTPRouteAnnotation],
+			Namespace: ns,
+			Header:    baggageHeader(ns),
+			Healthy:   true,
+		}
+		if !r.namespaceExists(ctx, ns) {
+			status.Healthy = false
+			status.Orphan = true
+			status.Reason = fmt.Sprintf("namespace '%s' doesn't exist", ns)
+			routeName := route.Name
+			status.prune = func(ctx context.Context) error {
+				err := r.gatewayClient.GatewayV1().HTTPRoutes(sharedNamespace).Delete(ctx, routeName, metav1.DeleteOptions{})
+				if err != nil && !k8sErrors.IsNotFound(err) {
+					return fmt.Errorf("error deleting HTTPRoute '%s/%s': %w", sharedNamespace, routeName, err)
+				}
+				return nil
+			}
+			result = append(result, status)
+			continue
+		}
+
+		if !sharedRoutes[status.Name] {
+			status.Healthy = false
+			status.Reason = fmt.Sprintf("HTTPRoute '%s' doesn't exist in namespace '%s'", status.Name, sharedNamespace)
+			result = append(result, status)
+			continue
+		}
+
+		developerServices, err := r.listServices(ctx, ns)
+		if err != nil {
+			return nil, err
+		}
+		for _, svc := range gateway.DivertedServices(route) {
+			if developerServices[svc] == nil {
+				status.Healthy = false
+				status.Reason = fmt.Sprintf("service '%s' doesn't exist in namespace '%s'", svc, ns)
+				break
+			}
+		}
+		result = append(result, status)
+	}
+	return result, nil
+}
+
+func (r *StatusReader) listServices(ctx context.Context, namespace string) (map[string]*apiv1.Service, error) {
+	result := map[string]*apiv1.Service{}
+	sList, err := r.client.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing the services of namespace '%s': %w", namespace, err)
+	}
+	for i := range sList.Items {
+		result[sList.Items[i].Name] = &sList.Items[i]
+	}
+	return result, nil
+}
+
+// namespaceExists returns false only when the cluster says that the namespace is not found. The namespaces
+// that the user is not allowed to get, like the ones of other developers, are considered to exist so their
+// diverts are never pruned
+func (r *StatusReader) namespaceExists(ctx context.Context, namespace string) bool {
+	_, err := r.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	return !k8sErrors.IsNotFound(err)
+}
+
+// isNotAvailable returns true when the resource can't be read, because its CRD is not installed
+// in the cluster or the user is not allowed to read it
+func isNotAvailable(err error) bool {
+	return k8sErrors.IsNotFound(err) || k8sErrors.IsForbidden(err)
+}
+
+func baggageHeader(divertKey string) string {
+	return fmt.Sprintf("%s: %s=%s", constants.OktetoDivertBaggageHeader, constants.OktetoDivertHeaderName, divertKey)
+}
@@ -0,0 +1,236 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package divert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/divert/gateway"
+	"github.com/okteto/okteto/pkg/divert/k8s"
+	"github.com/okteto/okteto/pkg/divert/k8s/fake"
+	"github.com/okteto/okteto/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	istioNetworkingV1beta1 "istio.io/api/networking/v1beta1"
+	istioV1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	apiv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayfake "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+)
+
+func TestStatusReaderNginx(t *testing.T) {
+	ctx := context.Background()
+	c := k8sfake.NewClientset(
+		&apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cindy"}},
+		&apiv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "staging"}},
+		&apiv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "cindy"}},
+		&apiv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "cindy"}},
+		&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "staging"}},
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "movies",
+				Namespace: "cindy",
+				Annotations: map[string]string{
+					model.OktetoDivertedNamespaceAnnotation: "staging",
+					model.OktetoDivertHeaderAnnotation:      "cindy",
+				},
+			},
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{Host: "movies-cindy.okteto.dev"}},
+			},
+		},
+	)
+	dc := fake.NewFakeDivertV1(fake.PossibleDivertErrors{},
+		&k8s.Divert{
+			ObjectMeta: metav1.ObjectMeta{Name: "movies-api", Namespace: "cindy"},
+			Spec:       k8s.DivertSpec{Service: "api", SharedNamespace: "staging", DivertKey: "cindy"},
+		},
+		&k8s.Divert{
+			ObjectMeta: metav1.ObjectMeta{Name: "movies-worker", Namespace: "cindy"},
+			Spec:       k8s.DivertSpec{Service: "worker", SharedNamespace: "staging", DivertKey: "cindy"},
+		},
+		&k8s.Divert{
+			ObjectMeta: metav1.ObjectMeta{Name: "movies-db", Namespace: "cindy"},
+			Spec:       k8s.DivertSpec{Service: "db", SharedNamespace: "staging", DivertKey: "cindy"},
+		},
+		&k8s.Divert{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-api", Namespace: "cindy"},
+			Spec:       k8s.DivertSpec{Service: "api", SharedNamespace: "other", DivertKey: "cindy"},
+		},
+	)
+
+	r := NewStatusReader(c, dc, nil, nil)
+	statuses, err := r.List(ctx, "staging", []string{"staging", "cindy"})
+	require.NoError(t, err)
+	for i := range statuses {
+		statuses[i].prune = nil
+	}
+	assert.Equal(t, []Status{
+		{Driver: constants.OktetoDivertNginxDriver, Kind: StatusKindHost, Name: "movies-cindy.okteto.dev", Namespace: "cindy", Header: "baggage: okteto-divert=cindy", Healthy: true},
+		{Driver: constants.OktetoDivertNginxDriver, Kind: StatusKindService, Name: "api", Namespace: "cindy", Header: "baggage: okteto-divert=cindy", Healthy: true},
+		{Driver: constants.OktetoDivertNginxDriver, Kind: StatusKindService, Name: "db", Namespace: "cindy", Header: "baggage: okteto-divert=cindy", Orphan: true, Reason: "service 'db' doesn't exist in namespace 'cindy'"},
+		{Driver: constants.OktetoDivertNginxDriver, Kind: StatusKindService, Name: "worker", Namespace: "cindy", Header: "baggage: okteto-divert=cindy", Reason: "service 'worker' doesn't exist in namespace 'staging'"},
+	}, statuses)
+
+	statuses, err = r.List(ctx, "staging", []string{"cindy"})
+	require.NoError(t, err)
+	require.True(t, statuses[2].Orphan)
+	require.NoError(t, statuses[2].Prune(ctx))
+	_, err = dc.Diverts("cindy").Get(ctx, "movies-db", metav1.GetOptions{})
+	require.Error(t, err)
+
+	require.Error(t, statuses[1].Prune(ctx))
+}
+
+func TestStatusReaderIstio(t *testing.T) {
+	ctx := context.Background()
+	c := k8sfake.NewClientset(&apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cindy"}})
+	ic := istiofake.NewSimpleClientset(
+		&istioV1beta1.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "frontend",
+				Namespace: "staging",
+				Annotations: map[string]string{
+					"divert.okteto.com/abc":                 `{"namespace":"cindy"}`,
+					"divert.okteto.com/def":                 `{"namespace":"deleted"}`,
+					model.OktetoDivertedNamespaceAnnotation: "staging",
+				},
+			},
+		},
+		&istioV1beta1.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "frontend",
+				Namespace: "cindy",
+				Labels:    map[string]string{model.OktetoAutoCreateAnnotation: "true"},
+			},
+			Spec: istioNetworkingV1beta1.VirtualService{
+				Hosts: []string{"frontend-cindy.okteto.dev"},
+				Http: []*istioNetworkingV1beta1.HTTPRoute{
+					{Route: []*istioNetworkingV1beta1.HTTPRouteDestination{{Destination: &istioNetworkingV1beta1.Destination{Host: "frontend.staging.svc.cluster.local"}}}},
+				},
+			},
+		},
+	)
+
+	r := NewStatusReader(c, nil, ic, nil)
+	statuses, err := r.List(ctx, "staging", []string{"cindy"})
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	assert.Equal(t, Status{Driver: constants.OktetoDivertIstioDriver, Kind: StatusKindHost, Name: "frontend-cindy.okteto.dev", Namespace: "cindy", Header: "baggage: okteto-divert=cindy", Healthy: true}, statuses[0])
+	assert.Equal(t, Status{Driver: constants.OktetoDivertIstioDriver, Kind: StatusKindVirtualService, Name: "frontend", Namespace: "cindy", Header: "baggage: okteto-divert=cindy", Healthy: true}, statuses[1])
+	orphan := statuses[2]
+	assert.Equal(t, "deleted", orphan.Namespace)
+	assert.True(t, orphan.Orphan)
+	assert.Equal(t, "namespace 'deleted' doesn't exist", orphan.Reason)
+
+	require.NoError(t, orphan.Prune(ctx))
+	vs, err := ic.NetworkingV1beta1().VirtualServices("staging").Get(ctx, "frontend", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, vs.Annotations, "divert.okteto.com/def")
+	assert.Contains(t, vs.Annotations, "divert.okteto.com/abc")
+}
+
+func TestStatusReaderGateway(t *testing.T) {
+	ctx := context.Background()
+	c := k8sfake.NewClientset(
+		&apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cindy"}},
+		&apiv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "cindy"}},
+	)
+	divertedRoute := func(name, source, namespace string, services ...string) *gatewayv1.HTTPRoute {
+		ns := gatewayv1.Namespace(namespace)
+		route := &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   "staging",
+				Labels:      map[string]string{gateway.DivertedNamespaceLabel: namespace},
+				Annotations: map[string]string{gateway.DivertedHTTPRouteAnnotation: source},
+			},
+		}
+		for _, service := range services {
+			route.Spec.Rules = append(route.Spec.Rules, gatewayv1.HTTPRouteRule{
+				Matches: []gatewayv1.HTTPRouteMatch{
+					{Headers: []gatewayv1.HTTPHeaderMatch{{Name: constants.OktetoDivertBaggageHeader, Value: fmt.Sprintf("^.*okteto-divert=%s(,.*)?$", namespace)}}},
+				},
+				BackendRefs: []gatewayv1.HTTPBackendRef{
+					{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: gatewayv1.ObjectName(service), Namespace: &ns}}},
+				},
+			})
+		}
+		return route
+	}
+	gc := gatewayfake.NewSimpleClientset(
+		&gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "movies", Namespace: "staging"},
+			Spec: gatewayv1.HTTPRouteSpec{
+				Rules: []gatewayv1.HTTPRouteRule{
+					{BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: gatewayv1.BackendRef{BackendObjectReference: gatewayv1.BackendObjectReference{Name: "api"}}}}},
+				},
+			},
+		},
+		divertedRoute("movies-okteto-divert-cindy", "movies", "cindy", "api", "worker"),
+		divertedRoute("admin-okteto-divert-cindy", "admin", "cindy", "api"),
+		divertedRoute("movies-okteto-divert-deleted", "movies", "deleted", "api"),
+	)
+
+	r := NewStatusReader(c, nil, nil, gc)
+	statuses, err := r.List(ctx, "staging", []string{"cindy"})
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+	assert.Equal(t, "cindy", statuses[0].Namespace)
+	assert.Equal(t, "admin", statuses[0].Name)
+	assert.False(t, statuses[0].Healthy)
+	assert.Equal(t, "HTTPRoute 'admin' doesn't exist in namespace 'staging'", statuses[0].Reason)
+	assert.Equal(t, "cindy", statuses[1].Namespace)
+	assert.Equal(t, "movies", statuses[1].Name)
+	assert.Equal(t, "baggage: okteto-divert=cindy", statuses[1].Header)
+	assert.False(t, statuses[1].Healthy)
+	assert.Equal(t, "service 'worker' doesn't exist in namespace 'cindy'", statuses[1].Reason)
+	assert.Equal(t, "deleted", statuses[2].Namespace)
+	assert.True(t, statuses[2].Orphan)
+
+	require.NoError(t, statuses[2].Prune(ctx))
+	_, err = gc.GatewayV1().HTTPRoutes("staging").Get(ctx, "movies-okteto-divert-deleted", metav1.GetOptions{})
+	assert.True(t, k8sErrors.IsNotFound(err))
+	_, err = gc.GatewayV1().HTTPRoutes("staging").Get(ctx, "movies", metav1.GetOptions{})
+	assert.NoError(t, err)
+}
+
+func TestStatusReaderNamespaceExists(t *testing.T) {
+	ctx := context.Background()
+	c := k8sfake.NewClientset()
+	c.PrependReactor("get", "namespaces", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, k8sErrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "cindy", errors.New("forbidden"))
+	})
+	r := NewStatusReader(c, nil, nil, nil)
+
+	// the namespaces of other developers can't be read, but they exist
+	assert.True(t, r.namespaceExists(ctx, "cindy"))
+
+	c = k8sfake.NewClientset(&apiv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "cindy"}})
+	r = NewStatusReader(c, nil, nil, nil)
+	assert.True(t, r.namespaceExists(ctx, "cindy"))
+	assert.False(t, r.namespaceExists(ctx, "deleted"))
+}
@@ -116,8 +116,8 @@ var (
 	// ErrDevPodDeleted raised if dev pod is deleted in the middle of the "okteto up" sequence
 	ErrDevPodDeleted = fmt.Errorf("development container has been removed")
 
-	// ErrDivertNotSupported raised if the divert feature is not supported in the current cluster
-	ErrDivertNotSupported = fmt.Errorf("the 'divert' field is only supported in contexts that have Okteto installed")
+	// ErrDivertNotSupported raised if the divert driver is not supported in the current cluster
+	ErrDivertNotSupported = fmt.Errorf("the 'nginx' divert driver is only supported in contexts that have Okteto installed. Use the 'istio' or 'gateway' drivers instead")
 
 	// ErrContextIsNotOktetoCluster raised if the cluster connected is not managed by okteto
 	ErrContextIsNotOktetoCluster = UserError{
@@ -21,6 +21,7 @@ import (
 	"io"
 	"net/http"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/okteto/okteto/pkg/k8s/labels"
@@ -45,8 +46,10 @@ type PortForwardManager struct {
 	activeDev      *active
 	activeServices map[string]*active
 	restConfig     *rest.Config
+	tracker        *forward.StatusTracker
 	iface          string
 	namespace      string
+	lock           sync.Mutex
 	stopped        bool
 }
 
@@ -94,6 +97,10 @@ func NewPortForwardManager(ctx context.Context, iface string, restConfig *rest.C
 
 // Add initializes a port forward
 func (p *PortForwardManager) Add(f forward.Forward) error {
+	if f.IsUDP() || f.IsSocket() {
+		return fmt.Errorf("forward '%s' requires the SSH tunnel to your development container: Kubernetes port-forwards only support TCP ports", f.String())
+	}
+
 	if _, ok := p.ports[f.Local]; ok {
 		return fmt.Errorf("port %d is listed multiple times, please check your configuration", f.Local)
 	}
@@ -112,6 +119,8 @@ func (p *PortForwardManager) Add(f forward.Forward) error {
 		return fmt.Errorf("local port %d is already in-use in your local machine", f.Local)
 	}
 
+	p.lock.Lock()
+	defer p.lock.Unlock()
 	p.ports[f.Local] = f
 	if f.Service {
 		p.services[f.ServiceName] = struct{}{}
@@ -120,9 +129,28 @@ func (p *PortForwardManager) Add(f forward.Forward) error {
 	return nil
 }
 
-// StartGlobalForwarding is not implemented
-func (*PortForwardManager) StartGlobalForwarding() error {
-	return fmt.Errorf("not implemented")
+// SetStatusTracker sets the tracker that receives the health of the global forwards
+func (p *PortForwardManager) SetStatusTracker(tracker *forward.StatusTracker) {
+	p.tracker = tracker
+}
+
+// StartGlobalForwarding starts the port-forwards to the services added since Start
+func (p *PortForwardManager) StartGlobalForwarding() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.activeServices == nil {
+		return fmt.Errorf("k8s port-forwards aren't started")
+	}
+
+	for svc := range p.services {
+		if _, ok := p.activeServices[svc]; ok {
+			continue
+		}
+		p.activeServices[svc] = nil
+		go p.forwardService(p.ctx, p.namespace, svc)
+	}
+
+	return nil
 }
 
 // AddReverse is not implemented
@@ -130,9 +158,16 @@ func (*PortForwardManager) AddReverse(_ model.Reverse) error {
 	return fmt.Errorf("not implemented")
 }
 
+// AddSocks is not implemented
+func (*PortForwardManager) AddSocks(_ int) error {
+	return fmt.Errorf("not implemented")
+}
+
 // Start starts all the port forwarders to the development container
 func (p *PortForwardManager) Start(devPod, namespace string) error {
+	p.lock.Lock()
 	p.stopped = false
+	p.lock.Unlock()
 	a, devPF, err := p.buildForwarderToDevPod(namespace, devPod)
 	if err != nil {
 		return fmt.Errorf("failed to k8s forward to development container: %w", err)
@@ -152,10 +187,13 @@ func (p *PortForwardManager) Start(devPod, namespace string) error {
 		}
 	}()
 
+	p.lock.Lock()
 	p.activeServices = map[string]*active{}
 	for svc := range p.services {
+		p.activeServices[svc] = nil
 		go p.forwardService(p.ctx, namespace, svc)
 	}
+	p.lock.Unlock()
 
 	<-p.activeDev.readyChan
 
@@ -169,6 +207,8 @@ func (p *PortForwardManager) Start(devPod, namespace string) error {
 
 // Stop stops all the port forwarders
 func (p *PortForwardManager) Stop() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
 	p.stopped = true
 	p.activeDev.stop()
 
@@ -244,7 +284,9 @@ func (p *PortForwardManager) buildForwarderToService(ctx context.Context, namesp
 		return nil, nil, fmt.Errorf("failed to get pod mapped to service/%s: %w", svc.GetName(), err)
 	}
 
+	p.lock.Lock()
 	ports := getServicePorts(svc.GetName(), p.ports)
+	p.lock.Unlock()
 	return p.buildForwarder(pod.GetNamespace(), pod.GetName(), ports)
 }
 
@@ -279,31 +321,148 @@ func (p *PortForwardManager) buildDialer(namespace, pod string) (httpstream.Dial
 	return spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url), nil
 }
 
+// forwardService keeps a port-forward to a pod of the service. When the pod goes away, it looks up the backing pod
+// again, and the service by its labels, retrying with backoff
 func (p *PortForwardManager) forwardService(ctx context.Context, namespace, service string) {
-	t := time.NewTicker(3 * time.Second)
-
+	retries := 0
 	for {
-		if p.stopped {
+		if p.isStopped() {
 			return
 		}
 
 		oktetoLog.Infof("k8s forwarding ports for service/%s", service)
 		a, pf, err := p.buildForwarderToService(ctx, namespace, service)
-		if err != nil {
-			oktetoLog.Infof("failed to k8s forward ports to service/%s: %s", service, err)
-			<-t.C
-			continue
+		if err == nil && !p.setActiveService(service, a) {
+			return
 		}
 
-		if err := pf.ForwardPorts(); err != nil {
-			oktetoLog.Infof("k8s forwarding to service/%s finished with errors: %s", service, err)
+		if err == nil {
+			ready := a.readyChan
+			done := make(chan struct{})
+			go func() {
+				select {
+				case <-ready:
+					p.setServiceStatus(service, forward.StateConnected, 0, nil)
+				case <-done:
+				}
+			}()
+
+			err = pf.ForwardPorts()
+			close(done)
+			p.lock.Lock()
 			a.stop()
-		} else {
-			oktetoLog.Infof("k8s forwarding to service/%s finished", service)
+			p.lock.Unlock()
+			if isClosed(ready) {
+				retries = 0
+			}
+			if err == nil {
+				err = fmt.Errorf("k8s forwarding to service/%s finished", service)
+			}
+		}
+
+		if p.isStopped() {
+			return
+		}
+
+		retries++
+		oktetoLog.Infof("failed to k8s forward ports to service/%s, attempt %d: %s", service, retries, err)
+		p.setServiceStatus(service, forward.StateAfter(retries), retries, err)
+
+		select {
+		case <-time.After(forward.Backoff(retries)):
+		case <-ctx.Done():
+			return
 		}
 
-		<-t.C
+		service = p.resolveService(service)
+	}
+}
+
+func isClosed(c chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *PortForwardManager) isStopped() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.stopped
+}
+
+// setActiveService keeps a, so Stop can stop it. It returns false if the manager was stopped in the meantime
+func (p *PortForwardManager) setActiveService(service string, a *active) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.stopped || p.activeServices == nil {
+		a.stop()
+		return false
+	}
+	p.activeServices[service] = a
+	return true
+}
+
+// setServiceStatus updates the health of the global forwards to the service
+func (p *PortForwardManager) setServiceStatus(service string, state forward.State, retries int, err error) {
+	p.lock.Lock()
+	forwards := []forward.Forward{}
+	for _, f := range p.ports {
+		if f.IsGlobal && f.ServiceName == service {
+			forwards = append(forwards, f)
+		}
+	}
+	p.lock.Unlock()
+
+	for _, f := range forwards {
+		p.tracker.Set(f, state, retries, err)
+	}
+}
+
+// resolveService looks up the service of the forwards by their labels, in case it was recreated with a different name
+func (p *PortForwardManager) resolveService(service string) string {
+	p.lock.Lock()
+	var selector map[string]string
+	for _, f := range p.ports {
+		if f.Service && f.ServiceName == service && f.Labels != nil {
+			selector = f.Labels
+			break
+		}
+	}
+	p.lock.Unlock()
+
+	if selector == nil {
+		return service
+	}
+
+	name, err := p.GetServiceNameByLabel(p.namespace, selector)
+	if err != nil {
+		oktetoLog.Infof("failed to resolve the labels of the forwards to service/%s: %s", service, err)
+		return service
+	}
+
+	if name == service {
+		return service
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	oktetoLog.Infof("k8s forwards to service/%s now target service/%s", service, name)
+	for port, f := range p.ports {
+		if f.Service && f.ServiceName == service {
+			f.ServiceName = name
+			p.ports[port] = f
+		}
+	}
+	delete(p.services, service)
+	p.services[name] = struct{}{}
+	if p.activeServices != nil {
+		delete(p.activeServices, service)
+		p.activeServices[name] = nil
 	}
+	return name
 }
 
 func (p *PortForwardManager) GetServiceNameByLabel(namespace string, labelsMap map[string]string) (string, error) {
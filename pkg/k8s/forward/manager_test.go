@@ -15,12 +15,16 @@ package forward
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"sort"
 	"testing"
 
 	"github.com/okteto/okteto/pkg/model"
 	"github.com/okteto/okteto/pkg/model/forward"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestAdd(t *testing.T) {
@@ -42,6 +46,14 @@ func TestAdd(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	if err := pf.Add(forward.Forward{Local: 10130, Remote: 53, Protocol: forward.ProtocolUDP}); err == nil {
+		t.Fatal("UDP forward didn't return an error")
+	}
+
+	if err := pf.Add(forward.Forward{LocalSocket: "/tmp/docker.sock", Remote: 2375}); err == nil {
+		t.Fatal("socket forward didn't return an error")
+	}
+
 	if len(pf.ports) != 3 {
 		t.Fatalf("expected 3 ports but got %d", len(pf.ports))
 	}
@@ -184,3 +196,65 @@ func Test_getServicePorts(t *testing.T) {
 		})
 	}
 }
+
+func TestStartGlobalForwardingBeforeStart(t *testing.T) {
+	pf := NewPortForwardManager(context.Background(), model.Localhost, nil, nil, "")
+	if err := pf.StartGlobalForwarding(); err == nil {
+		t.Fatal("global forwarding started before the port-forwards")
+	}
+}
+
+func Test_setServiceStatus(t *testing.T) {
+	pf := NewPortForwardManager(context.Background(), model.Localhost, nil, nil, "")
+	statuses := map[int]forward.State{}
+	pf.SetStatusTracker(forward.NewStatusTracker(func(_ forward.State, current forward.Status) {
+		statuses[current.Local] = current.State
+	}))
+	pf.ports = map[int]forward.Forward{
+		8080: {Local: 8080, Remote: 80, Service: true, ServiceName: "api", IsGlobal: true},
+		8081: {Local: 8081, Remote: 81, Service: true, ServiceName: "api", IsGlobal: true},
+		5432: {Local: 5432, Remote: 5432, Service: true, ServiceName: "db", IsGlobal: true},
+		9090: {Local: 9090, Remote: 90, Service: true, ServiceName: "api"},
+	}
+
+	pf.setServiceStatus("api", forward.StateReconnecting, 1, errors.New("lost connection to pod"))
+
+	expected := map[int]forward.State{8080: forward.StateReconnecting, 8081: forward.StateReconnecting}
+	if !reflect.DeepEqual(statuses, expected) {
+		t.Errorf("Expected: %+v, Got: %+v", expected, statuses)
+	}
+}
+
+func Test_resolveService(t *testing.T) {
+	client := fake.NewSimpleClientset(&apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api-v2",
+			Namespace: "test",
+			Labels:    map[string]string{"app": "api"},
+		},
+	})
+	pf := NewPortForwardManager(context.Background(), model.Localhost, nil, client, "test")
+	pf.ports = map[int]forward.Forward{
+		8080: {Local: 8080, Remote: 80, Service: true, ServiceName: "api", Labels: map[string]string{"app": "api"}, IsGlobal: true},
+		5432: {Local: 5432, Remote: 5432, Service: true, ServiceName: "db", IsGlobal: true},
+	}
+	pf.services = map[string]struct{}{"api": {}, "db": {}}
+	pf.activeServices = map[string]*active{"api": nil, "db": nil}
+
+	if got := pf.resolveService("db"); got != "db" {
+		t.Errorf("service without labels was resolved to '%s'", got)
+	}
+
+	if got := pf.resolveService("api"); got != "api-v2" {
+		t.Fatalf("expected 'api-v2', got '%s'", got)
+	}
+	if pf.ports[8080].ServiceName != "api-v2" {
+		t.Errorf("forward wasn't updated: %+v", pf.ports[8080])
+	}
+	if _, ok := pf.services["api"]; ok {
+		t.Errorf("service/api wasn't removed: %+v", pf.services)
+	}
+	if _, ok := pf.activeServices["api-v2"]; !ok {
+		t.Errorf("service/api-v2 isn't active: %+v", pf.activeServices)
+	}
+}
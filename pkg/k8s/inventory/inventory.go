@@ -0,0 +1,248 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/okteto/okteto/pkg/compress"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Operation is the change made by a deploy to a resource
+type Operation string
+
+const (
+	// Created the resource didn't exist before the deploy
+	Created Operation = "created"
+
+	// Updated the resource existed and the deploy modified it
+	Updated Operation = "updated"
+
+	// Unchanged the resource existed and the deploy didn't modify it
+	Unchanged Operation = "unchanged"
+)
+
+// Resource is a Kubernetes object created or applied during a deploy
+type Resource struct {
+	Group           string    `json:"group,omitempty"`
+	Version         string    `json:"version"`
+	Kind            string    `json:"kind"`
+	Resource        string    `json:"resource"`
+	Namespace       string    `json:"namespace,omitempty"`
+	Name            string    `json:"name"`
+	UID             string    `json:"uid"`
+	ResourceVersion string    `json:"resourceVersion,omitempty"`
+	Generation      int64     `json:"generation,omitempty"`
+	Command         string    `json:"command,omitempty"`
+	Operation       Operation `json:"operation,omitempty"`
+}
+
+// GroupVersionResource returns the group, version and resource of the API of the resource
+func (r Resource) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Resource}
+}
+
+// String returns the resource with the kubectl notation, like 'deployment.apps/api'
+func (r Resource) String() string {
+	kind := strings.ToLower(r.Kind)
+	if kind == "" {
+		kind = r.Resource
+	}
+	if r.Group != "" {
+		kind = fmt.Sprintf("%s.%s", kind, r.Group)
+	}
+	return fmt.Sprintf("%s/%s", kind, r.Name)
+}
+
+// sameVersion returns true if a and b are the same version of an object. The generation only changes when the
+// spec of an object changes, while the resource version also changes with every update of its status
+func sameVersion(a, b Resource) bool {
+	if a.Generation != 0 && b.Generation != 0 {
+		return a.Generation == b.Generation
+	}
+	return a.ResourceVersion == b.ResourceVersion
+}
+
+func (r Resource) key() string {
+	return strings.Join([]string{r.Group, r.Resource, r.Namespace, r.Name}, "/")
+}
+
+// Inventory keeps the resources created or applied during a deploy. It is safe for concurrent use.
+// A nil Inventory ignores all the updates
+type Inventory struct {
+	previous  map[string]Resource
+	resources map[string]Resource
+	removed   map[string]bool
+	command   string
+	lock      sync.Mutex
+}
+
+// New returns an Inventory for a deploy, where previous is the inventory of the last deploy
+func New(previous []Resource) *Inventory {
+	inv := &Inventory{
+		previous:  map[string]Resource{},
+		resources: map[string]Resource{},
+		removed:   map[string]bool{},
+	}
+	for _, r := range previous {
+		inv.previous[r.key()] = r
+	}
+	return inv
+}
+
+// SetCommand sets the name of the deploy command that is running, recorded as the creator of the new resources
+func (i *Inventory) SetCommand(name string) {
+	if i == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.command = name
+}
+
+// Record adds a resource returned by the cluster after a create or apply request
+func (i *Inventory) Record(r Resource, created bool) {
+	if i == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	k := r.key()
+	current, applied := i.resources[k]
+	applied = applied && current.UID == r.UID
+	prev, seen := i.previous[k]
+	seen = seen && prev.UID == r.UID
+
+	switch {
+	case created:
+		r.Operation = Created
+	case applied:
+		// an object applied several times during the same deploy keeps the most relevant operation
+		r.Operation = current.Operation
+		if current.Operation == Unchanged && !sameVersion(current, r) {
+			r.Operation = Updated
+		}
+	case seen && sameVersion(prev, r):
+		r.Operation = Unchanged
+	default:
+		r.Operation = Updated
+	}
+
+	switch {
+	case applied:
+		r.Command = current.Command
+	case seen && !created && prev.Command != "":
+		r.Command = prev.Command
+	default:
+		r.Command = i.command
+	}
+
+	i.resources[k] = r
+	delete(i.removed, k)
+}
+
+// Observe adds a resource of the deploy read during the deploy. kubectl and helm read the objects before applying
+// them and don't send any other request when there are no changes, so the objects only read are recorded as unchanged
+func (i *Inventory) Observe(r Resource) {
+	if i == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	k := r.key()
+	if current, ok := i.resources[k]; ok && current.UID == r.UID {
+		return
+	}
+
+	r.Operation = Unchanged
+	r.Command = i.command
+	if prev, ok := i.previous[k]; ok && prev.UID == r.UID && prev.Command != "" {
+		r.Command = prev.Command
+	}
+	i.resources[k] = r
+	delete(i.removed, k)
+}
+
+// Remove removes a resource deleted during the deploy
+func (i *Inventory) Remove(r Resource) {
+	if i == nil {
+		return
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	k := r.key()
+	delete(i.resources, k)
+	i.removed[k] = true
+}
+
+// Resources returns the resources recorded during the deploy, sorted by namespace and name.
+// Resources of the previous deploy that weren't deleted are kept as unchanged
+func (i *Inventory) Resources() []Resource {
+	if i == nil {
+		return nil
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	result := make([]Resource, 0, len(i.resources)+len(i.previous))
+	for _, r := range i.resources {
+		result = append(result, r)
+	}
+	for k, r := range i.previous {
+		if _, ok := i.resources[k]; ok || i.removed[k] {
+			continue
+		}
+		r.Operation = Unchanged
+		result = append(result, r)
+	}
+
+	sort.Slice(result, func(a, b int) bool {
+		if result[a].Namespace != result[b].Namespace {
+			return result[a].Namespace < result[b].Namespace
+		}
+		return result[a].String() < result[b].String()
+	})
+	return result
+}
+
+// Encode returns the resources as gzipped JSON encoded in base64. Inventories are stored in the
+// ConfigMap of the dev environment next to its logs, so they are compressed to stay far from the size limit
+func Encode(resources []Resource) (string, error) {
+	return compress.EncodeJSON(resources)
+}
+
+// Decode returns the resources of an inventory encoded by Encode
+func Decode(encoded string) ([]Resource, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	var resources []Resource
+	if err := compress.DecodeJSON(encoded, &resources); err != nil {
+		return nil, fmt.Errorf("invalid inventory: %w", err)
+	}
+	return resources, nil
+}
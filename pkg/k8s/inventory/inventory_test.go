@@ -0,0 +1,212 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	apiDeployment = Resource{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "test", Name: "api", UID: "1", ResourceVersion: "10"}
+	apiService    = Resource{Version: "v1", Kind: "Service", Resource: "services", Namespace: "test", Name: "api", UID: "2", ResourceVersion: "11"}
+	dbSecret      = Resource{Version: "v1", Kind: "Secret", Resource: "secrets", Namespace: "test", Name: "db", UID: "3", ResourceVersion: "12"}
+)
+
+func withOperation(r Resource, command string, op Operation) Resource {
+	r.Command = command
+	r.Operation = op
+	return r
+}
+
+func TestInventoryRecord(t *testing.T) {
+	previous := []Resource{
+		withOperation(apiDeployment, "helm", Created),
+		withOperation(apiService, "helm", Created),
+		withOperation(dbSecret, "kubectl", Created),
+	}
+
+	// kubectl doesn't send a request when nothing changes, so the configmap is only in the previous inventory
+	cm := Resource{Version: "v1", Kind: "ConfigMap", Resource: "configmaps", Namespace: "test", Name: "settings", UID: "5", ResourceVersion: "13", Command: "kubectl"}
+	inv := New(append(previous, cm))
+	inv.SetCommand("deploy api")
+
+	// applied without changes
+	inv.Record(apiService, false)
+
+	// applied with changes, and then applied again without changes
+	updated := apiDeployment
+	updated.ResourceVersion = "20"
+	inv.Record(updated, false)
+	inv.Record(updated, false)
+
+	// created and then patched by the next command
+	worker := Resource{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "test", Name: "worker", UID: "4", ResourceVersion: "21"}
+	inv.Record(worker, true)
+	inv.SetCommand("scale worker")
+	patched := worker
+	patched.ResourceVersion = "22"
+	inv.Record(patched, false)
+
+	// the secret of the previous deploy was deleted
+	inv.Remove(Resource{Version: "v1", Resource: "secrets", Namespace: "test", Name: "db"})
+
+	expected := []Resource{
+		withOperation(cm, "kubectl", Unchanged),
+		withOperation(updated, "helm", Updated),
+		withOperation(patched, "deploy api", Created),
+		withOperation(apiService, "helm", Unchanged),
+	}
+	assert.Equal(t, expected, inv.Resources())
+}
+
+func TestInventoryRecordRecreated(t *testing.T) {
+	inv := New([]Resource{withOperation(apiDeployment, "helm", Created)})
+	inv.SetCommand("kubectl")
+
+	// same name, but it is a different object
+	recreated := apiDeployment
+	recreated.UID = "100"
+	inv.Record(recreated, false)
+
+	assert.Equal(t, []Resource{withOperation(recreated, "kubectl", Updated)}, inv.Resources())
+}
+
+func TestInventoryObserve(t *testing.T) {
+	inv := New([]Resource{withOperation(apiService, "helm", Created)})
+	inv.SetCommand("kubectl")
+
+	// read and then applied without changes, reporting the status of the deployment
+	deployment := apiDeployment
+	deployment.Generation = 1
+	inv.Observe(deployment)
+	applied := deployment
+	applied.ResourceVersion = "15"
+	inv.Record(applied, false)
+
+	// read and then applied with changes
+	inv.Observe(apiService)
+	updated := apiService
+	updated.ResourceVersion = "16"
+	inv.Record(updated, false)
+	inv.Observe(updated)
+
+	expected := []Resource{
+		withOperation(applied, "kubectl", Unchanged),
+		withOperation(updated, "helm", Updated),
+	}
+	assert.Equal(t, expected, inv.Resources())
+}
+
+func TestEncodeDecode(t *testing.T) {
+	resources := []Resource{
+		withOperation(apiDeployment, "helm", Created),
+		withOperation(apiService, "helm", Unchanged),
+	}
+	encoded, err := Encode(resources)
+	require.NoError(t, err)
+
+	decoded, err := Decode(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, resources, decoded)
+
+	decoded, err = Decode("")
+	require.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	_, err = Decode("not an inventory")
+	assert.Error(t, err)
+}
+
+func TestResourceString(t *testing.T) {
+	assert.Equal(t, "deployment.apps/api", apiDeployment.String())
+	assert.Equal(t, "service/api", apiService.String())
+	assert.Equal(t, "services/api", Resource{Resource: "services", Name: "api"}.String())
+}
+
+func TestDeletionOrder(t *testing.T) {
+	external := Resource{Group: "dev.okteto.com", Version: "v1", Kind: "External", Resource: "externals", Namespace: "test", Name: "db"}
+	pvc := Resource{Version: "v1", Kind: "PersistentVolumeClaim", Resource: "persistentvolumeclaims", Namespace: "test", Name: "data"}
+	worker := Resource{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: "test", Name: "worker"}
+
+	got := DeletionOrder([]Resource{dbSecret, pvc, apiService, apiDeployment, external, worker})
+	expected := [][]Resource{
+		{external},
+		{apiDeployment, worker},
+		{apiService},
+		{pvc},
+		{dbSecret},
+	}
+	assert.Equal(t, expected, got)
+	assert.Empty(t, DeletionOrder(nil))
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected Resource
+		ok       bool
+	}{
+		{
+			name:     "namespaced core resource",
+			path:     "/api/v1/namespaces/test/services/api",
+			expected: Resource{Version: "v1", Resource: "services", Namespace: "test", Name: "api"},
+			ok:       true,
+		},
+		{
+			name:     "collection",
+			path:     "/apis/apps/v1/namespaces/test/deployments",
+			expected: Resource{Group: "apps", Version: "v1", Resource: "deployments", Namespace: "test"},
+			ok:       true,
+		},
+		{
+			name:     "cluster scoped resource",
+			path:     "/apis/rbac.authorization.k8s.io/v1/clusterroles/admin",
+			expected: Resource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles", Name: "admin"},
+			ok:       true,
+		},
+		{
+			name:     "namespace",
+			path:     "/api/v1/namespaces/test",
+			expected: Resource{Version: "v1", Resource: "namespaces", Name: "test"},
+			ok:       true,
+		},
+		{
+			name: "subresource",
+			path: "/apis/apps/v1/namespaces/test/deployments/api/scale",
+		},
+		{
+			name: "namespace subresource",
+			path: "/api/v1/namespaces/test/finalize",
+		},
+		{
+			name: "discovery",
+			path: "/apis/apps/v1",
+		},
+		{
+			name: "not an API path",
+			path: "/version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParsePath(tt.path)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
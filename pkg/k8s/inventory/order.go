@@ -0,0 +1,89 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import "sort"
+
+// installOrder is the order in which the kinds depend on each other, the same one used by Helm to install a chart
+var installOrder = []string{
+	"Namespace",
+	"NetworkPolicy",
+	"ResourceQuota",
+	"LimitRange",
+	"PodSecurityPolicy",
+	"PodDisruptionBudget",
+	"ServiceAccount",
+	"Secret",
+	"ConfigMap",
+	"StorageClass",
+	"PersistentVolume",
+	"PersistentVolumeClaim",
+	"CustomResourceDefinition",
+	"ClusterRole",
+	"ClusterRoleBinding",
+	"Role",
+	"RoleBinding",
+	"Service",
+	"DaemonSet",
+	"Pod",
+	"ReplicationController",
+	"ReplicaSet",
+	"Deployment",
+	"HorizontalPodAutoscaler",
+	"StatefulSet",
+	"Job",
+	"CronJob",
+	"IngressClass",
+	"Ingress",
+	"APIService",
+}
+
+var installRank = func() map[string]int {
+	result := make(map[string]int, len(installOrder))
+	for i, kind := range installOrder {
+		result[kind] = i
+	}
+	return result
+}()
+
+// rank returns the position of the kind in installOrder. Unknown kinds, like custom resources, are installed last
+func rank(kind string) int {
+	if r, ok := installRank[kind]; ok {
+		return r
+	}
+	return len(installOrder)
+}
+
+// DeletionOrder groups the resources in the order they have to be deleted: the reverse of installOrder,
+// so workloads are deleted before the services, config and volumes they use. The resources of a group
+// don't depend on each other and can be deleted in parallel
+func DeletionOrder(resources []Resource) [][]Resource {
+	byRank := map[int][]Resource{}
+	for _, r := range resources {
+		k := rank(r.Kind)
+		byRank[k] = append(byRank[k], r)
+	}
+
+	ranks := make([]int, 0, len(byRank))
+	for k := range byRank {
+		ranks = append(ranks, k)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(ranks)))
+
+	result := make([][]Resource, 0, len(ranks))
+	for _, k := range ranks {
+		result = append(result, byRank[k])
+	}
+	return result
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import "strings"
+
+// namespaceSubresources are the subresources of the namespace objects, the same way the API server resolves them
+var namespaceSubresources = map[string]bool{
+	"status":   true,
+	"finalize": true,
+}
+
+// ParsePath returns the resource addressed by the path of a request to the Kubernetes API, like
+// '/apis/apps/v1/namespaces/test/deployments/api'. The Kind, UID and ResourceVersion of the resource are empty.
+// It returns false if the path doesn't address a resource or it addresses one of its subresources, like 'status'
+func ParsePath(path string) (Resource, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	r := Resource{}
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		r.Version = parts[1]
+		parts = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		r.Group = parts[1]
+		r.Version = parts[2]
+		parts = parts[3:]
+	default:
+		return Resource{}, false
+	}
+
+	if len(parts) == 0 || parts[0] == "" {
+		return Resource{}, false
+	}
+
+	if parts[0] == "namespaces" && len(parts) > 1 {
+		r.Namespace = parts[1]
+		if len(parts) > 2 && !namespaceSubresources[parts[2]] {
+			parts = parts[2:]
+		}
+	}
+
+	r.Resource = parts[0]
+	if len(parts) > 1 {
+		r.Name = parts[1]
+	}
+	if len(parts) > 2 {
+		return Resource{}, false
+	}
+
+	if r.Group == "" && r.Resource == "namespaces" {
+		// namespaces are cluster scoped
+		r.Namespace = ""
+	}
+	return r, true
+}
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	"github.com/okteto/okteto/pkg/k8s/statefulsets"
 	"github.com/okteto/okteto/pkg/k8s/volumes"
 	oktetoLog "github.com/okteto/okteto/pkg/log"
@@ -26,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -184,6 +186,78 @@ func (n *Namespaces) DestroyWithLabel(ctx context.Context, ns string, opts Delet
 	}))
 }
 
+// DestroyInventory deletes the resources recorded in the inventory of a deploy that belong to the namespace ns.
+// Resources are deleted in dependency order, without discovering every API of the cluster like DestroyWithLabel.
+// Resources that don't match opts.LabelSelector anymore are skipped, as they are no longer managed by the deploy
+func (n *Namespaces) DestroyInventory(ctx context.Context, ns string, resources []inventory.Resource, opts DeleteAllOptions) error {
+	selector, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range inventory.DeletionOrder(resources) {
+		eg, egCtx := errgroup.WithContext(ctx)
+		eg.SetLimit(int(parallelism))
+		for _, r := range group {
+			if r.Namespace != ns {
+				continue
+			}
+			r := r
+			eg.Go(func() error {
+				return n.destroyResource(egCtx, r, selector, opts)
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Namespaces) destroyResource(ctx context.Context, r inventory.Resource, selector labels.Selector, opts DeleteAllOptions) error {
+	if isStorage(r.Kind) && !opts.IncludeVolumes {
+		oktetoLog.Debugf("skipping deletion of '%s' because of volume flag", r)
+		return nil
+	}
+
+	client := n.dynClient.Resource(r.GroupVersionResource()).Namespace(r.Namespace)
+	obj, err := client.Get(ctx, r.Name, metav1.GetOptions{})
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			oktetoLog.Debugf("'%s' was already deleted", r)
+			return nil
+		}
+		return fmt.Errorf("error getting '%s': %w", r, err)
+	}
+
+	if !selector.Matches(labels.Set(obj.GetLabels())) {
+		oktetoLog.Debugf("skipping deletion of '%s' because it wasn't deployed by '%s'", r, opts.LabelSelector)
+		return nil
+	}
+	if obj.GetAnnotations()[resourcePolicyAnnotation] == keepPolicy {
+		oktetoLog.Debugf("skipping deletion of '%s' because of policy annotation", r)
+		return nil
+	}
+
+	// the precondition avoids deleting an object recreated since it was read
+	uid := obj.GetUID()
+	deleteOpts := metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &uid},
+	}
+	if r.Kind == jobKind {
+		deletePropagation := metav1.DeletePropagationBackground
+		deleteOpts.PropagationPolicy = &deletePropagation
+	}
+
+	if err := client.Delete(ctx, r.Name, deleteOpts); err != nil && !k8sErrors.IsNotFound(err) {
+		oktetoLog.Debugf("error deleting '%s': %s", r, err)
+		return fmt.Errorf("error deleting '%s': %w", r, err)
+	}
+
+	oktetoLog.Debugf("successfully deleted '%s'", r)
+	return nil
+}
+
 // DestroySFSVolumes This function deletes volumes for any statefulset that matches with opts.LabelSelector but it doesn't have any
 // dev.okteto.com/deployed-by label. This is to avoid to left PVCs behind when everything deployed with okteto deploy
 // command is deleted
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	openapi_v2 "github.com/google/gnostic-models/openapiv2"
+	"github.com/okteto/okteto/pkg/k8s/inventory"
 	"github.com/okteto/okteto/pkg/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -566,3 +567,68 @@ func Test_wander(t *testing.T) {
 		})
 	}
 }
+
+func TestDestroyInventory(t *testing.T) {
+	ctx := context.Background()
+	ns := "test"
+	deployedBy := map[string]string{model.DeployedByLabel: "app"}
+
+	objects := []runtime.Object{
+		&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: ns, Labels: deployedBy, UID: "1"},
+		},
+		&apiv1.Service{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "api",
+				Namespace:   ns,
+				Labels:      deployedBy,
+				Annotations: map[string]string{resourcePolicyAnnotation: keepPolicy},
+			},
+		},
+		&apiv1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: ns, Labels: map[string]string{model.DeployedByLabel: "other"}},
+		},
+		&apiv1.PersistentVolumeClaim{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+			ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: ns, Labels: deployedBy},
+		},
+		&apiv1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "other", Labels: deployedBy},
+		},
+	}
+	resources := []inventory.Resource{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Resource: "deployments", Namespace: ns, Name: "api"},
+		{Version: "v1", Kind: "Service", Resource: "services", Namespace: ns, Name: "api"},
+		{Version: "v1", Kind: "ConfigMap", Resource: "configmaps", Namespace: ns, Name: "settings"},
+		{Version: "v1", Kind: "PersistentVolumeClaim", Resource: "persistentvolumeclaims", Namespace: ns, Name: "data"},
+		{Version: "v1", Kind: "Secret", Resource: "secrets", Namespace: "other", Name: "db"},
+		{Version: "v1", Kind: "Secret", Resource: "secrets", Namespace: ns, Name: "already-deleted"},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, apiv1.AddToScheme(scheme))
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme, objects...)
+	n := &Namespaces{dynClient: dynamicClient}
+
+	err := n.DestroyInventory(ctx, ns, resources, DeleteAllOptions{LabelSelector: fmt.Sprintf("%s=app", model.DeployedByLabel)})
+	require.NoError(t, err)
+
+	exists := func(r inventory.Resource) bool {
+		_, err := dynamicClient.Resource(r.GroupVersionResource()).Namespace(r.Namespace).Get(ctx, r.Name, metav1.GetOptions{})
+		return err == nil
+	}
+	assert.False(t, exists(resources[0]), "deployment wasn't deleted")
+	assert.True(t, exists(resources[1]), "service with keep policy was deleted")
+	assert.True(t, exists(resources[2]), "configmap of another deploy was deleted")
+	assert.True(t, exists(resources[3]), "volume was deleted without the volumes option")
+	assert.True(t, exists(resources[4]), "secret of another namespace was deleted")
+
+	err = n.DestroyInventory(ctx, ns, resources, DeleteAllOptions{LabelSelector: fmt.Sprintf("%s=app", model.DeployedByLabel), IncludeVolumes: true})
+	require.NoError(t, err)
+	assert.False(t, exists(resources[3]), "volume wasn't deleted")
+}
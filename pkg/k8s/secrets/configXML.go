@@ -36,7 +36,7 @@ const configXML = `<configuration version="32">
     <scanProgressIntervalS>1</scanProgressIntervalS>
     <disableFsync>true</disableFsync>
     <pullerPauseS>0</pullerPauseS>
-    <maxConflicts>0</maxConflicts>
+    <maxConflicts>{{ $.MaxConflicts }}</maxConflicts>
     <disableSparseFiles>false</disableSparseFiles>
     <disableTempIndexes>false</disableTempIndexes>
     <paused>false</paused>
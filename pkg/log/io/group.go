@@ -0,0 +1,102 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"bytes"
+	"sync"
+)
+
+// OutputGroup buffers the output of an operation that runs concurrently with others,
+// so it can be displayed as a single block once the operation finishes
+type OutputGroup struct {
+	parent *Controller
+	ctrl   *Controller
+	buf    *syncBuffer
+}
+
+// syncBuffer is a bytes.Buffer safe for concurrent writes
+type syncBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+// Write writes p into the buffer
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// drain returns the buffered content and resets the buffer
+func (b *syncBuffer) drain() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	content := make([]byte, b.buf.Len())
+	copy(content, b.buf.Bytes())
+	b.buf.Reset()
+	return content
+}
+
+// NewOutputGroup returns a group whose controller shares the input and the logger of ioc,
+// but keeps its output in memory until Flush is called. The stage is only set for the group,
+// so concurrent groups can report different stages in json format.
+func (ioc *Controller) NewOutputGroup(stage string) *OutputGroup {
+	buf := &syncBuffer{}
+	out := newOutputController(buf)
+	switch ioc.out.formatter.(type) {
+	case *plainFormatter:
+		out.formatter = newPlainFormatter()
+		out.decorator = newPlainDecorator()
+	case *jsonFormatter:
+		f := newJSONFormatter()
+		f.SetStage(stage)
+		out.formatter = f
+		out.decorator = newPlainDecorator()
+	}
+	return &OutputGroup{
+		parent: ioc,
+		buf:    buf,
+		ctrl: &Controller{
+			in:           ioc.in,
+			out:          out,
+			oktetoLogger: ioc.oktetoLogger,
+		},
+	}
+}
+
+// IO returns the controller that must be used by the operation of the group
+func (g *OutputGroup) IO() *Controller {
+	return g.ctrl
+}
+
+// Flush writes the buffered output of the group into the output of the parent controller
+func (g *OutputGroup) Flush() {
+	g.parent.out.writeBlock(g.buf.drain())
+}
+
+// writeBlock writes an already formatted block into the output without interleaving it with
+// other blocks
+func (oc *OutputController) writeBlock(block []byte) {
+	if len(block) == 0 {
+		return
+	}
+	oc.blockMu.Lock()
+	defer oc.blockMu.Unlock()
+	if oc.spinner != nil && oc.spinner.isActive() {
+		oc.spinner.Stop()
+		defer oc.Spinner(oc.spinner.getMessage()).Start()
+	}
+	oc.out.Write(block)
+}
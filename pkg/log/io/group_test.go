@@ -0,0 +1,62 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputGroupFlush(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	ioc := NewIOController()
+	ioc.out = newOutputController(buffer)
+	ioc.SetOutputFormat("plain")
+
+	first := ioc.NewOutputGroup("first")
+	second := ioc.NewOutputGroup("second")
+
+	first.IO().Out().Println("first line 1")
+	second.IO().Out().Println("second line 1")
+	first.IO().Out().Println("first line 2")
+	require.Empty(t, buffer.String())
+
+	second.Flush()
+	first.Flush()
+	require.Equal(t, "second line 1\nfirst line 1\nfirst line 2\n", buffer.String())
+
+	// flushing twice doesn't duplicate the output
+	first.Flush()
+	require.Equal(t, "second line 1\nfirst line 1\nfirst line 2\n", buffer.String())
+}
+
+func TestOutputGroupJSONStage(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	ioc := NewIOController()
+	ioc.out = newOutputController(buffer)
+	ioc.SetOutputFormat("json")
+	ioc.out.SetStage("parent")
+
+	group := ioc.NewOutputGroup("group")
+	group.IO().Out().Println("test")
+	group.Flush()
+
+	msg := &jsonMessage{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), msg))
+	require.Equal(t, "group", msg.Stage)
+	require.Equal(t, "test", msg.Message)
+}
@@ -14,6 +14,7 @@
 package io
 
 import (
+	"io"
 	"os"
 
 	oktetoLog "github.com/okteto/okteto/pkg/log"
@@ -24,6 +25,9 @@ type Controller struct {
 	in  *InputController
 	out *OutputController
 	*oktetoLogger
+
+	// capture records the output of the commands executed remotely
+	capture io.Writer
 }
 
 // NewIOController returns a new input/output controller
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 
 	"github.com/okteto/okteto/pkg/env"
 )
@@ -29,6 +30,12 @@ type OutputController struct {
 	decorator decorator
 
 	spinner OktetoSpinner
+
+	// prefix is added at the beginning of every message
+	prefix string
+
+	// blockMu serializes the blocks written by output groups
+	blockMu sync.Mutex
 }
 
 // newOutputController returns a new logger that writes to stdout
@@ -60,7 +67,7 @@ func (oc *OutputController) SetOutputFormat(output string) {
 
 // Println prints a line into stdout
 func (oc *OutputController) Println(args ...any) {
-	msg := fmt.Sprint(args...)
+	msg := oc.prefix + fmt.Sprint(args...)
 	bytes, err := oc.formatter.format(msg)
 	if err != nil {
 		return
@@ -74,7 +81,7 @@ func (oc *OutputController) Println(args ...any) {
 
 // Print prints a line into stdout without a new line at the end
 func (oc *OutputController) Print(args ...any) {
-	msg := fmt.Sprint(args...)
+	msg := oc.prefix + fmt.Sprint(args...)
 	bytes, err := oc.formatter.format(msg)
 	if err != nil {
 		return
@@ -88,7 +95,7 @@ func (oc *OutputController) Print(args ...any) {
 
 // Printf prints a line into stdout with a format
 func (oc *OutputController) Printf(format string, args ...any) {
-	msg := fmt.Sprintf(format, args...)
+	msg := oc.prefix + fmt.Sprintf(format, args...)
 	bytes, err := oc.formatter.format(msg)
 	if err != nil {
 		return
@@ -102,7 +109,7 @@ func (oc *OutputController) Printf(format string, args ...any) {
 
 // Infof prints a information message to the user
 func (oc *OutputController) Infof(format string, args ...any) {
-	msg := fmt.Sprintf(format, args...)
+	msg := oc.prefix + fmt.Sprintf(format, args...)
 	msg = oc.decorator.Information(msg)
 	bytes, err := oc.formatter.format(msg)
 	if err != nil {
@@ -117,7 +124,7 @@ func (oc *OutputController) Infof(format string, args ...any) {
 
 // Success prints a success message to the user
 func (oc *OutputController) Success(format string, args ...any) {
-	msg := fmt.Sprintf(format, args...)
+	msg := oc.prefix + fmt.Sprintf(format, args...)
 	msg = oc.decorator.Success(msg)
 	bytes, err := oc.formatter.format(msg)
 	if err != nil {
@@ -132,7 +139,7 @@ func (oc *OutputController) Success(format string, args ...any) {
 
 // Warning prints a warning message to the user
 func (oc *OutputController) Warning(format string, args ...any) {
-	msg := fmt.Sprintf(format, args...)
+	msg := oc.prefix + fmt.Sprintf(format, args...)
 	msg = oc.decorator.Warning(msg)
 	bytes, err := oc.formatter.format(msg)
 	if err != nil {
@@ -174,7 +181,7 @@ func (oc *OutputController) Spinner(msg string) OktetoSpinner {
 
 // Write logs into the buffer but does not print anything
 func (oc *OutputController) Write(p []byte) (n int, err error) {
-	msg := string(p)
+	msg := oc.prefix + string(p)
 	if !strings.HasSuffix(msg, "\n") {
 		msg += "\n"
 	}
@@ -0,0 +1,54 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import "io"
+
+// WithPrefix returns a controller that writes into the same output as ioc, adding prefix at the
+// beginning of every message. It is used to identify the output of operations that run concurrently.
+func (ioc *Controller) WithPrefix(prefix string) *Controller {
+	return &Controller{
+		in: ioc.in,
+		out: &OutputController{
+			out:       ioc.out.out,
+			formatter: ioc.out.formatter,
+			decorator: ioc.out.decorator,
+			prefix:    ioc.out.prefix + prefix,
+		},
+		oktetoLogger: ioc.oktetoLogger,
+		capture:      ioc.capture,
+	}
+}
+
+// Prefix returns the prefix added to the messages of the controller
+func (ioc *Controller) Prefix() string {
+	return ioc.out.prefix
+}
+
+// WithCapture returns a controller that writes into the same output as ioc and also records
+// into w the output of the commands executed remotely, so it can be reported afterwards
+func (ioc *Controller) WithCapture(w io.Writer) *Controller {
+	return &Controller{
+		in:           ioc.in,
+		out:          ioc.out,
+		oktetoLogger: ioc.oktetoLogger,
+		capture:      w,
+	}
+}
+
+// Capture returns the writer where the output of the commands executed remotely is recorded.
+// It returns nil when the output is not captured
+func (ioc *Controller) Capture() io.Writer {
+	return ioc.capture
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithPrefix(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	ioc := NewIOController()
+	ioc.out = newOutputController(buffer)
+	ioc.SetOutputFormat("plain")
+
+	prefixed := ioc.WithPrefix("[unit] ")
+	prefixed.Out().Println("first")
+	ioc.Out().Println("second")
+	prefixed.Out().Infof("third")
+
+	require.Equal(t, "[unit] ", prefixed.Prefix())
+	require.Empty(t, ioc.Prefix())
+	require.Equal(t, "[unit] first\nsecond\n", buffer.String()[:len("[unit] first\nsecond\n")])
+	require.Contains(t, buffer.String(), "[unit] third")
+}
+
+func TestWithCapture(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	ioc := NewIOController()
+	ioc.out = newOutputController(buffer)
+	require.Nil(t, ioc.Capture())
+
+	capture := bytes.NewBuffer([]byte{})
+	captured := ioc.WithCapture(capture).WithPrefix("[unit] ")
+	require.Equal(t, capture, captured.Capture())
+	require.Equal(t, "[unit] ", captured.Prefix())
+}
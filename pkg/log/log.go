@@ -331,11 +331,20 @@ func AddMaskedWord(word string) {
 		}
 		log.maskedWords = append(log.maskedWords, cleanLine)
 	}
+
+	// words added while masking is enabled, like the sensitive outputs of a deploy command, are redacted from now on
+	if log.isMasked {
+		buildReplacer()
+	}
 }
 
 // EnableMasking starts redacting all variables
 func EnableMasking() {
 	log.isMasked = true
+	buildReplacer()
+}
+
+func buildReplacer() {
 	sort.Slice(log.maskedWords, func(i, j int) bool {
 		return len(log.maskedWords[i]) > len(log.maskedWords[j])
 	})
@@ -352,6 +361,11 @@ func DisableMasking() {
 	log.isMasked = false
 }
 
+// Redact returns the message with the masked words redacted
+func Redact(message string) string {
+	return redactMessage(message)
+}
+
 func redactMessage(message string) string {
 	if log.isMasked {
 		return log.replacer.Replace(message)
@@ -72,6 +72,17 @@ func Test_GetContextResource(t *testing.T) {
 	}
 }
 
+func TestAddMaskedWordWhileMasking(t *testing.T) {
+	maskedWords := log.maskedWords
+	t.Cleanup(func() { log.maskedWords = maskedWords })
+	log.maskedWords = []string{"password"}
+	EnableMasking()
+	defer DisableMasking()
+
+	AddMaskedWord("postgres://db:5432")
+	assert.Equal(t, "*** and ***", redactMessage("password and postgres://db:5432"))
+}
+
 func TestSetOutputFormat(t *testing.T) {
 	Init(logrus.DebugLevel)
 	var tests = []struct {
@@ -100,6 +100,12 @@ func StopSpinner() {
 	}
 }
 
+// DisableSpinner prints the spinner messages as plain lines, for commands that run without a terminal
+func DisableSpinner() {
+	StopSpinner()
+	log.spinner.spinnerSupport = false
+}
+
 func ucFirst(str string) string {
 	for i, v := range str {
 		return string(unicode.ToUpper(v)) + str[i+1:]
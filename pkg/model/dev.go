@@ -86,6 +86,7 @@ type Dev struct {
 	Timeout         Timeout            `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 	RemotePort      int                `json:"remote,omitempty" yaml:"remote,omitempty"`
 	SSHServerPort   int                `json:"sshServerPort,omitempty" yaml:"sshServerPort,omitempty"`
+	Socks           int                `json:"socks,omitempty" yaml:"socks,omitempty"`
 
 	Autocreate bool `json:"autocreate,omitempty" yaml:"autocreate,omitempty"`
 }
@@ -121,6 +122,7 @@ type Sync struct {
 	RescanInterval int          `json:"rescanInterval,omitempty" yaml:"rescanInterval,omitempty"`
 	Compression    bool         `json:"compression" yaml:"compression"`
 	Verbose        bool         `json:"verbose" yaml:"verbose"`
+	KeepConflicts  bool         `json:"keepConflicts,omitempty" yaml:"keepConflicts,omitempty"`
 }
 
 // SyncFolder represents a sync folder in the development container
@@ -193,8 +195,10 @@ type Secret struct {
 
 // Reverse represents a remote forward port
 type Reverse struct {
-	Remote int
-	Local  int
+	Protocol    string
+	LocalSocket string
+	Remote      int
+	Local       int
 }
 
 // ResourceRequirements describes the compute resource requirements.
@@ -581,6 +585,10 @@ func (dev *Dev) Validate() error {
 		return fmt.Errorf("'sshServerPort' must be > 0")
 	}
 
+	if dev.Socks < 0 || dev.Socks > IANAEphemeralPortEnd {
+		return fmt.Errorf("'socks' must be a port between 1 and %d", IANAEphemeralPortEnd)
+	}
+
 	for _, s := range dev.Services {
 		if err := validatePullPolicy(s.ImagePullPolicy); err != nil {
 			return err
@@ -1040,12 +1048,28 @@ func (dev *Dev) RemoteModeEnabled() bool {
 		return true
 	}
 
+	if dev.Socks > 0 {
+		return true
+	}
+
 	if v, ok := os.LookupEnv(OktetoExecuteSSHEnvVar); ok && v == "false" {
 		return false
 	}
 	return true
 }
 
+func (r Reverse) String() string {
+	local := strconv.Itoa(r.Local)
+	if r.LocalSocket != "" {
+		local = r.LocalSocket
+	}
+
+	if r.Protocol == forward.ProtocolUDP {
+		return fmt.Sprintf("%s:%d:%s", forward.ProtocolUDP, r.Remote, local)
+	}
+	return fmt.Sprintf("%d:%s", r.Remote, local)
+}
+
 // GetKeyName returns the secret key name
 func (s *Secret) GetKeyName() string {
 	return fmt.Sprintf("dev-secret-%s", filepath.Base(s.RemotePath))
@@ -1112,6 +1136,9 @@ func (service *Dev) validateForExtraFields() error {
 	if service.SSHServerPort != 0 {
 		return fmt.Errorf(errorMessage, "sshServerPort")
 	}
+	if service.Socks != 0 {
+		return fmt.Errorf(errorMessage, "socks")
+	}
 	if service.ExternalVolumes != nil {
 		return fmt.Errorf(errorMessage, "externalVolumes")
 	}
@@ -654,6 +654,30 @@ func Test_Reverse(t *testing.T) {
 	}
 }
 
+func Test_Socks(t *testing.T) {
+	manifestBytes := []byte(`dev:
+    deployment:
+        image: code/core:0.1.8
+        socks: 1080`)
+	manifest, err := Read(manifestBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev := manifest.Dev["deployment"]
+
+	if dev.Socks != 1080 {
+		t.Errorf("socks port wasn't 1080 it was %d", dev.Socks)
+	}
+
+	t.Setenv(OktetoExecuteSSHEnvVar, "false")
+	if !dev.RemoteModeEnabled() {
+		t.Error("remote mode was not automatically enabled")
+	}
+
+	dev.Socks = 70000
+	assert.ErrorContains(t, dev.Validate(), "'socks'")
+}
+
 func Test_LoadForcePull(t *testing.T) {
 	manifestBytes := []byte(`dev:
     a:
@@ -15,14 +15,26 @@ package forward
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 )
 
-const MalformedPortForward = "wrong port-forward syntax '%s', must be of the form 'localPort:remotePort' or 'localPort:serviceName:remotePort'"
+const MalformedPortForward = "wrong port-forward syntax '%s', must be of the form '[protocol:]localPort:remotePort' or '[protocol:]localPort:serviceName:remotePort'"
+
+const (
+	// ProtocolTCP forwards TCP connections. It is the default protocol
+	ProtocolTCP = "tcp"
+
+	// ProtocolUDP forwards UDP datagrams
+	ProtocolUDP = "udp"
+)
 
 // Forward represents a port forwarding definition
 type Forward struct {
 	Labels      map[string]string `json:"labels" yaml:"labels"`
 	ServiceName string            `json:"name" yaml:"name"`
+	Protocol    string            `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+	LocalSocket string            `json:"localSocket,omitempty" yaml:"localSocket,omitempty"`
 	Local       int               `json:"localPort" yaml:"localPort"`
 	Remote      int               `json:"remotePort" yaml:"remotePort"`
 	Service     bool              `json:"-" yaml:"-"`
@@ -30,11 +42,72 @@ type Forward struct {
 }
 
 func (f Forward) String() string {
+	local := strconv.Itoa(f.Local)
+	if f.LocalSocket != "" {
+		local = f.LocalSocket
+	}
+
+	prefix := ""
+	if f.IsUDP() {
+		prefix = ProtocolUDP + ":"
+	}
+
 	if f.Service {
-		return fmt.Sprintf("%d:%s:%d", f.Local, f.ServiceName, f.Remote)
+		return fmt.Sprintf("%s%s:%s:%d", prefix, local, f.ServiceName, f.Remote)
+	}
+
+	return fmt.Sprintf("%s%s:%d", prefix, local, f.Remote)
+}
+
+// IsUDP returns true if the forward sends UDP datagrams instead of TCP connections
+func (f Forward) IsUDP() bool {
+	return f.Protocol == ProtocolUDP
+}
+
+// IsSocket returns true if the local end of the forward is a Unix-domain socket
+func (f Forward) IsSocket() bool {
+	return f.LocalSocket != ""
+}
+
+func (f Forward) validate() error {
+	switch f.Protocol {
+	case "", ProtocolTCP, ProtocolUDP:
+	default:
+		return fmt.Errorf("unsupported protocol '%s' in port-forward '%s', must be '%s' or '%s'", f.Protocol, f.String(), ProtocolTCP, ProtocolUDP)
+	}
+
+	if f.IsSocket() && f.IsUDP() {
+		return fmt.Errorf("port-forward '%s' is not valid: Unix socket forwards only support the '%s' protocol", f.String(), ProtocolTCP)
 	}
 
-	return fmt.Sprintf("%d:%d", f.Local, f.Remote)
+	if f.IsSocket() && f.Local != 0 {
+		return fmt.Errorf("port-forward '%s' is not valid: 'localPort' and 'localSocket' can't be used at the same time", f.String())
+	}
+
+	return nil
+}
+
+// SplitProtocol removes the optional protocol prefix of a forward definition.
+// It returns an empty protocol if the definition doesn't have a prefix or it is 'tcp'
+func SplitProtocol(raw string) (string, string) {
+	protocol, rest, found := strings.Cut(raw, ":")
+	if !found {
+		return "", raw
+	}
+
+	switch strings.ToLower(protocol) {
+	case ProtocolTCP:
+		return "", rest
+	case ProtocolUDP:
+		return ProtocolUDP, rest
+	default:
+		return "", raw
+	}
+}
+
+// IsSocketPath returns true if value is the path of a Unix-domain socket instead of a port
+func IsSocketPath(value string) bool {
+	return strings.HasPrefix(value, "/")
 }
 
 func (f *Forward) Less(c *Forward) bool {
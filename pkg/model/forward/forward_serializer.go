@@ -22,6 +22,8 @@ import (
 type Raw struct {
 	Labels      map[string]string `json:"labels" yaml:"labels"`
 	ServiceName string            `json:"name" yaml:"name"`
+	Protocol    string            `json:"protocol" yaml:"protocol"`
+	LocalSocket string            `json:"localSocket" yaml:"localSocket"`
 	Local       int               `json:"localPort" yaml:"localPort"`
 	Remote      int               `json:"remotePort" yaml:"remotePort"`
 	Service     bool              `json:"-" yaml:"-"`
@@ -31,6 +33,9 @@ type Raw struct {
 // It supports the following options:
 // - int:int
 // - int:serviceName:int
+// - /path/to/socket:int
+// - /path/to/socket:serviceName:int
+// All of them accept an optional 'tcp:' or 'udp:' prefix.
 // Anything else will result in an error
 func (f *Forward) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var raw string
@@ -41,16 +46,22 @@ func (f *Forward) UnmarshalYAML(unmarshal func(interface{}) error) error {
 
 	maxForwardParts := 3
 	minForwardParts := 2
-	parts := strings.Split(raw, ":")
+	protocol, definition := SplitProtocol(raw)
+	parts := strings.Split(definition, ":")
 	if len(parts) < minForwardParts || len(parts) > maxForwardParts {
 		return fmt.Errorf(MalformedPortForward, raw)
 	}
 
-	localPort, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return fmt.Errorf("Cannot convert local port '%s' in port-forward '%s'", parts[0], raw)
+	f.Protocol = protocol
+	if IsSocketPath(parts[0]) {
+		f.LocalSocket = parts[0]
+	} else {
+		localPort, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return fmt.Errorf("Cannot convert local port '%s' in port-forward '%s'", parts[0], raw)
+		}
+		f.Local = localPort
 	}
-	f.Local = localPort
 
 	if len(parts) == minForwardParts {
 		p, err := strconv.Atoi(parts[1])
@@ -59,7 +70,7 @@ func (f *Forward) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		}
 
 		f.Remote = p
-		return nil
+		return f.validate()
 	}
 
 	f.Service = true
@@ -70,7 +81,7 @@ func (f *Forward) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	}
 
 	f.Remote = p
-	return nil
+	return f.validate()
 }
 
 // MarshalYAML Implements the marshaler interface of the yaml pkg.
@@ -88,11 +99,16 @@ func (f *Forward) UnmarshalExtendedForm(unmarshal func(interface{}) error) error
 	f.Remote = rawForward.Remote
 	f.ServiceName = rawForward.ServiceName
 	f.Labels = rawForward.Labels
+	f.LocalSocket = rawForward.LocalSocket
+	f.Protocol = strings.ToLower(rawForward.Protocol)
+	if f.Protocol == ProtocolTCP {
+		f.Protocol = ""
+	}
 	if len(rawForward.Labels) != 0 || rawForward.ServiceName != "" {
 		f.Service = true
 	}
 	if f.Labels != nil && f.ServiceName != "" {
 		return fmt.Errorf("Can not use ServiceName and Labels to specify the service.\nUse either the service name or labels to get the service to expose.")
 	}
-	return nil
+	return f.validate()
 }
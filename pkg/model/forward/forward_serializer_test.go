@@ -108,6 +108,36 @@ func TestForward_UnmarshalYAML(t *testing.T) {
 			data:      "8080:svc",
 			expectErr: true,
 		},
+		{
+			name:     "udp",
+			data:     "udp:5353:53",
+			expected: Forward{Local: 5353, Remote: 53, Protocol: ProtocolUDP},
+		},
+		{
+			name:     "udp-service-with-port",
+			data:     "udp:8125:statsd:8125",
+			expected: Forward{Local: 8125, Remote: 8125, Protocol: ProtocolUDP, Service: true, ServiceName: "statsd"},
+		},
+		{
+			name:     "socket",
+			data:     "/tmp/docker.sock:2375",
+			expected: Forward{LocalSocket: "/tmp/docker.sock", Remote: 2375},
+		},
+		{
+			name:     "socket-service-with-port",
+			data:     "/tmp/debug.sock:api:4711",
+			expected: Forward{LocalSocket: "/tmp/debug.sock", Remote: 4711, Service: true, ServiceName: "api"},
+		},
+		{
+			name:      "udp-socket",
+			data:      "udp:/tmp/statsd.sock:8125",
+			expectErr: true,
+		},
+		{
+			name:      "unknown-protocol",
+			data:      "sctp:8080:8080",
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -177,3 +207,63 @@ func TestForwardExtended_MarshalYAML(t *testing.T) {
 		})
 	}
 }
+
+func TestForwardExtended_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		expected  Forward
+		expectErr bool
+	}{
+		{
+			name:     "tcp",
+			data:     "localPort: 8080\nremotePort: 9090\nprotocol: TCP",
+			expected: Forward{Local: 8080, Remote: 9090},
+		},
+		{
+			name:     "udp",
+			data:     "localPort: 8125\nremotePort: 8125\nname: statsd\nprotocol: udp",
+			expected: Forward{Local: 8125, Remote: 8125, Protocol: ProtocolUDP, Service: true, ServiceName: "statsd"},
+		},
+		{
+			name:     "socket",
+			data:     "localSocket: /tmp/docker.sock\nremotePort: 2375",
+			expected: Forward{LocalSocket: "/tmp/docker.sock", Remote: 2375},
+		},
+		{
+			name:      "socket-and-port",
+			data:      "localSocket: /tmp/docker.sock\nlocalPort: 2375\nremotePort: 2375",
+			expectErr: true,
+		},
+		{
+			name:      "udp-socket",
+			data:      "localSocket: /tmp/docker.sock\nremotePort: 2375\nprotocol: udp",
+			expectErr: true,
+		},
+		{
+			name:      "unknown-protocol",
+			data:      "localPort: 8080\nremotePort: 9090\nprotocol: sctp",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result Forward
+			err := yaml.Unmarshal([]byte(tt.data), &result)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("didn't got expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("didn't unmarshal correctly. Actual '%+v', Expected '%+v'", result, tt.expected)
+			}
+		})
+	}
+}
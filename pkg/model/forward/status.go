@@ -0,0 +1,136 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forward
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// State represents the health of a forward
+type State string
+
+const (
+	// StateConnected the forward reaches its target
+	StateConnected State = "connected"
+
+	// StateReconnecting the forward lost its target and it is being re-established
+	StateReconnecting State = "reconnecting"
+
+	// StateFailed the forward couldn't be re-established after MaxReconnectRetries attempts. It keeps retrying in the background
+	StateFailed State = "failed"
+)
+
+const (
+	// MaxReconnectRetries is the number of failed attempts before a forward is reported as failed
+	MaxReconnectRetries = 10
+
+	minReconnectBackoff = time.Second
+	maxReconnectBackoff = 30 * time.Second
+)
+
+// Status is the health of a forward
+type Status struct {
+	LastTransition time.Time `json:"lastTransition"`
+	Forward        string    `json:"forward"`
+	State          State     `json:"state"`
+	Error          string    `json:"error,omitempty"`
+	Local          int       `json:"localPort"`
+	Retries        int       `json:"retries,omitempty"`
+}
+
+// Backoff returns the time to wait before the given reconnection attempt
+func Backoff(retries int) time.Duration {
+	backoff := minReconnectBackoff
+	for i := 1; i < retries; i++ {
+		backoff *= 2
+		if backoff >= maxReconnectBackoff {
+			return maxReconnectBackoff
+		}
+	}
+	return backoff
+}
+
+// StateAfter returns the state of a forward that failed to reconnect the given number of times
+func StateAfter(retries int) State {
+	if retries >= MaxReconnectRetries {
+		return StateFailed
+	}
+	return StateReconnecting
+}
+
+// StatusTracker keeps the health of a set of forwards, identified by their local port.
+// A nil StatusTracker ignores all the updates
+type StatusTracker struct {
+	statuses map[int]Status
+	onChange func(previous State, current Status)
+	lock     sync.Mutex
+}
+
+// NewStatusTracker returns a StatusTracker that calls onChange every time the state of a forward changes
+func NewStatusTracker(onChange func(previous State, current Status)) *StatusTracker {
+	return &StatusTracker{
+		statuses: map[int]Status{},
+		onChange: onChange,
+	}
+}
+
+// Set updates the health of the forward f
+func (t *StatusTracker) Set(f Forward, state State, retries int, err error) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	previous := t.statuses[f.Local]
+	current := Status{
+		Local:          f.Local,
+		Forward:        f.String(),
+		State:          state,
+		Retries:        retries,
+		LastTransition: previous.LastTransition,
+	}
+	if err != nil {
+		current.Error = err.Error()
+	}
+	changed := previous.State != state
+	if changed {
+		current.LastTransition = time.Now()
+	}
+	t.statuses[f.Local] = current
+	t.lock.Unlock()
+
+	if changed && t.onChange != nil {
+		t.onChange(previous.State, current)
+	}
+}
+
+// List returns the health of all the forwards sorted by local port
+func (t *StatusTracker) List() []Status {
+	if t == nil {
+		return nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	result := make([]Status, 0, len(t.statuses))
+	for _, s := range t.statuses {
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Local < result[j].Local
+	})
+	return result
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forward
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		retries  int
+		expected time.Duration
+	}{
+		{retries: 0, expected: time.Second},
+		{retries: 1, expected: time.Second},
+		{retries: 2, expected: 2 * time.Second},
+		{retries: 5, expected: 16 * time.Second},
+		{retries: 6, expected: 30 * time.Second},
+		{retries: 100, expected: 30 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.retries); got != tt.expected {
+			t.Errorf("Backoff(%d) = %s, expected %s", tt.retries, got, tt.expected)
+		}
+	}
+}
+
+func TestStateAfter(t *testing.T) {
+	if got := StateAfter(1); got != StateReconnecting {
+		t.Errorf("got %s, expected %s", got, StateReconnecting)
+	}
+	if got := StateAfter(MaxReconnectRetries); got != StateFailed {
+		t.Errorf("got %s, expected %s", got, StateFailed)
+	}
+}
+
+func TestStatusTracker(t *testing.T) {
+	type change struct {
+		previous State
+		current  State
+	}
+	changes := []change{}
+	tracker := NewStatusTracker(func(previous State, current Status) {
+		changes = append(changes, change{previous: previous, current: current.State})
+	})
+
+	db := Forward{Local: 5432, Remote: 5432, Service: true, ServiceName: "db"}
+	api := Forward{Local: 8080, Remote: 80, Service: true, ServiceName: "api"}
+	tracker.Set(api, StateConnected, 0, nil)
+	tracker.Set(db, StateConnected, 0, nil)
+	tracker.Set(db, StateReconnecting, 1, errors.New("connection refused"))
+	tracker.Set(db, StateReconnecting, 2, errors.New("connection refused"))
+	db.ServiceName = "db-v2"
+	tracker.Set(db, StateConnected, 0, nil)
+
+	expected := []change{
+		{previous: "", current: StateConnected},
+		{previous: "", current: StateConnected},
+		{previous: StateConnected, current: StateReconnecting},
+		{previous: StateReconnecting, current: StateConnected},
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("got %d changes, expected %d: %v", len(changes), len(expected), changes)
+	}
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Errorf("change %d: got %v, expected %v", i, changes[i], expected[i])
+		}
+	}
+
+	statuses := tracker.List()
+	if len(statuses) != 2 {
+		t.Fatalf("got %d statuses, expected 2", len(statuses))
+	}
+	if statuses[0].Forward != "5432:db-v2:5432" || statuses[0].State != StateConnected || statuses[0].Error != "" {
+		t.Errorf("unexpected status: %+v", statuses[0])
+	}
+	if statuses[1].Forward != "8080:api:80" {
+		t.Errorf("unexpected status: %+v", statuses[1])
+	}
+
+	var nilTracker *StatusTracker
+	nilTracker.Set(db, StateFailed, 1, nil)
+	if nilTracker.List() != nil {
+		t.Error("nil tracker returned statuses")
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -85,6 +86,9 @@ const (
 
 var (
 	priorityOrder = []string{"dev", "dependencies", "deploy", "build", "name"}
+
+	// outputNameRegex matches the names of the outputs, which are exposed as environment variables
+	outputNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
 )
 
 // Manifest represents an okteto manifest
@@ -174,6 +178,15 @@ type DeployInfo struct {
 	Image          string              `json:"image,omitempty" yaml:"image,omitempty"`
 	Context        string              `yaml:"context,omitempty"`
 	Commands       []DeployCommand     `json:"commands,omitempty" yaml:"commands,omitempty"`
+	Wait           []WaitCheck         `json:"wait,omitempty" yaml:"wait,omitempty"`
+}
+
+// WaitCheck represents an HTTP or TCP check that must pass for 'okteto deploy --wait' to finish
+type WaitCheck struct {
+	Name   string `json:"name,omitempty" yaml:"name,omitempty"`
+	HTTP   string `json:"http,omitempty" yaml:"http,omitempty"`
+	TCP    string `json:"tcp,omitempty" yaml:"tcp,omitempty"`
+	Status int    `json:"status,omitempty" yaml:"status,omitempty"`
 }
 
 // DestroyInfo represents what must be destroyed for the app
@@ -225,8 +238,16 @@ type ServicesToDeploy []string
 
 // DeployCommand represents a command to be executed
 type DeployCommand struct {
-	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
-	Command string `json:"command,omitempty" yaml:"command,omitempty"`
+	Name    string         `json:"name,omitempty" yaml:"name,omitempty"`
+	Command string         `json:"command,omitempty" yaml:"command,omitempty"`
+	Outputs []DeployOutput `json:"outputs,omitempty" yaml:"outputs,omitempty"`
+}
+
+// DeployOutput represents a value generated by a deploy command. The command sets its value writing
+// 'NAME=VALUE' to the $OKTETO_ENV file
+type DeployOutput struct {
+	Name      string `json:"name,omitempty" yaml:"name,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty" yaml:"sensitive,omitempty"`
 }
 
 func getManifestFromOktetoFile(cwd string, fs afero.Fs) (*Manifest, error) {
@@ -484,6 +505,11 @@ func getOktetoManifest(devPath string) (*Manifest, error) {
 		return nil, err
 	}
 
+	return ReadOktetoManifest(b, devPath)
+}
+
+// ReadOktetoManifest reads the content of an okteto manifest stored at manifestPath
+func ReadOktetoManifest(b []byte, manifestPath string) (*Manifest, error) {
 	if isEmptyManifestFile(b) {
 		return nil, fmt.Errorf("%s: %w", oktetoErrors.ErrInvalidManifest, oktetoErrors.ErrEmptyManifest)
 	}
@@ -500,7 +526,7 @@ func getOktetoManifest(devPath string) (*Manifest, error) {
 		external.SetDefaults(name)
 	}
 
-	manifest.ManifestPath = devPath
+	manifest.ManifestPath = manifestPath
 
 	return manifest, nil
 }
@@ -539,7 +565,13 @@ func (m *Manifest) validate() error {
 	if err := m.Build.Validate(); err != nil {
 		return err
 	}
-	return m.validateDivert()
+	if err := m.validateDivert(); err != nil {
+		return err
+	}
+	if err := m.validateWait(); err != nil {
+		return err
+	}
+	return m.validateOutputs()
 }
 
 func (s *Secret) validate() error {
@@ -602,6 +634,24 @@ func (m *Manifest) SanitizeSvcNames() error {
 	return nil
 }
 
+func (m *Manifest) validateWait() error {
+	if m.Deploy == nil {
+		return nil
+	}
+	for i, check := range m.Deploy.Wait {
+		if (check.HTTP == "") == (check.TCP == "") {
+			return fmt.Errorf("the field 'deploy.wait[%d]' must define either 'http' or 'tcp'", i)
+		}
+		if check.Status != 0 && check.HTTP == "" {
+			return fmt.Errorf("the field 'deploy.wait[%d].status' is only supported with 'http'", i)
+		}
+		if check.Status != 0 && (check.Status < 100 || check.Status > 599) {
+			return fmt.Errorf("the field 'deploy.wait[%d].status' must be a valid HTTP status code", i)
+		}
+	}
+	return nil
+}
+
 func (m *Manifest) validateDivert() error {
 	if m.Deploy == nil {
 		return nil
@@ -621,6 +671,16 @@ func (m *Manifest) validateDivert() error {
 		if len(m.Deploy.Divert.Hosts) > 0 {
 			return fmt.Errorf("the field 'deploy.divert.host' is not supported with the nginx driver")
 		}
+	case constants.OktetoDivertGatewayDriver:
+		if m.Deploy.Divert.Namespace == "" {
+			return fmt.Errorf("the field 'deploy.divert.namespace' is mandatory")
+		}
+		if len(m.Deploy.Divert.VirtualServices) > 0 {
+			return fmt.Errorf("the field 'deploy.divert.virtualServices' is not supported with the gateway driver")
+		}
+		if len(m.Deploy.Divert.Hosts) > 0 {
+			return fmt.Errorf("the field 'deploy.divert.host' is not supported with the gateway driver")
+		}
 	case constants.OktetoDivertIstioDriver:
 		if m.Deploy.Divert.DeprecatedService != "" {
 			return fmt.Errorf("the field 'deploy.divert.service' is not supported with the istio driver")
@@ -1300,3 +1360,22 @@ func (d *DivertDeploy) IsEmpty() bool {
 			d.DeprecatedDeployment == "" &&
 			d.DeprecatedPort == 0
 }
+
+func (m *Manifest) validateOutputs() error {
+	if m.Deploy == nil {
+		return nil
+	}
+	names := map[string]bool{}
+	for _, cmd := range m.Deploy.Commands {
+		for _, output := range cmd.Outputs {
+			if !outputNameRegex.MatchString(output.Name) {
+				return fmt.Errorf("the output '%s' of the command '%s' is not a valid environment variable name", output.Name, cmd.Name)
+			}
+			if names[output.Name] {
+				return fmt.Errorf("the output '%s' is declared more than once", output.Name)
+			}
+			names[output.Name] = true
+		}
+	}
+	return nil
+}
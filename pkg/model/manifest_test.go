@@ -312,6 +312,30 @@ func Test_validateDivert(t *testing.T) {
 			},
 			expectedErr: fmt.Errorf("the field 'deploy.divert.namespace' is mandatory"),
 		},
+		{
+			name: "divert-ok-gateway",
+			divert: DivertDeploy{
+				Driver:    constants.OktetoDivertGatewayDriver,
+				Namespace: "namespace",
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "divert-ko-gateway-without-namespace",
+			divert: DivertDeploy{
+				Driver: constants.OktetoDivertGatewayDriver,
+			},
+			expectedErr: fmt.Errorf("the field 'deploy.divert.namespace' is mandatory"),
+		},
+		{
+			name: "divert-ko-gateway-with-virtual-services",
+			divert: DivertDeploy{
+				Driver:          constants.OktetoDivertGatewayDriver,
+				Namespace:       "namespace",
+				VirtualServices: []DivertVirtualService{{Name: "vs", Namespace: "staging"}},
+			},
+			expectedErr: fmt.Errorf("the field 'deploy.divert.virtualServices' is not supported with the gateway driver"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -2201,3 +2225,101 @@ func TestDivertDeployIsEmpty(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateWait(t *testing.T) {
+	tests := []struct {
+		expectedErr error
+		name        string
+		wait        []WaitCheck
+	}{
+		{
+			name: "http-and-tcp-ok",
+			wait: []WaitCheck{
+				{HTTP: "https://api-${OKTETO_NAMESPACE}.okteto.dev/healthz", Status: 200},
+				{Name: "db", TCP: "postgres:5432"},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "ko-without-http-nor-tcp",
+			wait:        []WaitCheck{{Name: "api"}},
+			expectedErr: fmt.Errorf("the field 'deploy.wait[0]' must define either 'http' or 'tcp'"),
+		},
+		{
+			name:        "ko-with-http-and-tcp",
+			wait:        []WaitCheck{{HTTP: "http://api:8080", TCP: "api:8080"}},
+			expectedErr: fmt.Errorf("the field 'deploy.wait[0]' must define either 'http' or 'tcp'"),
+		},
+		{
+			name:        "ko-status-with-tcp",
+			wait:        []WaitCheck{{TCP: "api:8080", Status: 200}},
+			expectedErr: fmt.Errorf("the field 'deploy.wait[0].status' is only supported with 'http'"),
+		},
+		{
+			name:        "ko-invalid-status",
+			wait:        []WaitCheck{{HTTP: "http://api:8080"}, {HTTP: "http://api:8080", Status: 42}},
+			expectedErr: fmt.Errorf("the field 'deploy.wait[1].status' must be a valid HTTP status code"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Manifest{
+				Deploy: &DeployInfo{
+					Wait: tt.wait,
+				},
+			}
+			assert.Equal(t, tt.expectedErr, m.validateWait())
+		})
+	}
+}
+
+func Test_validateOutputs(t *testing.T) {
+	tests := []struct {
+		expectedErr error
+		name        string
+		commands    []DeployCommand
+	}{
+		{
+			name: "outputs-ok",
+			commands: []DeployCommand{
+				{Name: "db", Outputs: []DeployOutput{{Name: "DATABASE_URL", Sensitive: true}}},
+				{Name: "api", Outputs: []DeployOutput{{Name: "_API_KEY2"}}},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "ko-invalid-name",
+			commands: []DeployCommand{
+				{Name: "db", Outputs: []DeployOutput{{Name: "DATABASE-URL"}}},
+			},
+			expectedErr: fmt.Errorf("the output 'DATABASE-URL' of the command 'db' is not a valid environment variable name"),
+		},
+		{
+			name: "ko-empty-name",
+			commands: []DeployCommand{
+				{Name: "db", Outputs: []DeployOutput{{Sensitive: true}}},
+			},
+			expectedErr: fmt.Errorf("the output '' of the command 'db' is not a valid environment variable name"),
+		},
+		{
+			name: "ko-duplicated",
+			commands: []DeployCommand{
+				{Name: "db", Outputs: []DeployOutput{{Name: "DATABASE_URL"}}},
+				{Name: "migrate", Outputs: []DeployOutput{{Name: "DATABASE_URL"}}},
+			},
+			expectedErr: fmt.Errorf("the output 'DATABASE_URL' is declared more than once"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Manifest{
+				Deploy: &DeployInfo{
+					Commands: tt.commands,
+				},
+			}
+			assert.Equal(t, tt.expectedErr, m.validateOutputs())
+		})
+	}
+}
@@ -187,16 +187,17 @@ func Test_getStructKeys(t *testing.T) {
 				"deps.Dependency":                   {"repository", "manifest", "branch", "variables", "timeout", "wait"},
 				"env.Var":                           {"name", "value"},
 				"externalresource.ExternalResource": {"icon", "notes", "endpoints"},
-				"forward.Forward":                   {"labels", "name", "localPort", "remotePort"},
+				"forward.Forward":                   {"labels", "name", "protocol", "localSocket", "localPort", "remotePort"},
 				"forward.GlobalForward":             {"labels", "name", "localPort", "remotePort"},
 				"model.Artifact":                    {"path", "destination"},
 				"model.Capabilities":                {"add", "drop"},
 				"model.ComposeInfo":                 {"file", "services"},
 				"model.ComposeSectionInfo":          {"manifest"},
-				"model.DeployCommand":               {"name", "command"},
-				"model.DeployInfo":                  {"compose", "endpoints", "divert", "image", "commands", "remote", "context"},
+				"model.DeployCommand":               {"name", "command", "outputs"},
+				"model.DeployOutput":                {"name", "sensitive"},
+				"model.DeployInfo":                  {"compose", "endpoints", "divert", "image", "commands", "remote", "context", "wait"},
 				"model.DestroyInfo":                 {"image", "commands", "remote", "context"},
-				"model.Dev":                         {"resources", "selector", "persistentVolume", "securityContext", "probes", "nodeSelector", "metadata", "affinity", "image", "lifecycle", "replicas", "initContainer", "workdir", "name", "container", "serviceAccount", "priorityClassName", "interface", "mode", "imagePullPolicy", "tolerations", "command", "forward", "reverse", "externalVolumes", "secrets", "volumes", "envFiles", "environment", "services", "args", "sync", "timeout", "remote", "sshServerPort", "autocreate"},
+				"model.Dev":                         {"resources", "selector", "persistentVolume", "securityContext", "probes", "nodeSelector", "metadata", "affinity", "image", "lifecycle", "replicas", "initContainer", "workdir", "name", "container", "serviceAccount", "priorityClassName", "interface", "mode", "imagePullPolicy", "tolerations", "command", "forward", "reverse", "externalVolumes", "secrets", "volumes", "envFiles", "environment", "services", "args", "sync", "timeout", "remote", "sshServerPort", "socks", "autocreate"},
 				"model.DivertDeploy":                {"driver", "namespace", "service", "deployment", "virtualServices", "hosts", "port"},
 				"model.DivertHost":                  {"virtualService", "namespace"},
 				"model.DivertVirtualService":        {"name", "namespace", "routes"},
@@ -219,12 +220,14 @@ func Test_getStructKeys(t *testing.T) {
 				"model.StackResources":              {"limits", "requests"},
 				"model.StackSecurityContext":        {"runAsUser", "runAsGroup"},
 				"model.StorageResource":             {"size", "class"},
-				"model.Sync":                        {"folders", "rescanInterval", "compression", "verbose"},
+				"model.Sync":                        {"folders", "rescanInterval", "compression", "verbose", "keepConflicts"},
 				"model.SyncFolder":                  {"localPath", "remotePath"},
-				"model.Test":                        {"image", "context", "commands", "depends_on", "caches", "artifacts", "hosts", "skipIfNoFileChanges"},
+				"model.Test":                        {"image", "context", "commands", "depends_on", "caches", "artifacts", "hosts", "skipIfNoFileChanges", "cacheResults", "timeout", "retries", "allow_failure", "shards", "services"},
+				"model.TestService":                 {"image", "environment", "ports", "healthcheck"},
 				"model.TestCommand":                 {"name", "command"},
 				"model.Timeout":                     {"default", "resources"},
 				"model.VolumeSpec":                  {"labels", "annotations", "size", "class"},
+				"model.WaitCheck":                   {"name", "http", "tcp", "status"},
 			},
 		},
 	}
@@ -59,6 +59,7 @@ type syncRaw struct {
 	RescanInterval int          `json:"rescanInterval,omitempty" yaml:"rescanInterval,omitempty"`
 	Compression    bool         `json:"compression" yaml:"compression"`
 	Verbose        bool         `json:"verbose" yaml:"verbose"`
+	KeepConflicts  bool         `json:"keepConflicts,omitempty" yaml:"keepConflicts,omitempty"`
 }
 
 type storageResourceRaw struct {
@@ -248,12 +249,13 @@ func (sync *Sync) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	sync.Verbose = rawSync.Verbose
 	sync.RescanInterval = rawSync.RescanInterval
 	sync.Folders = rawSync.Folders
+	sync.KeepConflicts = rawSync.KeepConflicts
 	return nil
 }
 
 // MarshalYAML Implements the marshaler interface of the yaml pkg.
 func (sync Sync) MarshalYAML() (interface{}, error) {
-	if !sync.Compression && sync.RescanInterval == DefaultSyncthingRescanInterval {
+	if !sync.Compression && sync.RescanInterval == DefaultSyncthingRescanInterval && !sync.KeepConflicts {
 		return sync.Folders, nil
 	}
 	return syncRaw(sync), nil
@@ -362,6 +364,7 @@ func (s Secret) MarshalYAML() (interface{}, error) {
 }
 
 // UnmarshalYAML Implements the Unmarshaler interface of the yaml pkg.
+// It supports 'remotePort:localPort' and 'remotePort:/path/to/socket', with an optional 'tcp:' or 'udp:' prefix
 func (f *Reverse) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	var raw string
 	err := unmarshal(&raw)
@@ -369,28 +372,38 @@ func (f *Reverse) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return err
 	}
 	maxReverseParts := 2
-	parts := strings.SplitN(raw, ":", maxReverseParts)
+	protocol, definition := forward.SplitProtocol(raw)
+	parts := strings.SplitN(definition, ":", maxReverseParts)
 	if len(parts) != maxReverseParts {
-		return fmt.Errorf("wrong port-forward syntax '%s', must be of the form 'localPort:RemotePort'", raw)
+		return fmt.Errorf("wrong port-forward syntax '%s', must be of the form '[protocol:]remotePort:localPort' or 'remotePort:/path/to/socket'", raw)
 	}
 	remotePort, err := strconv.Atoi(parts[0])
 	if err != nil {
 		return fmt.Errorf("cannot convert remote port '%s' in reverse '%s'", parts[0], raw)
 	}
 
+	f.Protocol = protocol
+	f.Remote = remotePort
+	if forward.IsSocketPath(parts[1]) {
+		if protocol == forward.ProtocolUDP {
+			return fmt.Errorf("reverse '%s' is not valid: Unix socket reverses only support the '%s' protocol", raw, forward.ProtocolTCP)
+		}
+		f.LocalSocket = parts[1]
+		return nil
+	}
+
 	localPort, err := strconv.Atoi(parts[1])
 	if err != nil {
 		return fmt.Errorf("cannot convert local port '%s' in reverse '%s'", parts[1], raw)
 	}
 
 	f.Local = localPort
-	f.Remote = remotePort
 	return nil
 }
 
 // MarshalYAML Implements the marshaler interface of the yaml pkg.
 func (f Reverse) MarshalYAML() (interface{}, error) {
-	return fmt.Sprintf("%d:%d", f.Remote, f.Local), nil
+	return f.String(), nil
 }
 
 // UnmarshalYAML Implements the Unmarshaler interface of the yaml pkg.
@@ -843,17 +856,34 @@ func (d *DeployCommand) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil
 }
 
+// UnmarshalYAML Implements the Unmarshaler interface of the yaml pkg.
+func (o *DeployOutput) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err == nil {
+		o.Name = name
+		return nil
+	}
+
+	type deployOutput DeployOutput // This is necessary to prevent recursion
+	var output deployOutput
+	if err := unmarshal(&output); err != nil {
+		return err
+	}
+	*o = DeployOutput(output)
+	return nil
+}
+
 func (d *DeployInfo) MarshalYAML() (interface{}, error) {
 	if d.ComposeSection != nil && len(d.ComposeSection.ComposesInfo) != 0 {
 		return d, nil
 	}
 	isCommandList := true
 	for _, cmd := range d.Commands {
-		if cmd.Command != cmd.Name {
+		if cmd.Command != cmd.Name || len(cmd.Outputs) > 0 {
 			isCommandList = false
 		}
 	}
-	if isCommandList {
+	if isCommandList && len(d.Wait) == 0 {
 		var result []string
 		for _, cmd := range d.Commands {
 			result = append(result, cmd.Command)
@@ -64,6 +64,21 @@ func TestReverseMarshalling(t *testing.T) {
 			data:      "8080:svc",
 			expectErr: true,
 		},
+		{
+			name:     "udp",
+			data:     "udp:8125:8125",
+			expected: Reverse{Local: 8125, Remote: 8125, Protocol: forward.ProtocolUDP},
+		},
+		{
+			name:     "socket",
+			data:     "9229:/tmp/debug.sock",
+			expected: Reverse{LocalSocket: "/tmp/debug.sock", Remote: 9229},
+		},
+		{
+			name:      "udp-socket",
+			data:      "udp:9229:/tmp/debug.sock",
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -957,7 +972,8 @@ func TestSyncUnmarshalling(t *testing.T) {
   - .:/usr/src/app
 compression: false
 verbose: true
-rescanInterval: 10`),
+rescanInterval: 10
+keepConflicts: true`),
 			expected: Sync{
 				Folders: []SyncFolder{
 					{
@@ -967,6 +983,7 @@ rescanInterval: 10`),
 				Compression:    false,
 				Verbose:        true,
 				RescanInterval: 10,
+				KeepConflicts:  true,
 			},
 		},
 	}
@@ -2199,6 +2216,17 @@ func TestDeployInfoMarshalling(t *testing.T) {
 			}},
 			expected: "context: .\ncommands:\n- name: build\n  command: okteto build\n- name: deploy\n  command: okteto deploy\n",
 		},
+		{
+			name: "cmd-with-outputs",
+			deployInfo: &DeployInfo{Commands: []DeployCommand{
+				{
+					Name:    "./create-db.sh",
+					Command: "./create-db.sh",
+					Outputs: []DeployOutput{{Name: "DATABASE_URL", Sensitive: true}},
+				},
+			}},
+			expected: "context: .\ncommands:\n- name: ./create-db.sh\n  command: ./create-db.sh\n  outputs:\n  - name: DATABASE_URL\n    sensitive: true\n",
+		},
 	}
 
 	for _, tt := range tests {
@@ -2215,6 +2243,29 @@ func TestDeployInfoMarshalling(t *testing.T) {
 	}
 }
 
+func TestDeployOutputUnmarshalling(t *testing.T) {
+	manifest := []byte(`- name: Create database
+  command: ./create-db.sh
+  outputs:
+  - DATABASE_HOST
+  - name: DATABASE_PASSWORD
+    sensitive: true`)
+	var commands []DeployCommand
+	require.NoError(t, yaml.Unmarshal(manifest, &commands))
+
+	expected := []DeployCommand{
+		{
+			Name:    "Create database",
+			Command: "./create-db.sh",
+			Outputs: []DeployOutput{
+				{Name: "DATABASE_HOST"},
+				{Name: "DATABASE_PASSWORD", Sensitive: true},
+			},
+		},
+	}
+	assert.Equal(t, expected, commands)
+}
+
 func TestComposeSectionInfoUnmarshalling(t *testing.T) {
 	tests := []struct {
 		expected            *ComposeSectionInfo
@@ -16,8 +16,10 @@ package model
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/okteto/okteto/pkg/env"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 type Test struct {
@@ -29,8 +31,32 @@ type Test struct {
 	Artifacts           []Artifact    `yaml:"artifacts,omitempty"`
 	Hosts               []Host        `yaml:"hosts,omitempty"`
 	SkipIfNoFileChanges bool          `yaml:"skipIfNoFileChanges,omitempty"`
+	CacheResults        bool          `yaml:"cacheResults,omitempty"`
+	Timeout             time.Duration `yaml:"timeout,omitempty"`
+	Retries             int           `yaml:"retries,omitempty"`
+	AllowFailure        bool          `yaml:"allow_failure,omitempty"`
+	Shards              int           `yaml:"shards,omitempty"`
+
+	Services map[string]*TestService `yaml:"services,omitempty"`
+}
+
+// TestService is a container started in the namespace before a test container runs, and removed
+// once it finishes. Its commands reach it using the name of the service as hostname
+type TestService struct {
+	Image       string          `yaml:"image,omitempty"`
+	Environment env.Environment `yaml:"environment,omitempty"`
+	Ports       []int32         `yaml:"ports,omitempty"`
+	Healthcheck *HealthCheck    `yaml:"healthcheck,omitempty"`
 }
 
+const (
+	// MaxTestRetries is the maximum number of retries of a test
+	MaxTestRetries = 10
+
+	// MaxTestShards is the maximum number of shards of a test
+	MaxTestShards = 32
+)
+
 type Host struct {
 	Hostname string `yaml:"hostname,omitempty"`
 	IP       string `yaml:"ip,omitempty"`
@@ -57,6 +83,20 @@ func (test ManifestTests) Validate() error {
 		if t == nil || len(t.Commands) == 0 {
 			return fmt.Errorf("test '%s' is invalid: no commands defined", k)
 		}
+		if t.Timeout < 0 {
+			return fmt.Errorf("test '%s' is invalid: timeout must be a positive duration", k)
+		}
+		if t.Retries < 0 || t.Retries > MaxTestRetries {
+			return fmt.Errorf("test '%s' is invalid: retries must be between 0 and %d", k, MaxTestRetries)
+		}
+		if t.Shards < 0 || t.Shards > MaxTestShards {
+			return fmt.Errorf("test '%s' is invalid: shards must be between 0 and %d", k, MaxTestShards)
+		}
+		for name, svc := range t.Services {
+			if err := svc.validate(name); err != nil {
+				return fmt.Errorf("test '%s' is invalid: %w", k, err)
+			}
+		}
 	}
 	return nil
 }
@@ -69,6 +109,58 @@ func (test *Test) expandEnvVars() error {
 			return err
 		}
 	}
+	for _, svc := range test.Services {
+		if svc == nil {
+			continue
+		}
+		svc.Image, err = env.ExpandEnv(svc.Image)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalYAML keeps the 'CMD-SHELL' form of the healthcheck test, as the command must run in a shell
+func (svc *TestService) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type testServiceAlias TestService
+	var s testServiceAlias
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var raw map[string]interface{}
+	if err := unmarshal(&raw); err == nil && s.Healthcheck != nil {
+		var test []interface{}
+		switch healthcheck := raw["healthcheck"].(type) {
+		case map[interface{}]interface{}:
+			test, _ = healthcheck["test"].([]interface{})
+		case map[string]interface{}:
+			test, _ = healthcheck["test"].([]interface{})
+		}
+		if len(test) == 2 && test[0] == "CMD-SHELL" {
+			s.Healthcheck.Test = HealtcheckTest{"CMD-SHELL", fmt.Sprint(test[1])}
+		}
+	}
+	*svc = TestService(s)
+	return nil
+}
+
+func (svc *TestService) validate(name string) error {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("service name '%s' must be a valid DNS label: %s", name, strings.Join(errs, ", "))
+	}
+	if svc == nil || svc.Image == "" {
+		return fmt.Errorf("service '%s' must define an image", name)
+	}
+	for _, port := range svc.Ports {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("service '%s' has an invalid port %d", name, port)
+		}
+	}
+	if err := validateHealthcheck(svc.Healthcheck); err != nil {
+		return fmt.Errorf("service '%s' has an invalid healthcheck: %w", name, err)
+	}
 	return nil
 }
 
@@ -14,7 +14,9 @@
 package model
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,6 +57,78 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "timeout, retries and allow_failure",
+			tests: ManifestTests{
+				"one": &Test{
+					Commands:     []TestCommand{{Command: "echo 'hello'"}},
+					Timeout:      5 * time.Minute,
+					Retries:      2,
+					AllowFailure: true,
+					Shards:       4,
+				},
+			},
+		},
+		{
+			name: "negative timeout",
+			tests: ManifestTests{
+				"one": &Test{
+					Commands: []TestCommand{{Command: "echo 'hello'"}},
+					Timeout:  -time.Second,
+				},
+			},
+			expectAnError: true,
+		},
+		{
+			name: "negative retries",
+			tests: ManifestTests{
+				"one": &Test{
+					Commands: []TestCommand{{Command: "echo 'hello'"}},
+					Retries:  -1,
+				},
+			},
+			expectAnError: true,
+		},
+		{
+			name: "service without image",
+			tests: ManifestTests{
+				"one": &Test{
+					Commands: []TestCommand{{Command: "echo 'hello'"}},
+					Services: map[string]*TestService{"db": {}},
+				},
+			},
+			expectAnError: true,
+		},
+		{
+			name: "service with invalid name",
+			tests: ManifestTests{
+				"one": &Test{
+					Commands: []TestCommand{{Command: "echo 'hello'"}},
+					Services: map[string]*TestService{"my_db": {Image: "postgres:16"}},
+				},
+			},
+			expectAnError: true,
+		},
+		{
+			name: "too many shards",
+			tests: ManifestTests{
+				"one": &Test{
+					Commands: []TestCommand{{Command: "echo 'hello'"}},
+					Shards:   MaxTestShards + 1,
+				},
+			},
+			expectAnError: true,
+		},
+		{
+			name: "too many retries",
+			tests: ManifestTests{
+				"one": &Test{
+					Commands: []TestCommand{{Command: "echo 'hello'"}},
+					Retries:  MaxTestRetries + 1,
+				},
+			},
+			expectAnError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -173,3 +247,89 @@ ip: ${NON_EXISTENT}`),
 		})
 	}
 }
+
+func TestTestUnmarshalYAMLTimeoutRetries(t *testing.T) {
+	var test Test
+	manifest := `
+commands:
+  - make e2e
+timeout: 10m
+retries: 2
+allow_failure: true`
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &test))
+	assert.Equal(t, 10*time.Minute, test.Timeout)
+	assert.Equal(t, 2, test.Retries)
+	assert.True(t, test.AllowFailure)
+}
+
+func TestTestUnmarshalYAMLServices(t *testing.T) {
+	var test Test
+	manifest := `
+commands:
+  - make integration
+services:
+  db:
+    image: postgres:16
+    environment:
+      POSTGRES_PASSWORD: password
+    ports:
+      - 5432
+    healthcheck:
+      test: pg_isready -U postgres
+      interval: 5s`
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &test))
+	require.Contains(t, test.Services, "db")
+	db := test.Services["db"]
+	assert.Equal(t, "postgres:16", db.Image)
+	assert.Equal(t, []int32{5432}, db.Ports)
+	require.Len(t, db.Environment, 1)
+	assert.Equal(t, "POSTGRES_PASSWORD", db.Environment[0].Name)
+	require.NotNil(t, db.Healthcheck)
+	assert.Equal(t, HealtcheckTest{"pg_isready", "-U", "postgres"}, db.Healthcheck.Test)
+	assert.True(t, db.Healthcheck.Readiness)
+
+	assert.NoError(t, ManifestTests{"integration": &test}.Validate())
+}
+
+func TestTestServiceUnmarshalYAMLHealthcheckForms(t *testing.T) {
+	tests := []struct {
+		name     string
+		test     string
+		expected HealtcheckTest
+	}{
+		{
+			name:     "string",
+			test:     `pg_isready -U postgres`,
+			expected: HealtcheckTest{"pg_isready", "-U", "postgres"},
+		},
+		{
+			name:     "CMD",
+			test:     `["CMD", "pg_isready", "-U", "postgres"]`,
+			expected: HealtcheckTest{"pg_isready", "-U", "postgres"},
+		},
+		{
+			name:     "CMD-SHELL",
+			test:     `["CMD-SHELL", "pg_isready -U postgres || exit 1"]`,
+			expected: HealtcheckTest{"CMD-SHELL", "pg_isready -U postgres || exit 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, err := Read([]byte(fmt.Sprintf(`
+test:
+  integration:
+    commands: [make integration]
+    services:
+      db:
+        image: postgres:16
+        healthcheck:
+          test: %s`, tt.test)))
+			require.NoError(t, err)
+			db := manifest.Test["integration"].Services["db"]
+			require.NotNil(t, db.Healthcheck)
+			assert.Equal(t, tt.expected, db.Healthcheck.Test)
+			assert.Equal(t, "postgres:16", db.Image)
+			assert.NoError(t, manifest.Test.Validate())
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	probeInterval = 2 * time.Second
+	probeTimeout  = 5 * time.Second
+)
+
+// Probe is an HTTP or TCP check that must pass for a deploy to be ready
+type Probe struct {
+	// Name identifies the probe in the pending items. It defaults to the URL or the address
+	Name string
+	// HTTP is the URL that must answer with the expected status
+	HTTP string
+	// TCP is the address, as host:port, that must accept connections
+	TCP string
+	// Status is the status code expected from the URL. Any 2xx or 3xx status is accepted when it's not set
+	Status int
+}
+
+func (p Probe) String() string {
+	switch {
+	case p.Name != "":
+		return p.Name
+	case p.HTTP != "":
+		return p.HTTP
+	default:
+		return p.TCP
+	}
+}
+
+// ProbeChecker runs the probes periodically until each of them passes once
+type ProbeChecker struct {
+	client  *http.Client
+	dialer  *net.Dialer
+	pending map[int]Pending
+	probes  []Probe
+	lock    sync.Mutex
+}
+
+// NewProbeChecker returns a ProbeChecker for probes
+func NewProbeChecker(probes []Probe) *ProbeChecker {
+	pending := map[int]Pending{}
+	for i, p := range probes {
+		pending[i] = Pending{Name: p.String(), Message: "not checked yet"}
+	}
+	return &ProbeChecker{
+		client:  &http.Client{Timeout: probeTimeout},
+		dialer:  &net.Dialer{Timeout: probeTimeout},
+		probes:  probes,
+		pending: pending,
+	}
+}
+
+// Start runs every probe until it passes or ctx is done
+func (pc *ProbeChecker) Start(ctx context.Context, notify func()) error {
+	for i := range pc.probes {
+		go pc.run(ctx, i, notify)
+	}
+	return nil
+}
+
+// Pending returns the probes that haven't passed yet
+func (pc *ProbeChecker) Pending() []Pending {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+
+	result := make([]Pending, 0, len(pc.pending))
+	for _, p := range pc.pending {
+		result = append(result, p)
+	}
+	return result
+}
+
+func (pc *ProbeChecker) run(ctx context.Context, i int, notify func()) {
+	probe := pc.probes[i]
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+	for {
+		err := pc.check(ctx, probe)
+
+		pc.lock.Lock()
+		if err == nil {
+			delete(pc.pending, i)
+		} else {
+			pc.pending[i] = Pending{Name: probe.String(), Message: err.Error()}
+		}
+		pc.lock.Unlock()
+		notify()
+
+		if err == nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (pc *ProbeChecker) check(ctx context.Context, probe Probe) error {
+	if probe.TCP != "" {
+		conn, err := pc.dialer.DialContext(ctx, "tcp", probe.TCP)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.HTTP, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := pc.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if probe.Status != 0 {
+		if resp.StatusCode != probe.Status {
+			return fmt.Errorf("got status %d, expected %d", resp.StatusCode, probe.Status)
+		}
+		return nil
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("got status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProbeCheckerCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/unauthorized" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	tests := []struct {
+		name        string
+		expectedErr string
+		probe       Probe
+	}{
+		{
+			name:  "http ok",
+			probe: Probe{HTTP: server.URL + "/healthz"},
+		},
+		{
+			name:        "http error status",
+			probe:       Probe{HTTP: server.URL + "/unauthorized"},
+			expectedErr: "got status 401",
+		},
+		{
+			name:  "http expected status",
+			probe: Probe{HTTP: server.URL + "/unauthorized", Status: http.StatusUnauthorized},
+		},
+		{
+			name:        "http unexpected status",
+			probe:       Probe{HTTP: server.URL + "/healthz", Status: http.StatusNoContent},
+			expectedErr: "got status 200, expected 204",
+		},
+		{
+			name:  "tcp ok",
+			probe: Probe{TCP: server.Listener.Addr().String()},
+		},
+		{
+			name:        "tcp refused",
+			probe:       Probe{TCP: closedAddr},
+			expectedErr: "connection refused",
+		},
+	}
+
+	pc := NewProbeChecker(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pc.check(context.Background(), tt.probe)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestProbeCheckerWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	pc := NewProbeChecker([]Probe{{Name: "api", HTTP: server.URL}})
+	assert.Equal(t, []Pending{{Name: "api", Message: "not checked yet"}}, pc.Pending())
+	require.NoError(t, Wait(context.Background(), []Checker{pc}, nil))
+	assert.Empty(t, pc.Pending())
+}
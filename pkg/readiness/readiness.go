@@ -0,0 +1,128 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Pending is an item that is not ready yet and the reason why
+type Pending struct {
+	Name    string
+	Message string
+	Failed  bool
+}
+
+func (p Pending) String() string {
+	if p.Message == "" {
+		return p.Name
+	}
+	return fmt.Sprintf("%s: %s", p.Name, p.Message)
+}
+
+// Checker tracks the readiness of a set of items, like the resources of a development environment
+// or the checks declared in its manifest
+type Checker interface {
+	// Start begins tracking the items and calls notify every time their readiness changes.
+	// The tracking stops when ctx is done
+	Start(ctx context.Context, notify func()) error
+
+	// Pending returns the items that are not ready
+	Pending() []Pending
+}
+
+// NotReadyError is returned when some items are not ready when the wait finishes
+type NotReadyError struct {
+	Err     error
+	Pending []Pending
+}
+
+func (e *NotReadyError) Error() string {
+	items := make([]string, 0, len(e.Pending))
+	for _, p := range e.Pending {
+		items = append(items, fmt.Sprintf("\n    - %s", p))
+	}
+	return fmt.Sprintf("%s:%s", e.Err, strings.Join(items, ""))
+}
+
+func (e *NotReadyError) Unwrap() error {
+	return e.Err
+}
+
+var errFailed = errors.New("some resources failed")
+
+// Wait blocks until all the items of the checkers are ready, an item fails or ctx is done.
+// onChange is called with the pending items every time they change
+func Wait(ctx context.Context, checkers []Checker, onChange func([]Pending)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	changes := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+	for _, c := range checkers {
+		if err := c.Start(ctx, notify); err != nil {
+			return err
+		}
+	}
+	notify()
+
+	var last []Pending
+	for {
+		select {
+		case <-ctx.Done():
+			return &NotReadyError{Err: ctx.Err(), Pending: last}
+		case <-changes:
+			pending := collectPending(checkers)
+			if len(pending) == 0 {
+				return nil
+			}
+
+			var failed []Pending
+			for _, p := range pending {
+				if p.Failed {
+					failed = append(failed, p)
+				}
+			}
+			if len(failed) > 0 {
+				return &NotReadyError{Err: errFailed, Pending: failed}
+			}
+
+			if !reflect.DeepEqual(pending, last) && onChange != nil {
+				onChange(pending)
+			}
+			last = pending
+		}
+	}
+}
+
+func collectPending(checkers []Checker) []Pending {
+	var result []Pending
+	for _, c := range checkers {
+		result = append(result, c.Pending()...)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
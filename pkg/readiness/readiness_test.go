@@ -0,0 +1,97 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChecker struct {
+	notify  func()
+	startFn func()
+	pending []Pending
+	lock    sync.Mutex
+}
+
+func (fc *fakeChecker) Start(_ context.Context, notify func()) error {
+	fc.lock.Lock()
+	fc.notify = notify
+	fc.lock.Unlock()
+	if fc.startFn != nil {
+		go fc.startFn()
+	}
+	return nil
+}
+
+func (fc *fakeChecker) Pending() []Pending {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	return fc.pending
+}
+
+func (fc *fakeChecker) set(pending []Pending) {
+	fc.lock.Lock()
+	fc.pending = pending
+	notify := fc.notify
+	fc.lock.Unlock()
+	notify()
+}
+
+func TestWaitReady(t *testing.T) {
+	fc := &fakeChecker{pending: []Pending{{Name: "deployment/api", Message: "0 of 1 replicas available"}}}
+	fc.startFn = func() {
+		fc.set(nil)
+	}
+
+	var changes [][]Pending
+	err := Wait(context.Background(), []Checker{fc}, func(p []Pending) {
+		changes = append(changes, p)
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(changes), 1)
+}
+
+func TestWaitFailed(t *testing.T) {
+	resources := &fakeChecker{pending: []Pending{{Name: "job/migrate", Message: "BackoffLimitExceeded", Failed: true}}}
+	probes := &fakeChecker{pending: []Pending{{Name: "api", Message: "connection refused"}}}
+
+	err := Wait(context.Background(), []Checker{resources, probes}, nil)
+	var notReady *NotReadyError
+	require.ErrorAs(t, err, &notReady)
+	assert.ErrorIs(t, err, errFailed)
+	assert.Equal(t, []Pending{{Name: "job/migrate", Message: "BackoffLimitExceeded", Failed: true}}, notReady.Pending)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	fc := &fakeChecker{pending: []Pending{
+		{Name: "service/web", Message: "waiting for the load balancer address"},
+		{Name: "deployment/api", Message: "0 of 1 replicas available"},
+	}}
+
+	var changes [][]Pending
+	err := Wait(ctx, []Checker{fc}, func(p []Pending) {
+		changes = append(changes, p)
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, "context deadline exceeded:\n    - deployment/api: 0 of 1 replicas available\n    - service/web: waiting for the load balancer address", err.Error())
+	assert.Len(t, changes, 1)
+}
@@ -0,0 +1,282 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/okteto/okteto/pkg/k8s/inventory"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	listParallelism = 10
+	rewatchInterval = time.Second
+)
+
+// skippedResources are never labeled by a deploy and are too many to list them on every wait
+var skippedResources = map[string]bool{
+	"events": true,
+}
+
+// ResourceChecker tracks the readiness of the resources of a namespace that match a label selector. The resources
+// are listed once and then watched, so their readiness is updated as soon as their controllers report it.
+// Resources owned by a controller, like the pods of a deployment, are tracked through their owner
+type ResourceChecker struct {
+	dynamic   dynamic.Interface
+	discovery discovery.DiscoveryInterface
+	results   map[string]Pending
+	notify    func()
+	namespace string
+	selector  string
+	lock      sync.Mutex
+}
+
+type watchedResource struct {
+	gvr  schema.GroupVersionResource
+	kind string
+}
+
+func (r watchedResource) key(name string) string {
+	return fmt.Sprintf("%s/%s", r.gvr.String(), name)
+}
+
+// NewResourceChecker returns a ResourceChecker for the resources of namespace that match selector
+func NewResourceChecker(dynClient dynamic.Interface, discClient discovery.DiscoveryInterface, namespace, selector string) *ResourceChecker {
+	return &ResourceChecker{
+		dynamic:   dynClient,
+		discovery: discClient,
+		namespace: namespace,
+		selector:  selector,
+		results:   map[string]Pending{},
+		notify:    func() {},
+	}
+}
+
+// Start lists the resources of every kind of the namespace and watches them until ctx is done
+func (rc *ResourceChecker) Start(ctx context.Context, notify func()) error {
+	rc.notify = notify
+	resources, err := rc.getWatchableResources()
+	if err != nil {
+		return err
+	}
+
+	eg := errgroup.Group{}
+	eg.SetLimit(listParallelism)
+	for _, r := range resources {
+		r := r
+		eg.Go(func() error {
+			resourceVersion, err := rc.list(ctx, r)
+			if err != nil {
+				oktetoLog.Infof("could not list %s: %s", r.gvr, err)
+				return nil
+			}
+			go rc.watch(ctx, r, resourceVersion)
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// Pending returns the resources that are not ready
+func (rc *ResourceChecker) Pending() []Pending {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	result := make([]Pending, 0, len(rc.results))
+	for _, p := range rc.results {
+		result = append(result, p)
+	}
+	return result
+}
+
+// getWatchableResources returns the preferred version of the namespaced resources that can be listed and watched
+func (rc *ResourceChecker) getWatchableResources() ([]watchedResource, error) {
+	_, lists, err := rc.discovery.ServerGroupsAndResources()
+	if err != nil {
+		var groupErr *discovery.ErrGroupDiscoveryFailed
+		if !errors.As(err, &groupErr) {
+			return nil, err
+		}
+		oktetoLog.Infof("some resources won't be checked: %s", err)
+	}
+
+	seen := map[schema.GroupResource]bool{}
+	var result []watchedResource
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, res := range list.APIResources {
+			if !res.Namespaced || strings.Contains(res.Name, "/") || skippedResources[res.Name] {
+				continue
+			}
+			if !hasVerbs(res, "list", "watch") {
+				continue
+			}
+			gr := schema.GroupResource{Group: gv.Group, Resource: res.Name}
+			if seen[gr] {
+				continue
+			}
+			seen[gr] = true
+			result = append(result, watchedResource{gvr: gv.WithResource(res.Name), kind: res.Kind})
+		}
+	}
+	return result, nil
+}
+
+func hasVerbs(res metav1.APIResource, verbs ...string) bool {
+	for _, verb := range verbs {
+		found := false
+		for _, v := range res.Verbs {
+			if v == verb {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// list replaces the resources of a kind with the ones in the cluster and returns the resource version to watch from
+func (rc *ResourceChecker) list(ctx context.Context, r watchedResource) (string, error) {
+	list, err := rc.dynamic.Resource(r.gvr).Namespace(rc.namespace).List(ctx, metav1.ListOptions{LabelSelector: rc.selector})
+	if err != nil {
+		return "", err
+	}
+
+	rc.lock.Lock()
+	prefix := r.key("")
+	for k := range rc.results {
+		if strings.HasPrefix(k, prefix) {
+			delete(rc.results, k)
+		}
+	}
+	for i := range list.Items {
+		rc.setLocked(r, &list.Items[i])
+	}
+	rc.lock.Unlock()
+
+	rc.notify()
+	return list.GetResourceVersion(), nil
+}
+
+// watch updates the resources of a kind until ctx is done. When the watch can't continue from the last
+// resource version, the resources are listed again
+func (rc *ResourceChecker) watch(ctx context.Context, r watchedResource, resourceVersion string) {
+	client := rc.dynamic.Resource(r.gvr).Namespace(rc.namespace)
+	for {
+		if resourceVersion != "" {
+			w, err := client.Watch(ctx, metav1.ListOptions{
+				LabelSelector:       rc.selector,
+				ResourceVersion:     resourceVersion,
+				AllowWatchBookmarks: true,
+			})
+			if err != nil {
+				oktetoLog.Infof("could not watch %s: %s", r.gvr, err)
+				resourceVersion = ""
+			} else {
+				resourceVersion = rc.handleEvents(r, w, resourceVersion)
+				w.Stop()
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if resourceVersion != "" {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rewatchInterval):
+		}
+		rv, err := rc.list(ctx, r)
+		if err != nil {
+			oktetoLog.Infof("could not list %s: %s", r.gvr, err)
+			continue
+		}
+		resourceVersion = rv
+	}
+}
+
+// handleEvents updates the resources until the watch is closed and returns the last resource version seen,
+// or an empty string if the watch failed
+func (rc *ResourceChecker) handleEvents(r watchedResource, w watch.Interface, resourceVersion string) string {
+	for event := range w.ResultChan() {
+		if event.Type == watch.Error {
+			return ""
+		}
+		u, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		resourceVersion = u.GetResourceVersion()
+
+		switch event.Type {
+		case watch.Added, watch.Modified:
+			rc.lock.Lock()
+			rc.setLocked(r, u)
+			rc.lock.Unlock()
+		case watch.Deleted:
+			rc.lock.Lock()
+			delete(rc.results, r.key(u.GetName()))
+			rc.lock.Unlock()
+		default:
+			continue
+		}
+		rc.notify()
+	}
+	return resourceVersion
+}
+
+func (rc *ResourceChecker) setLocked(r watchedResource, u *unstructured.Unstructured) {
+	key := r.key(u.GetName())
+	if metav1.GetControllerOf(u) != nil {
+		delete(rc.results, key)
+		return
+	}
+	if u.GetKind() == "" {
+		u.SetKind(r.kind)
+	}
+
+	result := Compute(u)
+	if result.Status == Current {
+		delete(rc.results, key)
+		return
+	}
+	name := inventory.Resource{Group: r.gvr.Group, Kind: u.GetKind(), Resource: r.gvr.Resource, Name: u.GetName()}
+	rc.results[key] = Pending{
+		Name:    name.String(),
+		Message: result.Message,
+		Failed:  result.Status == Failed,
+	}
+}
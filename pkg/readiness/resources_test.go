@@ -0,0 +1,116 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testSelector = "dev.okteto.com/deployed-by=movies"
+
+var deploymentsGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+func newTestDeployment(name string, available int64, labels map[string]string) *unstructured.Unstructured {
+	u := newObject("apps/v1", "Deployment", map[string]interface{}{
+		"spec":   map[string]interface{}{"replicas": int64(1)},
+		"status": map[string]interface{}{"replicas": int64(1), "updatedReplicas": int64(1), "availableReplicas": available},
+	})
+	u.SetName(name)
+	u.SetNamespace("test")
+	u.SetLabels(labels)
+	return u
+}
+
+func newTestResourceChecker(t *testing.T, objects ...runtime.Object) (*ResourceChecker, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+	dynClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			deploymentsGVR:                      "DeploymentList",
+			{Version: "v1", Resource: "events"}: "EventList",
+		},
+		objects...,
+	)
+	disc, ok := fake.NewSimpleClientset().Discovery().(*fakediscovery.FakeDiscovery)
+	require.True(t, ok)
+	disc.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "events", Kind: "Event", Namespaced: true, Verbs: []string{"list", "watch"}},
+				{Name: "namespaces", Kind: "Namespace", Verbs: []string{"list", "watch"}},
+				{Name: "pods/log", Kind: "Pod", Namespaced: true, Verbs: []string{"get"}},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"list", "watch"}},
+			},
+		},
+	}
+	return NewResourceChecker(dynClient, disc, "test", testSelector), dynClient
+}
+
+func TestResourceCheckerWatchableResources(t *testing.T) {
+	rc, _ := newTestResourceChecker(t)
+	resources, err := rc.getWatchableResources()
+	require.NoError(t, err)
+	assert.Equal(t, []watchedResource{{gvr: deploymentsGVR, kind: "Deployment"}}, resources)
+}
+
+func TestResourceCheckerPending(t *testing.T) {
+	labels := map[string]string{"dev.okteto.com/deployed-by": "movies"}
+	rc, dynClient := newTestResourceChecker(t,
+		newTestDeployment("api", 0, labels),
+		newTestDeployment("frontend", 1, labels),
+		newTestDeployment("other", 0, map[string]string{"dev.okteto.com/deployed-by": "other"}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, rc.Start(ctx, func() {}))
+	assert.Equal(t, []Pending{{Name: "deployment.apps/api", Message: "0 of 1 replicas available"}}, rc.Pending())
+
+	_, err := dynClient.Resource(deploymentsGVR).Namespace("test").UpdateStatus(ctx, newTestDeployment("api", 1, labels), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(rc.Pending()) == 0
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestResourceCheckerSkipsOwnedResources(t *testing.T) {
+	labels := map[string]string{"dev.okteto.com/deployed-by": "movies"}
+	owned := newTestDeployment("api", 0, labels)
+	isController := true
+	owned.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "api", Controller: &isController}})
+	rc, _ := newTestResourceChecker(t, owned)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, rc.Start(ctx, func() {}))
+	assert.Empty(t, rc.Pending())
+}
@@ -0,0 +1,350 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Status is the readiness of a resource, following the conventions of kstatus
+type Status string
+
+const (
+	// Current the resource is ready
+	Current Status = "Current"
+
+	// InProgress the resource is being reconciled by its controller
+	InProgress Status = "InProgress"
+
+	// Failed the resource won't be ready unless it changes
+	Failed Status = "Failed"
+)
+
+// Result is the readiness of a resource and the reason why it isn't ready
+type Result struct {
+	Status  Status
+	Message string
+}
+
+// StatusFunc computes the readiness of a kind of resource
+type StatusFunc func(u *unstructured.Unstructured) Result
+
+var (
+	statusFuncs = map[schema.GroupKind]StatusFunc{
+		{Group: "apps", Kind: "Deployment"}:                     deploymentStatus,
+		{Group: "apps", Kind: "StatefulSet"}:                    statefulSetStatus,
+		{Group: "apps", Kind: "DaemonSet"}:                      daemonSetStatus,
+		{Group: "batch", Kind: "Job"}:                           jobStatus,
+		{Group: "batch", Kind: "CronJob"}:                       currentStatus,
+		{Kind: "Pod"}:                                           podStatus,
+		{Kind: "PersistentVolumeClaim"}:                         pvcStatus,
+		{Kind: "Service"}:                                       serviceStatus,
+		{Group: "networking.k8s.io", Kind: "Ingress"}:           currentStatus,
+		{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute"}: httpRouteStatus,
+		{Group: "argoproj.io", Kind: "Rollout"}:                 rolloutStatus,
+	}
+	statusFuncsLock sync.RWMutex
+)
+
+// Register sets the function that computes the readiness of a kind of resource. Kinds without a function
+// are ready when their 'Ready' condition is true or when they don't have one
+func Register(gk schema.GroupKind, fn StatusFunc) {
+	statusFuncsLock.Lock()
+	defer statusFuncsLock.Unlock()
+	statusFuncs[gk] = fn
+}
+
+// Compute returns the readiness of a resource
+func Compute(u *unstructured.Unstructured) Result {
+	if u.GetDeletionTimestamp() != nil {
+		return inProgress("being deleted")
+	}
+	if result, ok := genericStatus(u); ok {
+		return result
+	}
+
+	statusFuncsLock.RLock()
+	fn, ok := statusFuncs[u.GroupVersionKind().GroupKind()]
+	statusFuncsLock.RUnlock()
+	if ok {
+		return fn(u)
+	}
+	return readyConditionStatus(u)
+}
+
+func inProgress(format string, a ...interface{}) Result {
+	return Result{Status: InProgress, Message: fmt.Sprintf(format, a...)}
+}
+
+func failed(format string, a ...interface{}) Result {
+	return Result{Status: Failed, Message: fmt.Sprintf(format, a...)}
+}
+
+// currentStatus is used for the kinds that are ready as soon as they exist. Ingresses are ready without an address,
+// like in kstatus, because many ingress controllers never report one
+func currentStatus(_ *unstructured.Unstructured) Result {
+	return Result{Status: Current}
+}
+
+// genericStatus applies the rules shared by all the kinds: the controller must have observed the last
+// generation of the resource, and the 'Reconciling' and 'Stalled' conditions
+func genericStatus(u *unstructured.Unstructured) (Result, bool) {
+	observed, found, err := unstructured.NestedInt64(u.Object, "status", "observedGeneration")
+	if err == nil && found && observed < u.GetGeneration() {
+		return inProgress("waiting for the controller to observe generation %d", u.GetGeneration()), true
+	}
+	if c, ok := getCondition(u, "Stalled"); ok && c.status == "True" {
+		return failed("%s", c.describe()), true
+	}
+	if c, ok := getCondition(u, "Reconciling"); ok && c.status == "True" {
+		return inProgress("%s", c.describe()), true
+	}
+	return Result{}, false
+}
+
+// readyConditionStatus is the rule for the kinds without a status function, like Knative Services or cert-manager Certificates
+func readyConditionStatus(u *unstructured.Unstructured) Result {
+	c, ok := getCondition(u, "Ready")
+	if !ok || c.status == "True" {
+		return Result{Status: Current}
+	}
+	return inProgress("%s", c.describe())
+}
+
+type condition struct {
+	status  string
+	reason  string
+	message string
+}
+
+func (c condition) describe() string {
+	switch {
+	case c.reason != "" && c.message != "":
+		return fmt.Sprintf("%s: %s", c.reason, c.message)
+	case c.message != "":
+		return c.message
+	case c.reason != "":
+		return c.reason
+	default:
+		return "not ready"
+	}
+}
+
+func getCondition(u *unstructured.Unstructured, conditionType string) (condition, bool) {
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	return findCondition(conditions, conditionType)
+}
+
+func findCondition(conditions []interface{}, conditionType string) (condition, bool) {
+	for _, item := range conditions {
+		c, ok := item.(map[string]interface{})
+		if !ok || c["type"] != conditionType {
+			continue
+		}
+		status, _ := c["status"].(string)
+		reason, _ := c["reason"].(string)
+		message, _ := c["message"].(string)
+		return condition{status: status, reason: reason, message: message}, true
+	}
+	return condition{}, false
+}
+
+func getInt64(u *unstructured.Unstructured, fields ...string) int64 {
+	v, _, _ := unstructured.NestedInt64(u.Object, fields...)
+	return v
+}
+
+func getReplicas(u *unstructured.Unstructured) int64 {
+	replicas, found, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if !found {
+		return 1
+	}
+	return replicas
+}
+
+func deploymentStatus(u *unstructured.Unstructured) Result {
+	if c, ok := getCondition(u, "Progressing"); ok && c.reason == "ProgressDeadlineExceeded" {
+		return failed("%s", c.describe())
+	}
+
+	replicas := getReplicas(u)
+	updated := getInt64(u, "status", "updatedReplicas")
+	if updated < replicas {
+		return inProgress("%d of %d replicas updated", updated, replicas)
+	}
+	if total := getInt64(u, "status", "replicas"); total > updated {
+		return inProgress("%d old replicas pending termination", total-updated)
+	}
+	if available := getInt64(u, "status", "availableReplicas"); available < replicas {
+		return inProgress("%d of %d replicas available", available, replicas)
+	}
+	return Result{Status: Current}
+}
+
+func statefulSetStatus(u *unstructured.Unstructured) Result {
+	replicas := getReplicas(u)
+	if ready := getInt64(u, "status", "readyReplicas"); ready < replicas {
+		return inProgress("%d of %d replicas ready", ready, replicas)
+	}
+
+	strategy, _, _ := unstructured.NestedString(u.Object, "spec", "updateStrategy", "type")
+	if strategy == "OnDelete" {
+		return Result{Status: Current}
+	}
+	partition := getInt64(u, "spec", "updateStrategy", "rollingUpdate", "partition")
+	if updated := getInt64(u, "status", "updatedReplicas"); updated < replicas-partition {
+		return inProgress("%d of %d replicas updated", updated, replicas-partition)
+	}
+	if partition == 0 {
+		current, _, _ := unstructured.NestedString(u.Object, "status", "currentRevision")
+		update, _, _ := unstructured.NestedString(u.Object, "status", "updateRevision")
+		if current != update {
+			return inProgress("waiting for the rolling update to finish")
+		}
+	}
+	return Result{Status: Current}
+}
+
+func daemonSetStatus(u *unstructured.Unstructured) Result {
+	desired := getInt64(u, "status", "desiredNumberScheduled")
+	if updated := getInt64(u, "status", "updatedNumberScheduled"); updated < desired {
+		return inProgress("%d of %d pods updated", updated, desired)
+	}
+	if available := getInt64(u, "status", "numberAvailable"); available < desired {
+		return inProgress("%d of %d pods available", available, desired)
+	}
+	return Result{Status: Current}
+}
+
+func jobStatus(u *unstructured.Unstructured) Result {
+	if c, ok := getCondition(u, "Failed"); ok && c.status == "True" {
+		return failed("%s", c.describe())
+	}
+	if c, ok := getCondition(u, "Complete"); ok && c.status == "True" {
+		return Result{Status: Current}
+	}
+	if suspended, _, _ := unstructured.NestedBool(u.Object, "spec", "suspend"); suspended {
+		return Result{Status: Current}
+	}
+
+	completions, found, _ := unstructured.NestedInt64(u.Object, "spec", "completions")
+	if !found {
+		completions = 1
+	}
+	return inProgress("%d of %d completions", getInt64(u, "status", "succeeded"), completions)
+}
+
+func podStatus(u *unstructured.Unstructured) Result {
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return Result{Status: Current}
+	case "Failed":
+		reason, _, _ := unstructured.NestedString(u.Object, "status", "reason")
+		if reason == "" {
+			return failed("pod failed")
+		}
+		return failed("pod failed: %s", reason)
+	}
+
+	if c, ok := getCondition(u, "Ready"); ok && c.status == "True" {
+		return Result{Status: Current}
+	}
+	statuses, _, _ := unstructured.NestedSlice(u.Object, "status", "containerStatuses")
+	for _, item := range statuses {
+		s, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		reason, _, _ := unstructured.NestedString(s, "state", "waiting", "reason")
+		if reason != "" {
+			return inProgress("container '%s' is waiting: %s", s["name"], reason)
+		}
+	}
+	if phase == "" {
+		phase = "Pending"
+	}
+	return inProgress("pod is %s", strings.ToLower(phase))
+}
+
+func pvcStatus(u *unstructured.Unstructured) Result {
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	if phase == "Bound" {
+		return Result{Status: Current}
+	}
+	if phase == "" {
+		phase = "Pending"
+	}
+	return inProgress("volume claim is %s", strings.ToLower(phase))
+}
+
+func hasLoadBalancerAddress(u *unstructured.Unstructured) bool {
+	ingress, _, _ := unstructured.NestedSlice(u.Object, "status", "loadBalancer", "ingress")
+	return len(ingress) > 0
+}
+
+func serviceStatus(u *unstructured.Unstructured) Result {
+	serviceType, _, _ := unstructured.NestedString(u.Object, "spec", "type")
+	if serviceType == "LoadBalancer" && !hasLoadBalancerAddress(u) {
+		return inProgress("waiting for the load balancer address")
+	}
+	return Result{Status: Current}
+}
+
+// httpRouteStatus requires that all the gateways of the route accept it
+func httpRouteStatus(u *unstructured.Unstructured) Result {
+	parents, _, _ := unstructured.NestedSlice(u.Object, "status", "parents")
+	if len(parents) == 0 {
+		return inProgress("waiting for the gateway to accept the route")
+	}
+	for _, item := range parents {
+		p, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditions, _, _ := unstructured.NestedSlice(p, "conditions")
+		c, ok := findCondition(conditions, "Accepted")
+		if !ok {
+			return inProgress("waiting for the gateway to accept the route")
+		}
+		if c.status != "True" {
+			return failed("route not accepted: %s", c.describe())
+		}
+	}
+	return Result{Status: Current}
+}
+
+// rolloutStatus follows the phase computed by Argo Rollouts. A paused rollout is ready, as it waits for a promotion
+func rolloutStatus(u *unstructured.Unstructured) Result {
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+	message, _, _ := unstructured.NestedString(u.Object, "status", "message")
+	switch phase {
+	case "Healthy", "Paused":
+		return Result{Status: Current}
+	case "Degraded":
+		return failed("rollout is degraded: %s", message)
+	case "":
+		return readyConditionStatus(u)
+	default:
+		if message == "" {
+			message = strings.ToLower(phase)
+		}
+		return inProgress("rollout is %s", message)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readiness
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newObject(apiVersion, kind string, fields map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: fields}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetName("test")
+	return u
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		obj      *unstructured.Unstructured
+		name     string
+		expected Result
+	}{
+		{
+			name: "deployment ready",
+			obj: newObject("apps/v1", "Deployment", map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{"replicas": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(2)},
+			}),
+			expected: Result{Status: Current},
+		},
+		{
+			name: "deployment not available",
+			obj: newObject("apps/v1", "Deployment", map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{"replicas": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(1)},
+			}),
+			expected: Result{Status: InProgress, Message: "1 of 2 replicas available"},
+		},
+		{
+			name: "deployment progress deadline exceeded",
+			obj: newObject("apps/v1", "Deployment", map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded", "message": "timed out"},
+					},
+				},
+			}),
+			expected: Result{Status: Failed, Message: "ProgressDeadlineExceeded: timed out"},
+		},
+		{
+			name: "generation not observed",
+			obj: func() *unstructured.Unstructured {
+				u := newObject("apps/v1", "StatefulSet", map[string]interface{}{
+					"status": map[string]interface{}{"observedGeneration": int64(1)},
+				})
+				u.SetGeneration(2)
+				return u
+			}(),
+			expected: Result{Status: InProgress, Message: "waiting for the controller to observe generation 2"},
+		},
+		{
+			name: "job failed",
+			obj: newObject("batch/v1", "Job", map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Failed", "status": "True", "reason": "BackoffLimitExceeded"},
+					},
+				},
+			}),
+			expected: Result{Status: Failed, Message: "BackoffLimitExceeded"},
+		},
+		{
+			name: "pod waiting",
+			obj: newObject("v1", "Pod", map[string]interface{}{
+				"status": map[string]interface{}{
+					"phase": "Pending",
+					"containerStatuses": []interface{}{
+						map[string]interface{}{"name": "api", "state": map[string]interface{}{"waiting": map[string]interface{}{"reason": "ImagePullBackOff"}}},
+					},
+				},
+			}),
+			expected: Result{Status: InProgress, Message: "container 'api' is waiting: ImagePullBackOff"},
+		},
+		{
+			name:     "pvc pending",
+			obj:      newObject("v1", "PersistentVolumeClaim", map[string]interface{}{}),
+			expected: Result{Status: InProgress, Message: "volume claim is pending"},
+		},
+		{
+			name: "load balancer without address",
+			obj: newObject("v1", "Service", map[string]interface{}{
+				"spec": map[string]interface{}{"type": "LoadBalancer"},
+			}),
+			expected: Result{Status: InProgress, Message: "waiting for the load balancer address"},
+		},
+		{
+			name:     "ingress without address",
+			obj:      newObject("networking.k8s.io/v1", "Ingress", nil),
+			expected: Result{Status: Current},
+		},
+		{
+			name: "route not accepted",
+			obj: newObject("gateway.networking.k8s.io/v1", "HTTPRoute", map[string]interface{}{
+				"status": map[string]interface{}{
+					"parents": []interface{}{
+						map[string]interface{}{"conditions": []interface{}{
+							map[string]interface{}{"type": "Accepted", "status": "False", "reason": "NotAllowedByListeners"},
+						}},
+					},
+				},
+			}),
+			expected: Result{Status: Failed, Message: "route not accepted: NotAllowedByListeners"},
+		},
+		{
+			name: "custom resource with ready condition",
+			obj: newObject("cert-manager.io/v1", "Certificate", map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False", "message": "issuing"},
+					},
+				},
+			}),
+			expected: Result{Status: InProgress, Message: "issuing"},
+		},
+		{
+			name: "custom resource stalled",
+			obj: newObject("example.com/v1", "Database", map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Stalled", "status": "True", "reason": "QuotaExceeded"},
+					},
+				},
+			}),
+			expected: Result{Status: Failed, Message: "QuotaExceeded"},
+		},
+		{
+			name:     "custom resource without conditions",
+			obj:      newObject("example.com/v1", "Database", map[string]interface{}{}),
+			expected: Result{Status: Current},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Compute(tt.obj))
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	gk := schema.GroupKind{Group: "example.com", Kind: "Queue"}
+	Register(gk, func(_ *unstructured.Unstructured) Result {
+		return inProgress("custom")
+	})
+	t.Cleanup(func() {
+		statusFuncsLock.Lock()
+		delete(statusFuncs, gk)
+		statusFuncsLock.Unlock()
+	})
+
+	assert.Equal(t, Result{Status: InProgress, Message: "custom"}, Compute(newObject("example.com/v1", "Queue", map[string]interface{}{})))
+}
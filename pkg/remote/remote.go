@@ -150,6 +150,11 @@ type Runner struct {
 	ioCtrl               *io.Controller
 	getEnviron           func() []string
 	generateSocketName   socketNameGenerator
+
+	// clusterMetadata and knownHostsConfig are fetched on the first run and reused by the next
+	// ones, so a runner executed several times only bootstraps Remote Execution once
+	clusterMetadata  *types.ClusterMetadata
+	knownHostsConfig *types.KnownHostsConfig
 }
 
 // Params struct to pass the necessary parameters to create the Dockerfile
@@ -253,7 +258,7 @@ func (r *Runner) Run(ctx context.Context, params *Params) error {
 		return err
 	}
 
-	sc, err := r.fetchClusterMetadata(ctx)
+	sc, err := r.getClusterMetadata(ctx)
 	if err != nil {
 		return err
 	}
@@ -372,56 +377,49 @@ func (r *Runner) Run(ctx context.Context, params *Params) error {
 
 	buildOptions.ExtraHosts = addDefinedHosts(buildOptions.ExtraHosts, params.Hosts)
 
-	knownHostEnabled := false
-	knownHostsContent := ""
 	// Try to get known hosts from API first
-	c, err := r.oktetoClientProvider.Provide()
+	knownHostsConfig, err := r.fetchKnownHostsConfig(ctx)
 	if err != nil {
-		oktetoLog.Debugf("Failed to provide okteto client for known hosts: %s", err.Error())
-	} else {
-		knownHostsConfig, err := c.User().GetKnownHostsConfig(ctx)
-		if err != nil {
-			oktetoLog.Debugf("Failed to get known hosts from API: %s", err.Error())
-		} else if knownHostsConfig.Enabled && knownHostsConfig.Content != "" {
-			// Use known hosts from API when enabled and content is available
-			oktetoLog.Debugf("using known hosts from API")
-			tmpKnownHostsFile, err := r.fs.Create(filepath.Join(tmpDir, "known_hosts"))
+		oktetoLog.Debugf("Failed to get known hosts from API: %s", err.Error())
+	} else if knownHostsConfig.Enabled && knownHostsConfig.Content != "" {
+		// Use known hosts from API when enabled and content is available
+		oktetoLog.Debugf("using known hosts from API")
+		tmpKnownHostsFile, err := r.fs.Create(filepath.Join(tmpDir, "known_hosts"))
+		if err == nil {
+			_, err = tmpKnownHostsFile.WriteString(knownHostsConfig.Content)
+			tmpKnownHostsFile.Close()
 			if err == nil {
-				_, err = tmpKnownHostsFile.WriteString(knownHostsConfig.Content)
-				tmpKnownHostsFile.Close()
-				if err == nil {
-					buildOptions.Secrets = append(buildOptions.Secrets, fmt.Sprintf("id=known_hosts,src=%s", tmpKnownHostsFile.Name()))
-				} else {
-					oktetoLog.Debugf("Failed to write API known hosts to temp file: %s", err.Error())
-				}
+				buildOptions.Secrets = append(buildOptions.Secrets, fmt.Sprintf("id=known_hosts,src=%s", tmpKnownHostsFile.Name()))
 			} else {
-				oktetoLog.Debugf("Failed to create temp known hosts file: %s", err.Error())
+				oktetoLog.Debugf("Failed to write API known hosts to temp file: %s", err.Error())
 			}
-		} else if !knownHostsConfig.Enabled {
-			oktetoLog.Debugf("known hosts from API is disabled, using local file")
+		} else {
+			oktetoLog.Debugf("Failed to create temp known hosts file: %s", err.Error())
 		}
+	} else if !knownHostsConfig.Enabled {
+		oktetoLog.Debugf("known hosts from API is disabled, using local file")
+	}
 
-		knownHostEnabled = knownHostsConfig.Enabled
-		knownHostsContent = knownHostsConfig.Content
+	knownHostEnabled := knownHostsConfig.Enabled
+	knownHostsContent := knownHostsConfig.Content
 
-		if knownHostsConfig.Enabled && knownHostsConfig.Content != "" {
-			// Use known hosts from API when enabled and content is available
-			oktetoLog.Debugf("using known hosts from API")
-			tmpKnownHostsFile, err := r.fs.Create(filepath.Join(tmpDir, "known_hosts"))
+	if knownHostsConfig.Enabled && knownHostsConfig.Content != "" {
+		// Use known hosts from API when enabled and content is available
+		oktetoLog.Debugf("using known hosts from API")
+		tmpKnownHostsFile, err := r.fs.Create(filepath.Join(tmpDir, "known_hosts"))
+		if err == nil {
+			_, err = tmpKnownHostsFile.WriteString(knownHostsConfig.Content)
+			tmpKnownHostsFile.Close()
 			if err == nil {
-				_, err = tmpKnownHostsFile.WriteString(knownHostsConfig.Content)
-				tmpKnownHostsFile.Close()
-				if err == nil {
-					buildOptions.Secrets = append(buildOptions.Secrets, fmt.Sprintf("id=known_hosts,src=%s", tmpKnownHostsFile.Name()))
-				} else {
-					oktetoLog.Debugf("Failed to write API known hosts to temp file: %s", err.Error())
-				}
+				buildOptions.Secrets = append(buildOptions.Secrets, fmt.Sprintf("id=known_hosts,src=%s", tmpKnownHostsFile.Name()))
 			} else {
-				oktetoLog.Debugf("Failed to create temp known hosts file: %s", err.Error())
+				oktetoLog.Debugf("Failed to write API known hosts to temp file: %s", err.Error())
 			}
-		} else if !knownHostsConfig.Enabled {
-			oktetoLog.Debugf("known hosts from API is disabled, using local file")
+		} else {
+			oktetoLog.Debugf("Failed to create temp known hosts file: %s", err.Error())
 		}
+	} else if !knownHostsConfig.Enabled {
+		oktetoLog.Debugf("known hosts from API is disabled, using local file")
 	}
 
 	// Fallback to user's local known_hosts if API didn't provide content
@@ -571,6 +569,36 @@ func (r *Runner) getContextPath(cwd, manifestPath string) string {
 	return possibleCtx
 }
 
+// getClusterMetadata returns the metadata of the cluster, which is only fetched on the first run
+func (r *Runner) getClusterMetadata(ctx context.Context) (*types.ClusterMetadata, error) {
+	if r.clusterMetadata != nil {
+		return r.clusterMetadata, nil
+	}
+	sc, err := r.fetchClusterMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r.clusterMetadata = sc
+	return sc, nil
+}
+
+// fetchKnownHostsConfig returns the known hosts configured in Okteto, which are only fetched on the first run
+func (r *Runner) fetchKnownHostsConfig(ctx context.Context) (types.KnownHostsConfig, error) {
+	if r.knownHostsConfig != nil {
+		return *r.knownHostsConfig, nil
+	}
+	c, err := r.oktetoClientProvider.Provide()
+	if err != nil {
+		return types.KnownHostsConfig{}, fmt.Errorf("failed to provide okteto client for known hosts: %w", err)
+	}
+	knownHostsConfig, err := c.User().GetKnownHostsConfig(ctx)
+	if err != nil {
+		return types.KnownHostsConfig{}, err
+	}
+	r.knownHostsConfig = &knownHostsConfig
+	return knownHostsConfig, nil
+}
+
 func (r *Runner) fetchClusterMetadata(ctx context.Context) (*types.ClusterMetadata, error) {
 	c, err := r.oktetoClientProvider.Provide()
 	if err != nil {
@@ -216,6 +216,57 @@ func TestExtraHosts(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// countingClientProvider counts the okteto clients provided to the runner
+type countingClientProvider struct {
+	provider OktetoClientProvider
+	calls    int
+}
+
+func (p *countingClientProvider) Provide(opts ...okteto.Option) (types.OktetoInterface, error) {
+	p.calls++
+	return p.provider.Provide(opts...)
+}
+
+func TestRunReusesClusterMetadata(t *testing.T) {
+	originalStore := okteto.CurrentStore
+	defer func() { okteto.CurrentStore = originalStore }()
+	okteto.CurrentStore = &okteto.ContextStore{
+		CurrentContext: "test",
+		Contexts: map[string]*okteto.Context{
+			"test": {
+				Namespace: "namespace",
+			},
+		},
+	}
+
+	fs := afero.NewMemMapFs()
+	usersClient := client.NewFakeUsersClient(&types.User{})
+	usersClient.ClusterMetadata = types.ClusterMetadata{Certificate: []byte("cert")}
+	provider := &countingClientProvider{
+		provider: client.NewFakeOktetoClientProvider(&client.FakeOktetoClient{Users: usersClient}),
+	}
+	rdc := Runner{
+		builder:              fakeBuilder{},
+		fs:                   fs,
+		workingDirectoryCtrl: filesystem.NewFakeWorkingDirectoryCtrl(filepath.Clean("/")),
+		temporalCtrl:         filesystem.NewTemporalDirectoryCtrl(fs),
+		oktetoClientProvider: provider,
+		ioCtrl:               io.NewIOController(),
+		getEnviron: func() []string {
+			return []string{}
+		},
+		generateSocketName: fakeNameGenerator{name: "test"}.GenerateName,
+	}
+
+	manifest := &model.Manifest{Deploy: &model.DeployInfo{Image: "test-image"}}
+	require.NoError(t, rdc.Run(context.Background(), &Params{Manifest: manifest}))
+	calls := provider.calls
+	require.NotZero(t, calls)
+
+	require.NoError(t, rdc.Run(context.Background(), &Params{Manifest: manifest}))
+	assert.Equal(t, calls, provider.calls)
+}
+
 func TestCreateDockerfile(t *testing.T) {
 	originalStore := okteto.CurrentStore
 	defer func() { okteto.CurrentStore = originalStore }()
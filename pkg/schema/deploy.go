@@ -28,6 +28,33 @@ func (deploy) JSONSchema() *jsonschema.Schema {
 		Description: "Command to execute",
 	})
 
+	outputProps := jsonschema.NewProperties()
+	outputProps.Set("name", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"string"}},
+		Description: "Name of the variable written to $OKTETO_ENV by the command",
+	})
+	outputProps.Set("sensitive", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"boolean"}},
+		Description: "Whether the value must be masked in the logs. The values of sensitive outputs are stored in a Secret of the development environment instead of its ConfigMap",
+	})
+	namedCommandProps.Set("outputs", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"array"}},
+		Description: "Values generated by the command that are stored with the development environment",
+		Items: &jsonschema.Schema{
+			OneOf: []*jsonschema.Schema{
+				{
+					Type: &jsonschema.Type{Types: []string{"string"}},
+				},
+				{
+					Type:                 &jsonschema.Type{Types: []string{"object"}},
+					Properties:           outputProps,
+					Required:             []string{"name"},
+					AdditionalProperties: jsonschema.FalseSchema,
+				},
+			},
+		},
+	})
+
 	composeFileProps := jsonschema.NewProperties()
 	composeFileProps.Set("file", &jsonschema.Schema{
 		Type:        &jsonschema.Type{Types: []string{"string"}},
@@ -85,8 +112,8 @@ func (deploy) JSONSchema() *jsonschema.Schema {
 	divertProps := jsonschema.NewProperties()
 	divertProps.Set("driver", &jsonschema.Schema{
 		Type:        &jsonschema.Type{Types: []string{"string"}},
-		Enum:        []interface{}{"istio"},
-		Description: "The backend for divert. Currently, only 'istio' is supported",
+		Enum:        []interface{}{"istio", "gateway"},
+		Description: "The backend for divert. Supported values are 'istio' and 'gateway'",
 	})
 	divertProps.Set("namespace", &jsonschema.Schema{
 		Type:        &jsonschema.Type{Types: []string{"string"}},
@@ -111,6 +138,24 @@ func (deploy) JSONSchema() *jsonschema.Schema {
 		},
 	})
 
+	waitProps := jsonschema.NewProperties()
+	waitProps.Set("name", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"string"}},
+		Description: "Name of the check",
+	})
+	waitProps.Set("http", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"string"}},
+		Description: "URL that must answer with the expected status",
+	})
+	waitProps.Set("tcp", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"string"}},
+		Description: "Address, as host:port, that must accept connections",
+	})
+	waitProps.Set("status", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"integer"}},
+		Description: "Status code expected from the URL. If left empty, any 2xx or 3xx status is accepted",
+	})
+
 	deployProps := jsonschema.NewProperties()
 	deployProps.Set("image", &jsonschema.Schema{
 		Type:        &jsonschema.Type{Types: []string{"string"}},
@@ -183,6 +228,20 @@ func (deploy) JSONSchema() *jsonschema.Schema {
 		Description:          "Configuration for diverting traffic between namespaces",
 	})
 
+	deployProps.Set("wait", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"array"}},
+		Description: "List of HTTP or TCP checks that must pass for 'okteto deploy --wait' to finish",
+		Items: &jsonschema.Schema{
+			Type:                 &jsonschema.Type{Types: []string{"object"}},
+			Properties:           waitProps,
+			AdditionalProperties: jsonschema.FalseSchema,
+			OneOf: []*jsonschema.Schema{
+				{Required: []string{"http"}},
+				{Required: []string{"tcp"}},
+			},
+		},
+	})
+
 	return &jsonschema.Schema{
 		OneOf: []*jsonschema.Schema{
 			{
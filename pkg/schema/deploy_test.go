@@ -107,6 +107,16 @@ deploy:
     hosts:
       - virtualService: frontend
         namespace: staging`,
+		},
+		{
+			name: "deploy with gateway divert",
+			manifest: `
+deploy:
+  commands:
+    - helm upgrade --install movies chart
+  divert:
+    driver: gateway
+    namespace: staging`,
 		},
 		{
 			name: "full deploy",
@@ -133,6 +143,49 @@ deploy:
       service: frontend
       port: 80`,
 		},
+		{
+			name: "deploy with wait checks",
+			manifest: `
+deploy:
+  commands:
+    - kubectl apply -f k8s
+  wait:
+    - name: api
+      http: https://api-${OKTETO_NAMESPACE}.okteto.example.com/healthz
+      status: 200
+    - tcp: db.example.com:5432`,
+		},
+		{
+			name: "wait check without http nor tcp",
+			manifest: `
+deploy:
+  wait:
+    - name: api`,
+			expectErr: true,
+		},
+		{
+			name: "deploy with outputs",
+			manifest: `
+deploy:
+  commands:
+    - name: Create database
+      command: ./create-db.sh
+      outputs:
+        - DATABASE_HOST
+        - name: DATABASE_PASSWORD
+          sensitive: true`,
+		},
+		{
+			name: "output without name",
+			manifest: `
+deploy:
+  commands:
+    - name: Create database
+      command: ./create-db.sh
+      outputs:
+        - sensitive: true`,
+			expectErr: true,
+		},
 		{
 			name: "invalid commands type",
 			manifest: `
@@ -13,7 +13,11 @@
 
 package schema
 
-import "github.com/kubeark/jsonschema"
+import (
+	"encoding/json"
+
+	"github.com/kubeark/jsonschema"
+)
 
 type dev struct{}
 
@@ -104,6 +108,17 @@ func (dev) JSONSchema() *jsonschema.Schema {
 		Type:  &jsonschema.Type{Types: []string{"integer"}},
 		Title: "remotePort",
 	})
+	forwardItemProps.Set("localSocket", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"string"}},
+		Title:       "localSocket",
+		Description: "Path of a local Unix-domain socket to forward instead of a local port",
+	})
+	forwardItemProps.Set("protocol", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"string"}},
+		Title:       "protocol",
+		Description: "Protocol of the forward. Defaults to 'tcp'",
+		Enum:        []any{"tcp", "udp"},
+	})
 	forwardItemProps.Set("name", &jsonschema.Schema{
 		Type:  &jsonschema.Type{Types: []string{"string"}},
 		Title: "name",
@@ -126,12 +141,16 @@ func (dev) JSONSchema() *jsonschema.Schema {
 			OneOf: []*jsonschema.Schema{
 				{
 					Type:    &jsonschema.Type{Types: []string{"string"}},
-					Pattern: "^[0-9]+:([a-zA-Z0-9-]+:)?[0-9]+$",
+					Pattern: "^((tcp|udp):)?([0-9]+|/[^:]+):([a-zA-Z0-9-]+:)?[0-9]+$",
 				},
 				{
-					Type:                 &jsonschema.Type{Types: []string{"object"}},
-					Properties:           forwardItemProps,
-					Required:             []string{"localPort", "remotePort"},
+					Type:       &jsonschema.Type{Types: []string{"object"}},
+					Properties: forwardItemProps,
+					Required:   []string{"remotePort"},
+					OneOf: []*jsonschema.Schema{
+						{Required: []string{"localPort"}},
+						{Required: []string{"localSocket"}},
+					},
 					AdditionalProperties: jsonschema.FalseSchema,
 				},
 			},
@@ -374,7 +393,7 @@ func (dev) JSONSchema() *jsonschema.Schema {
 		Description: "Ports to reverse forward from your development container",
 		Items: &jsonschema.Schema{
 			Type:    &jsonschema.Type{Types: []string{"string"}},
-			Pattern: "^[0-9]+:[0-9]+$",
+			Pattern: "^((tcp|udp):)?[0-9]+:([0-9]+|/[^:]+)$",
 		},
 	})
 
@@ -544,6 +563,20 @@ func (dev) JSONSchema() *jsonschema.Schema {
 		Title:   "rescanInterval",
 		Default: 300,
 	})
+	syncProps.Set("keepConflicts", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"boolean"}},
+		Title:       "keepConflicts",
+		Description: "Keep a '.sync-conflict-' copy of the files modified both locally and in the development container, so you can resolve them with 'okteto sync conflicts'. By default, the local version wins and no copy is kept",
+		Default:     false,
+	})
+
+	devProps.Set("socks", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"integer"}},
+		Title:       "socks",
+		Description: "Local port of a SOCKS5 proxy that connects to any address reachable from your development container",
+		Minimum:     json.Number("1"),
+		Maximum:     json.Number("65535"),
+	})
 
 	devProps.Set("sync", &jsonschema.Schema{
 		Title:       "sync",
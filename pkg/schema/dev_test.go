@@ -273,6 +273,66 @@ dev:
         name: web
 `,
 		},
+		{
+			name: "valid udp and socket forwards",
+			manifest: `
+dev:
+  api:
+    forward:
+      - udp:8125:statsd:8125
+      - tcp:8080:80
+      - /tmp/docker.sock:2375
+      - localSocket: /tmp/debug.sock
+        remotePort: 4711
+      - localPort: 5353
+        remotePort: 53
+        protocol: udp
+    reverse:
+      - udp:8125:8125
+      - 9229:/tmp/debug.sock
+`,
+		},
+		{
+			name: "valid socks port",
+			manifest: `
+dev:
+  api:
+    socks: 1080
+`,
+		},
+		{
+			name: "invalid socks port",
+			manifest: `
+dev:
+  api:
+    socks: 70000
+`,
+			wantError: true,
+		},
+		{
+			name: "invalid forward protocol",
+			manifest: `
+dev:
+  api:
+    forward:
+      - localPort: 8080
+        remotePort: 8080
+        protocol: sctp
+`,
+			wantError: true,
+		},
+		{
+			name: "forward with local port and socket",
+			manifest: `
+dev:
+  api:
+    forward:
+      - localPort: 8080
+        localSocket: /tmp/debug.sock
+        remotePort: 8080
+`,
+			wantError: true,
+		},
 		{
 			name: "valid timeout formats",
 			manifest: `
@@ -159,5 +159,5 @@ func Test_Forward_JsonSchema_IsAlignedWithManifest(t *testing.T) {
 	}
 
 	manifestKeys := model.GetStructKeys(model.Manifest{})
-	assert.ElementsMatch(t, manifestKeys["forward.Forward"], forwardPropKeys, "JSON Schema Forward section should match Manifest Forward section")
+	assert.ElementsMatch(t, manifestKeys["forward.GlobalForward"], forwardPropKeys, "JSON Schema Forward section should match Manifest Forward section")
 }
@@ -13,13 +13,28 @@
 
 package schema
 
-import "github.com/kubeark/jsonschema"
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/kubeark/jsonschema"
+	"github.com/okteto/okteto/pkg/model"
+)
+
+// durationPattern matches the durations accepted by time.ParseDuration, e.g. 500ms, 1.5m or 1h30m
+const durationPattern = `^([0-9]*\.?[0-9]+(ns|us|µs|ms|s|m|h))+$`
 
 type test struct{}
 
 func (test) JSONSchema() *jsonschema.Schema {
 	testProps := jsonschema.NewProperties()
 
+	testProps.Set("allow_failure", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"boolean"}},
+		Title:       "allow_failure",
+		Description: "Allow the Test Container to fail without failing the execution of the tests. Test Containers that depend on it are executed even if it fails.",
+	})
+
 	testProps.Set("artifacts", &jsonschema.Schema{
 		Type:        &jsonschema.Type{Types: []string{"array"}},
 		Title:       "artifacts",
@@ -38,6 +53,12 @@ func (test) JSONSchema() *jsonschema.Schema {
 		},
 	})
 
+	testProps.Set("cacheResults", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"boolean"}},
+		Title:       "cacheResults",
+		Description: "Skip the Test Container if the test context, the image, the commands and the variables are the same as the last time it passed, and restore its artifacts. Use --no-cache to force the execution.",
+	})
+
 	commandProps := jsonschema.NewProperties()
 	commandProps.Set("name", &jsonschema.Schema{
 		Type:        &jsonschema.Type{Types: []string{"string"}},
@@ -117,10 +138,133 @@ func (test) JSONSchema() *jsonschema.Schema {
 		Description: withManifestRefDocLink("The base image used to run your test.", "image-string-optional-1"),
 	})
 
+	testProps.Set("retries", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"integer"}},
+		Title:       "retries",
+		Description: "The number of times the Test Container is executed again when it fails. The wait between retries doubles after each retry.",
+		Minimum:     json.Number("0"),
+		Maximum:     json.Number(strconv.Itoa(model.MaxTestRetries)),
+	})
+
+	healthcheckHTTPProps := jsonschema.NewProperties()
+	healthcheckHTTPProps.Set("path", &jsonschema.Schema{
+		Type: &jsonschema.Type{Types: []string{"string"}},
+	})
+	healthcheckHTTPProps.Set("port", &jsonschema.Schema{
+		Type: &jsonschema.Type{Types: []string{"integer"}},
+	})
+
+	healthcheckProps := jsonschema.NewProperties()
+	healthcheckProps.Set("http", &jsonschema.Schema{
+		Type:                 &jsonschema.Type{Types: []string{"object"}},
+		Description:          "HTTP request used to check the health of the service",
+		Properties:           healthcheckHTTPProps,
+		Required:             []string{"path", "port"},
+		AdditionalProperties: jsonschema.FalseSchema,
+	})
+	healthcheckProps.Set("test", &jsonschema.Schema{
+		Description: "Command used to check the health of the service",
+		OneOf: []*jsonschema.Schema{
+			{
+				Type: &jsonschema.Type{Types: []string{"string"}},
+			},
+			{
+				Type: &jsonschema.Type{Types: []string{"array"}},
+				Items: &jsonschema.Schema{
+					Type: &jsonschema.Type{Types: []string{"string"}},
+				},
+			},
+		},
+	})
+	for _, duration := range []string{"interval", "timeout", "start_period"} {
+		healthcheckProps.Set(duration, &jsonschema.Schema{
+			Type:    &jsonschema.Type{Types: []string{"string"}},
+			Pattern: durationPattern,
+		})
+	}
+	healthcheckProps.Set("retries", &jsonschema.Schema{
+		Type:    &jsonschema.Type{Types: []string{"integer"}},
+		Minimum: json.Number("0"),
+	})
+
+	serviceProps := jsonschema.NewProperties()
+	serviceProps.Set("environment", &jsonschema.Schema{
+		Title:       "environment",
+		Description: "Environment variables of the service",
+		OneOf: []*jsonschema.Schema{
+			{
+				Type: &jsonschema.Type{Types: []string{"object"}},
+				PatternProperties: map[string]*jsonschema.Schema{
+					".*": {
+						Type: &jsonschema.Type{Types: []string{"string", "boolean", "number"}},
+					},
+				},
+			},
+			{
+				Type: &jsonschema.Type{Types: []string{"array"}},
+				Items: &jsonschema.Schema{
+					Type: &jsonschema.Type{Types: []string{"string"}},
+				},
+			},
+		},
+	})
+	serviceProps.Set("healthcheck", &jsonschema.Schema{
+		Type:                 &jsonschema.Type{Types: []string{"object"}},
+		Title:                "healthcheck",
+		Description:          "Check used to know when the service is ready. The Test Container doesn't run until every service is ready",
+		Properties:           healthcheckProps,
+		AdditionalProperties: jsonschema.FalseSchema,
+	})
+	serviceProps.Set("image", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"string"}},
+		Title:       "image",
+		Description: "The image of the service",
+	})
+	serviceProps.Set("ports", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"array"}},
+		Title:       "ports",
+		Description: "The ports exposed by the service",
+		Items: &jsonschema.Schema{
+			Type:    &jsonschema.Type{Types: []string{"integer"}},
+			Minimum: json.Number("1"),
+			Maximum: json.Number("65535"),
+		},
+	})
+
+	testProps.Set("services", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"object"}},
+		Title:       "services",
+		Description: "Containers started in the namespace before the Test Container runs, like databases or mock servers. The commands reach each service using its name as hostname. Services are removed once the Test Container finishes, even if it fails.",
+		PatternProperties: map[string]*jsonschema.Schema{
+			"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$": {
+				Type:                 &jsonschema.Type{Types: []string{"object"}},
+				Properties:           serviceProps,
+				Required:             []string{"image"},
+				AdditionalProperties: jsonschema.FalseSchema,
+			},
+		},
+		AdditionalProperties: jsonschema.FalseSchema,
+	})
+
+	testProps.Set("shards", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"integer"}},
+		Title:       "shards",
+		Description: "The number of Test Containers to run at the same time to split the tests. Each shard receives its index, starting at 0, in the OKTETO_TEST_SHARD_INDEX environment variable and the number of shards in OKTETO_TEST_SHARD_TOTAL. The artifacts of each shard are exported into a 'shard-<index>' folder. The test passes only if every shard passes.",
+		Minimum:     json.Number("0"),
+		Maximum:     json.Number(strconv.Itoa(model.MaxTestShards)),
+	})
+
 	testProps.Set("skipIfNoFileChanges", &jsonschema.Schema{
 		Type:        &jsonschema.Type{Types: []string{"boolean"}},
 		Title:       "skipIfNoFileChanges",
-		Description: "Skip the test execution if no files have changed since the last test run. This is useful to avoid running tests when the code hasn't changed.",
+		Description: "Skip the test execution if no files have changed since the last test run. This is useful to avoid running tests when the code hasn't changed. It also enables cacheResults. Use --no-cache to force the execution.",
+	})
+
+	testProps.Set("timeout", &jsonschema.Schema{
+		Type:        &jsonschema.Type{Types: []string{"string"}},
+		Title:       "timeout",
+		Description: "The maximum time each execution of the Test Container can take. Any value should contain a corresponding time unit e.g. 500ms, 30s, 1.5m, 1h",
+		Pattern:     durationPattern,
 	})
 
 	return &jsonschema.Schema{
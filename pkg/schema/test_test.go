@@ -85,6 +85,81 @@ test:
       - hostname: detailed.host
         ip: 10.0.0.1`,
 		},
+		{
+			name: "valid timeout, retries and allow_failure",
+			manifest: `
+test:
+  e2e:
+    commands: [make e2e]
+    timeout: 1h30m
+    retries: 2
+    allow_failure: true
+    shards: 4`,
+		},
+		{
+			name: "valid timeout with fractions and milliseconds",
+			manifest: `
+test:
+  unit:
+    commands: [make unit]
+    timeout: 1.5m
+  lint:
+    commands: [make lint]
+    timeout: 500ms`,
+		},
+		{
+			name: "valid services",
+			manifest: `
+test:
+  integration:
+    commands: [make integration]
+    services:
+      db:
+        image: postgres:16
+        environment:
+          POSTGRES_PASSWORD: password
+        ports: [5432]
+        healthcheck:
+          test: pg_isready -U postgres
+          interval: 5s
+          retries: 10
+      api-mock:
+        image: wiremock/wiremock
+        ports: [8080]
+        healthcheck:
+          http:
+            path: /__admin/health
+            port: 8080`,
+		},
+		{
+			name: "invalid - service without image",
+			manifest: `
+test:
+  integration:
+    commands: [make integration]
+    services:
+      db:
+        ports: [5432]`,
+			expectErr: true,
+		},
+		{
+			name: "invalid - malformed timeout",
+			manifest: `
+test:
+  e2e:
+    commands: [make e2e]
+    timeout: forever`,
+			expectErr: true,
+		},
+		{
+			name: "invalid - too many retries",
+			manifest: `
+test:
+  e2e:
+    commands: [make e2e]
+    retries: 11`,
+			expectErr: true,
+		},
 		{
 			name: "invalid - missing commands",
 			manifest: `
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"os"
 	"sync"
 	"time"
 
@@ -27,12 +28,21 @@ import (
 
 type forward struct {
 	pool          *pool
+	localNetwork  string
 	localAddress  string
 	remoteAddress string
 	lock          sync.Mutex
 	c             bool
 }
 
+// network returns the network of the local address, "tcp" or "unix"
+func (f *forward) network() string {
+	if f.localNetwork == "" {
+		return "tcp"
+	}
+	return f.localNetwork
+}
+
 func (f *forward) connected() bool {
 	f.lock.Lock()
 	defer f.lock.Unlock()
@@ -52,7 +62,16 @@ func (f *forward) setDisconnected() {
 }
 
 func (f *forward) start(ctx context.Context) {
-	localListener, err := net.Listen("tcp", f.localAddress)
+	f.serve(ctx, f.handle)
+}
+
+// serve listens on the local address and calls handle with every accepted connection
+func (f *forward) serve(ctx context.Context, handle func(net.Conn)) {
+	if f.network() == "unix" {
+		removeStaleSocket(f.localAddress)
+	}
+
+	localListener, err := net.Listen(f.network(), f.localAddress)
 	if err != nil {
 		oktetoLog.Infof("%s -> failed to listen: %s", f.String(), err)
 		return
@@ -81,22 +100,28 @@ func (f *forward) start(ctx context.Context) {
 			<-tick.C
 			continue
 		}
-		go f.handle(localConn)
+		go handle(localConn)
 	}
 
 }
 
 func (f *forward) handle(local net.Conn) {
+	if err := f.proxy(local, f.remote()); err != nil {
+		oktetoLog.Infof("%s -> failed to dial remote connection: %s", f.String(), err)
+	}
+}
+
+// proxy copies the data between local and a new connection to remoteAddress until one of them is closed
+func (f *forward) proxy(local net.Conn, remoteAddress string) error {
 	defer func() {
 		if err := local.Close(); err != nil {
 			oktetoLog.Debugf("Error closing local connection: %s", err)
 		}
 	}()
 
-	remote, err := f.pool.get(f.remoteAddress)
+	remote, err := f.pool.get(remoteAddress)
 	if err != nil {
-		oktetoLog.Infof("%s -> failed to dial remote connection: %s", f.String(), err)
-		return
+		return err
 	}
 	defer func() {
 		if err := remote.Close(); err != nil {
@@ -110,10 +135,28 @@ func (f *forward) handle(local net.Conn) {
 	go f.transfer(local, remote, quit)
 
 	<-quit
+	return nil
+}
+
+func (f *forward) remote() string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.remoteAddress
+}
+
+// removeStaleSocket removes the Unix-domain socket left behind by a previous session, so it can be listened to again
+func removeStaleSocket(path string) {
+	info, err := os.Lstat(path)
+	if err != nil || info.Mode()&os.ModeSocket == 0 {
+		return
+	}
+	if err := os.Remove(path); err != nil {
+		oktetoLog.Infof("failed to remove stale socket '%s': %s", path, err)
+	}
 }
 
 func (f *forward) String() string {
-	return fmt.Sprintf("ssh forward %s->%s", f.localAddress, f.remoteAddress)
+	return fmt.Sprintf("ssh forward %s->%s", f.localAddress, f.remote())
 }
 
 func (f *forward) transfer(from io.Writer, to io.Reader, quit chan struct{}) {
@@ -0,0 +1,149 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	forwardModel "github.com/okteto/okteto/pkg/model/forward"
+)
+
+// globalForward is a forward to a service of the namespace. When its service can't be reached,
+// it looks up the service again by its labels and retries with backoff until the connection is re-established
+type globalForward struct {
+	forward
+	tracker      *forwardModel.StatusTracker
+	resolve      func(forwardModel.Forward) (forwardModel.Forward, error)
+	spec         forwardModel.Forward
+	started      bool
+	reconnecting bool
+}
+
+func (fm *ForwardManager) addGlobal(f forwardModel.Forward) error {
+	if err := fm.canAdd(f.Local, true); err != nil {
+		return err
+	}
+
+	gf := &globalForward{
+		forward: forward{
+			localAddress:  net.JoinHostPort(fm.localInterface, strconv.Itoa(f.Local)),
+			remoteAddress: net.JoinHostPort(f.ServiceName, strconv.Itoa(f.Remote)),
+		},
+		spec: f,
+	}
+
+	if f.Labels != nil && fm.pf != nil {
+		gf.resolve = fm.TransformLabelsToServiceName
+	}
+
+	fm.globalForwards[f.Local] = gf
+	return nil
+}
+
+func (g *globalForward) start(ctx context.Context) {
+	go g.reconnect(ctx, nil)
+	g.serve(ctx, func(local net.Conn) {
+		if err := g.proxy(local, g.remote()); err != nil {
+			oktetoLog.Infof("%s -> failed to dial remote connection: %s", g.String(), err)
+			go g.reconnect(ctx, err)
+		}
+	})
+}
+
+// reconnect probes the service of the forward until it is reachable, updating the health of the forward.
+// cause is the error that triggered the reconnection, or nil to probe the service right away
+func (g *globalForward) reconnect(ctx context.Context, cause error) {
+	g.lock.Lock()
+	if g.reconnecting {
+		g.lock.Unlock()
+		return
+	}
+	g.reconnecting = true
+	g.lock.Unlock()
+
+	defer func() {
+		g.lock.Lock()
+		g.reconnecting = false
+		g.lock.Unlock()
+	}()
+
+	err := cause
+	retries := 0
+	for {
+		if err == nil {
+			err = g.probe()
+		}
+
+		if err == nil {
+			g.tracker.Set(g.target(), forwardModel.StateConnected, 0, nil)
+			return
+		}
+
+		retries++
+		oktetoLog.Infof("%s -> service unreachable, attempt %d: %s", g.String(), retries, err)
+		g.tracker.Set(g.target(), forwardModel.StateAfter(retries), retries, err)
+
+		select {
+		case <-time.After(forwardModel.Backoff(retries)):
+		case <-ctx.Done():
+			return
+		}
+
+		err = g.resolveService()
+	}
+}
+
+// probe opens and closes a connection to the service of the forward
+func (g *globalForward) probe() error {
+	c, err := g.pool.get(g.remote())
+	if err != nil {
+		return err
+	}
+
+	if err := c.Close(); err != nil {
+		oktetoLog.Debugf("Error closing probe connection: %s", err)
+	}
+	return nil
+}
+
+// resolveService looks up the service of the forward by its labels, in case it was recreated with a different name
+func (g *globalForward) resolveService() error {
+	if g.resolve == nil {
+		return nil
+	}
+
+	spec, err := g.resolve(g.target())
+	if err != nil {
+		return err
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if spec.ServiceName != g.spec.ServiceName {
+		oktetoLog.Infof("global forward %d now targets service/%s", spec.Local, spec.ServiceName)
+		g.spec = spec
+		g.remoteAddress = net.JoinHostPort(spec.ServiceName, strconv.Itoa(spec.Remote))
+	}
+	return nil
+}
+
+func (g *globalForward) target() forwardModel.Forward {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.spec
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/okteto/okteto/pkg/constants"
+	"github.com/okteto/okteto/pkg/model"
+	forwardModel "github.com/okteto/okteto/pkg/model/forward"
+)
+
+func waitForState(t *testing.T, changes chan forwardModel.Status, expected forwardModel.State) forwardModel.Status {
+	t.Helper()
+	for {
+		select {
+		case s := <-changes:
+			if s.State == expected {
+				return s
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("global forward didn't reach the '%s' state", expected)
+		}
+	}
+}
+
+func startTestHTTPServer(t *testing.T, port int, message string) *http.Server {
+	t.Helper()
+	l, err := net.Listen("tcp", net.JoinHostPort(model.Localhost, strconv.Itoa(port)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &http.Server{
+		Handler:           &testHTTPHandler{message: message},
+		ReadHeaderTimeout: 3 * time.Second,
+	}
+	go server.Serve(l)
+	return server
+}
+
+func TestGlobalForwardReconnect(t *testing.T) {
+	t.Setenv(constants.OktetoFolderEnvVar, t.TempDir())
+	public, private := getKeyPaths()
+	if err := generate(public, private); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sshPort, err := model.GetAvailablePort(model.Localhost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sshAddr := fmt.Sprintf("localhost:%d", sshPort)
+	ssh := testSSHHandler{}
+	go ssh.listenAndServe(sshAddr)
+	fm := NewForwardManager(ctx, sshAddr, model.Localhost, "0.0.0.0", nil, "")
+
+	changes := make(chan forwardModel.Status, 10)
+	fm.SetStatusTracker(forwardModel.NewStatusTracker(func(_ forwardModel.State, current forwardModel.Status) {
+		changes <- current
+	}))
+
+	remote, err := model.GetAvailablePort(model.Localhost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := startTestHTTPServer(t, remote, "global")
+
+	local, err := model.GetAvailablePort(model.Localhost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := forwardModel.Forward{
+		Local:       local,
+		Remote:      remote,
+		Service:     true,
+		IsGlobal:    true,
+		ServiceName: "okteto-recreated.invalid",
+		Labels:      map[string]string{"app": "api"},
+	}
+	if err := fm.Add(f); err != nil {
+		t.Fatal(err)
+	}
+	// the service was recreated with a different name, only the labels resolve to it
+	fm.globalForwards[local].resolve = func(f forwardModel.Forward) (forwardModel.Forward, error) {
+		f.ServiceName = model.Localhost
+		return f, nil
+	}
+
+	if err := fm.Start("", ""); err != nil {
+		t.Fatal(err)
+	}
+	defer fm.Stop()
+	if err := fm.StartGlobalForwarding(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := waitForState(t, changes, forwardModel.StateReconnecting)
+	if s.Retries != 1 || s.Error == "" {
+		t.Errorf("unexpected status: %+v", s)
+	}
+	s = waitForState(t, changes, forwardModel.StateConnected)
+	if s.Forward != fmt.Sprintf("%d:%s:%d", local, model.Localhost, remote) {
+		t.Errorf("got forward '%s', the service wasn't resolved again", s.Forward)
+	}
+
+	url := fmt.Sprintf("http://%s", net.JoinHostPort(model.Localhost, strconv.Itoa(local)))
+	if body, err := getBody(url); err != nil || body != "global" {
+		t.Fatalf("got '%s' from the global forward: %v", body, err)
+	}
+
+	// the pod behind the service goes away and comes back
+	if err := server.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := getBody(url); err == nil {
+		t.Fatal("request through the global forward didn't fail")
+	}
+	waitForState(t, changes, forwardModel.StateReconnecting)
+
+	server = startTestHTTPServer(t, remote, "rescheduled")
+	defer server.Close()
+	waitForState(t, changes, forwardModel.StateConnected)
+	if body, err := getBody(url); err != nil || body != "rescheduled" {
+		t.Fatalf("got '%s' from the global forward: %v", body, err)
+	}
+}
+
+func getBody(url string) (string, error) {
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
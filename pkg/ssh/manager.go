@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
 	"runtime"
 	"strconv"
 	"time"
@@ -37,8 +38,14 @@ type ForwardManager struct {
 	localInterface  string
 	remoteInterface string
 	forwards        map[int]*forward
-	globalForwards  map[int]*forward
+	globalForwards  map[int]*globalForward
 	reverses        map[int]*reverse
+	socketForwards  map[string]*forward
+	socketReverses  map[string]*reverse
+	udpForwards     map[int]*udpForward
+	udpReverses     map[int]*udpReverse
+	socks           *socksProxy
+	tracker         *forwardModel.StatusTracker
 	ctx             context.Context
 	sshAddr         string
 	pf              *k8sForward.PortForwardManager
@@ -53,8 +60,12 @@ func NewForwardManager(ctx context.Context, sshAddr, localInterface, remoteInter
 		localInterface:  localInterface,
 		remoteInterface: remoteInterface,
 		forwards:        make(map[int]*forward),
-		globalForwards:  make(map[int]*forward),
+		globalForwards:  make(map[int]*globalForward),
 		reverses:        make(map[int]*reverse),
+		socketForwards:  make(map[string]*forward),
+		socketReverses:  make(map[string]*reverse),
+		udpForwards:     make(map[int]*udpForward),
+		udpReverses:     make(map[int]*udpReverse),
 		sshAddr:         sshAddr,
 		pf:              pf,
 		namespace:       namespace,
@@ -74,6 +85,10 @@ func (fm *ForwardManager) canAdd(localPort int, checkAvailable bool) error {
 		return fmt.Errorf("port %d is listed multiple times, please check your global forwards configuration", localPort)
 	}
 
+	if fm.socks != nil && fm.socks.localAddress == net.JoinHostPort(fm.localInterface, strconv.Itoa(localPort)) {
+		return fmt.Errorf("port %d is already used by the SOCKS5 proxy, please check your forwards configuration", localPort)
+	}
+
 	if !checkAvailable {
 		return nil
 	}
@@ -99,23 +114,52 @@ func (fm *ForwardManager) canAdd(localPort int, checkAvailable bool) error {
 
 // Add initializes a remote forward
 func (fm *ForwardManager) Add(f forwardModel.Forward) error {
+	if f.IsUDP() {
+		return fm.addUDP(f)
+	}
+
+	if f.IsSocket() {
+		return fm.addSocket(f)
+	}
 
-	forwardsToUpdate := fm.forwards
 	if f.IsGlobal {
-		forwardsToUpdate = fm.globalForwards
+		return fm.addGlobal(f)
 	}
 
 	if err := fm.canAdd(f.Local, true); err != nil {
 		return err
 	}
 
-	forwardsToUpdate[f.Local] = &forward{
+	fm.forwards[f.Local] = &forward{
 		localAddress:  net.JoinHostPort(fm.localInterface, strconv.Itoa(f.Local)),
 		remoteAddress: net.JoinHostPort(fm.remoteInterface, strconv.Itoa(f.Remote)),
 	}
 
 	if f.Service {
-		forwardsToUpdate[f.Local].remoteAddress = net.JoinHostPort(f.ServiceName, strconv.Itoa(f.Remote))
+		fm.forwards[f.Local].remoteAddress = net.JoinHostPort(f.ServiceName, strconv.Itoa(f.Remote))
+	}
+
+	return nil
+}
+
+func (fm *ForwardManager) addSocket(f forwardModel.Forward) error {
+	if _, ok := fm.socketForwards[f.LocalSocket]; ok {
+		return fmt.Errorf("socket '%s' is listed multiple times, please check your forwards configuration", f.LocalSocket)
+	}
+
+	if info, err := os.Lstat(f.LocalSocket); err == nil && info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("local socket '%s' can't be created: the path already exists and it is not a socket", f.LocalSocket)
+	}
+
+	remoteHost := fm.remoteInterface
+	if f.Service {
+		remoteHost = f.ServiceName
+	}
+
+	fm.socketForwards[f.LocalSocket] = &forward{
+		localNetwork:  "unix",
+		localAddress:  f.LocalSocket,
+		remoteAddress: net.JoinHostPort(remoteHost, strconv.Itoa(f.Remote)),
 	}
 
 	return nil
@@ -181,6 +225,31 @@ func (fm *ForwardManager) Start(devPod, namespace string) error {
 		go rt.start(fm.ctx)
 	}
 
+	for _, sf := range fm.socketForwards {
+		sf.pool = fm.pool
+		go sf.start(fm.ctx)
+	}
+
+	for _, sr := range fm.socketReverses {
+		sr.pool = fm.pool
+		go sr.start(fm.ctx)
+	}
+
+	for _, uf := range fm.udpForwards {
+		uf.pool = fm.pool
+		go uf.start(fm.ctx)
+	}
+
+	for _, ur := range fm.udpReverses {
+		ur.pool = fm.pool
+		go ur.start(fm.ctx)
+	}
+
+	if fm.socks != nil {
+		fm.socks.pool = fm.pool
+		go fm.socks.start(fm.ctx)
+	}
+
 	return nil
 }
 
@@ -207,11 +276,22 @@ func (fm *ForwardManager) TransformLabelsToServiceName(f forwardModel.Forward) (
 	return f, nil
 }
 
-// StartGlobalForwarding implements from the interface types.forwarder
+// SetStatusTracker sets the tracker that receives the health of the global forwards
+func (fm *ForwardManager) SetStatusTracker(tracker *forwardModel.StatusTracker) {
+	fm.tracker = tracker
+}
+
+// StartGlobalForwarding implements from the interface types.forwarder.
+// It starts the global forwards added since the last call
 // nolint:unparam
 func (fm *ForwardManager) StartGlobalForwarding() error {
 	for _, gf := range fm.globalForwards {
+		if gf.started {
+			continue
+		}
+		gf.started = true
 		gf.pool = fm.pool
+		gf.tracker = fm.tracker
 		go gf.start(fm.ctx)
 	}
 
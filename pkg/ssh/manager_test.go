@@ -19,6 +19,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -367,3 +368,108 @@ func TestAdd(t *testing.T) {
 		t.Fatalf("expected 'svc:15123', got '%s'", pf.forwards[1012].remoteAddress)
 	}
 }
+
+func TestAddUDPAndSocket(t *testing.T) {
+	pf := NewForwardManager(context.Background(), "0.0.0.0:22000", "0.0.0.0", "0.0.0.0", nil, "")
+	if err := pf.Add(forwardModel.Forward{Local: 10010, Remote: 1010}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := pf.Add(forwardModel.Forward{Local: 10010, Remote: 8125, Protocol: forwardModel.ProtocolUDP, Service: true, ServiceName: "statsd"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := pf.Add(forwardModel.Forward{Local: 10010, Remote: 8125, Protocol: forwardModel.ProtocolUDP}); err == nil {
+		t.Fatal("duplicated local UDP port didn't return an error")
+	}
+
+	if pf.udpForwards[10010].remoteAddress != "statsd:8125" {
+		t.Fatalf("expected 'statsd:8125', got '%s'", pf.udpForwards[10010].remoteAddress)
+	}
+
+	socket := filepath.Join(t.TempDir(), "docker.sock")
+	if err := pf.Add(forwardModel.Forward{LocalSocket: socket, Remote: 2375}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := pf.Add(forwardModel.Forward{LocalSocket: socket, Remote: 2376}); err == nil {
+		t.Fatal("duplicated local socket didn't return an error")
+	}
+
+	if pf.socketForwards[socket].network() != "unix" {
+		t.Fatalf("expected 'unix' network, got '%s'", pf.socketForwards[socket].network())
+	}
+
+	if err := pf.AddReverse(model.Reverse{Local: 10010, Remote: 8125, Protocol: forwardModel.ProtocolUDP}); err == nil {
+		t.Fatal("reverse on a forwarded local UDP port didn't return an error")
+	}
+
+	if err := pf.AddReverse(model.Reverse{LocalSocket: socket, Remote: 9229}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSocketForward(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sshPort, err := model.GetAvailablePort(model.Localhost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sshAddr := fmt.Sprintf("localhost:%d", sshPort)
+	ssh := testSSHHandler{}
+	go ssh.listenAndServe(sshAddr)
+	fm := NewForwardManager(ctx, sshAddr, model.Localhost, "0.0.0.0", nil, "")
+
+	remote, err := model.GetAvailablePort(model.Localhost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		server := &http.Server{
+			Addr:              net.JoinHostPort("", strconv.Itoa(remote)),
+			Handler:           &testHTTPHandler{message: "socket"},
+			ReadHeaderTimeout: 3 * time.Second,
+		}
+		if err := server.ListenAndServe(); err != nil {
+			oktetoLog.Infof("socket server failed: %s", err.Error())
+		}
+	}()
+
+	socket := filepath.Join(t.TempDir(), "forward.sock")
+	if err := fm.Add(forwardModel.Forward{LocalSocket: socket, Remote: remote}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fm.Start("", ""); err != nil {
+		t.Fatal(err)
+	}
+	defer fm.Stop()
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+
+	var body []byte
+	for i := 0; i < 50; i++ {
+		resp, err := client.Get("http://socket/")
+		if err == nil {
+			body, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err == nil && resp.StatusCode == http.StatusOK {
+				break
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if string(body) != "socket" {
+		t.Fatalf("got '%s', expected 'socket'", string(body))
+	}
+}
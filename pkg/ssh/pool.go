@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
@@ -29,9 +30,12 @@ const (
 )
 
 type pool struct {
-	client  *ssh.Client
-	ka      time.Duration
-	stopped bool
+	client      *ssh.Client
+	udpHandlers map[uint32]func(ssh.NewChannel)
+	ka          time.Duration
+	udpLock     sync.Mutex
+	udpOnce     sync.Once
+	stopped     bool
 }
 
 func startPool(ctx context.Context, serverAddr string, config *ssh.ClientConfig) (*pool, error) {
@@ -112,8 +116,101 @@ func (p *pool) getListener(address string) (net.Listener, error) {
 	return l, nil
 }
 
+// getUDP opens a channel to send the datagrams of origin to address
+func (p *pool) getUDP(address, origin string) (ssh.Channel, error) {
+	destHost, destPort, err := splitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	originHost, originPort, err := splitHostPort(origin)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := udpChannelMsg{
+		DestAddr:   destHost,
+		DestPort:   destPort,
+		OriginAddr: originHost,
+		OriginPort: originPort,
+	}
+	channel, reqs, err := p.client.OpenChannel(directUDPChannel, ssh.Marshal(&msg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open UDP channel to %s: %w", address, err)
+	}
+	go ssh.DiscardRequests(reqs)
+
+	return channel, nil
+}
+
+// listenUDP asks the remote server to listen for UDP datagrams on address. handler is called with the channel of every new remote peer.
+// The returned function stops listening
+func (p *pool) listenUDP(address string, handler func(ssh.NewChannel)) (func(), error) {
+	host, port, err := splitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	p.udpOnce.Do(func() {
+		go p.dispatchUDPChannels(p.client.HandleChannelOpen(forwardedUDPChannel))
+	})
+
+	p.udpLock.Lock()
+	if p.udpHandlers == nil {
+		p.udpHandlers = map[uint32]func(ssh.NewChannel){}
+	}
+	p.udpHandlers[port] = handler
+	p.udpLock.Unlock()
+
+	payload := ssh.Marshal(&udpForwardMsg{BindAddr: host, BindPort: port})
+	ok, _, err := p.client.SendRequest(udpForwardRequest, true, payload)
+	if err == nil && !ok {
+		err = fmt.Errorf("request denied by the remote server")
+	}
+	if err != nil {
+		p.removeUDPHandler(port)
+		return nil, fmt.Errorf("failed to start ssh UDP listener on %s: %w", address, err)
+	}
+
+	return func() {
+		p.removeUDPHandler(port)
+		if _, _, err := p.client.SendRequest(cancelUDPForwardRequest, false, payload); err != nil {
+			oktetoLog.Debugf("Error cancelling ssh UDP listener on %s: %s", address, err)
+		}
+	}, nil
+}
+
+func (p *pool) removeUDPHandler(port uint32) {
+	p.udpLock.Lock()
+	defer p.udpLock.Unlock()
+	delete(p.udpHandlers, port)
+}
+
+func (p *pool) dispatchUDPChannels(chans <-chan ssh.NewChannel) {
+	for newChannel := range chans {
+		var msg udpChannelMsg
+		if err := ssh.Unmarshal(newChannel.ExtraData(), &msg); err != nil {
+			if err := newChannel.Reject(ssh.ConnectionFailed, "malformed payload"); err != nil {
+				oktetoLog.Debugf("Error rejecting UDP channel: %s", err)
+			}
+			continue
+		}
+
+		p.udpLock.Lock()
+		handler, ok := p.udpHandlers[msg.DestPort]
+		p.udpLock.Unlock()
+		if !ok {
+			if err := newChannel.Reject(ssh.Prohibited, fmt.Sprintf("no UDP listener for port %d", msg.DestPort)); err != nil {
+				oktetoLog.Debugf("Error rejecting UDP channel: %s", err)
+			}
+			continue
+		}
+
+		go handler(newChannel)
+	}
+}
+
 func getTCPConnection(ctx context.Context, serverAddr string, keepAlive time.Duration) (net.Conn, error) {
-	c, err := getConn(ctx, serverAddr, defaultRetries)
+	c, err := getConn(ctx, "tcp", serverAddr, defaultRetries)
 	if err != nil {
 		return nil, err
 	}
@@ -129,12 +226,12 @@ func getTCPConnection(ctx context.Context, serverAddr string, keepAlive time.Dur
 	return c, nil
 }
 
-func getConn(ctx context.Context, serverAddr string, retries int) (net.Conn, error) {
+func getConn(ctx context.Context, network, serverAddr string, retries int) (net.Conn, error) {
 	var lastErr error
 	t := time.NewTicker(100 * time.Millisecond)
 	for i := 0; i < retries; i++ {
 		d := net.Dialer{}
-		c, err := d.DialContext(ctx, "tcp", serverAddr)
+		c, err := d.DialContext(ctx, network, serverAddr)
 		if err == nil {
 			return c, nil
 		}
@@ -23,6 +23,7 @@ import (
 
 	oktetoLog "github.com/okteto/okteto/pkg/log"
 	"github.com/okteto/okteto/pkg/model"
+	forwardModel "github.com/okteto/okteto/pkg/model/forward"
 )
 
 type reverse struct {
@@ -32,6 +33,25 @@ type reverse struct {
 // AddReverse adds a reverse forward
 func (fm *ForwardManager) AddReverse(f model.Reverse) error {
 
+	if f.Protocol == forwardModel.ProtocolUDP {
+		return fm.addUDPReverse(f)
+	}
+
+	if f.LocalSocket != "" {
+		key := f.String()
+		if _, ok := fm.socketReverses[key]; ok {
+			return fmt.Errorf("reverse '%s' is listed multiple times, please check your reverse forwards configuration", key)
+		}
+		fm.socketReverses[key] = &reverse{
+			forward: forward{
+				localNetwork:  "unix",
+				localAddress:  f.LocalSocket,
+				remoteAddress: net.JoinHostPort(fm.remoteInterface, strconv.Itoa(f.Remote)),
+			},
+		}
+		return nil
+	}
+
 	if err := fm.canAdd(f.Local, false); err != nil {
 		return err
 	}
@@ -95,7 +115,7 @@ func (r *reverse) handle(ctx context.Context, remote net.Conn) {
 	}()
 
 	quit := make(chan struct{}, 1)
-	local, err := getConn(ctx, r.localAddress, defaultRetries)
+	local, err := getConn(ctx, r.network(), r.localAddress, defaultRetries)
 	if err != nil {
 		oktetoLog.Infof("%s -> failed to listen on local address: %v", r.String(), err)
 		return
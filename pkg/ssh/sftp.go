@@ -0,0 +1,62 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTPClient is an SFTP session with the SSH server of a development container
+type SFTPClient struct {
+	*sftp.Client
+	conn *ssh.Client
+}
+
+// NewSFTPClient opens an SFTP session through the SSH port forward of a running 'okteto up' session
+func NewSFTPClient(ctx context.Context, iface string, remotePort int) (*SFTPClient, error) {
+	sshConfig, err := getSSHClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SSH configuration: %w", err)
+	}
+
+	conn, err := dial(ctx, "tcp", net.JoinHostPort(iface, strconv.Itoa(remotePort)), sshConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to SSH server: %w", err)
+	}
+
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		if err := conn.Close(); err != nil {
+			oktetoLog.Debugf("Error closing SSH connection: %s", err)
+		}
+		return nil, fmt.Errorf("failed to start SFTP session: %w", err)
+	}
+
+	return &SFTPClient{Client: client, conn: conn}, nil
+}
+
+// Close closes the SFTP session and its SSH connection
+func (c *SFTPClient) Close() error {
+	if err := c.Client.Close(); err != nil {
+		oktetoLog.Debugf("Error closing SFTP session: %s", err)
+	}
+	return c.conn.Close()
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+)
+
+// SOCKS5 protocol values, as defined in RFC 1928
+const (
+	socksVersion = 0x05
+
+	socksMethodNoAuth       = 0x00
+	socksMethodNoAcceptable = 0xff
+
+	socksCmdConnect = 0x01
+
+	socksAddrIPv4   = 0x01
+	socksAddrDomain = 0x03
+	socksAddrIPv6   = 0x04
+
+	socksReplySucceeded           = 0x00
+	socksReplyHostUnreachable     = 0x04
+	socksReplyCommandNotSupported = 0x07
+	socksReplyAddressNotSupported = 0x08
+)
+
+// socksProxy is a local SOCKS5 proxy that opens the requested connections from the development container.
+// Domain names are resolved by the development container, so in-cluster names like 'svc.namespace' work as-is
+type socksProxy struct {
+	forward
+}
+
+// AddSocks adds a SOCKS5 proxy listening on localPort
+func (fm *ForwardManager) AddSocks(localPort int) error {
+	if err := fm.canAdd(localPort, true); err != nil {
+		return err
+	}
+
+	fm.socks = &socksProxy{
+		forward: forward{
+			localAddress: net.JoinHostPort(fm.localInterface, strconv.Itoa(localPort)),
+		},
+	}
+
+	return nil
+}
+
+func (s *socksProxy) start(ctx context.Context) {
+	s.serve(ctx, s.handle)
+}
+
+func (s *socksProxy) handle(local net.Conn) {
+	defer func() {
+		if err := local.Close(); err != nil {
+			oktetoLog.Debugf("Error closing local connection: %s", err)
+		}
+	}()
+
+	address, err := socksHandshake(local)
+	if err != nil {
+		oktetoLog.Infof("%s -> handshake failed: %s", s.String(), err)
+		return
+	}
+
+	remote, err := s.pool.get(address)
+	if err != nil {
+		oktetoLog.Infof("%s -> failed to dial %s: %s", s.String(), address, err)
+		if err := writeSocksReply(local, socksReplyHostUnreachable); err != nil {
+			oktetoLog.Debugf("Error writing SOCKS reply: %s", err)
+		}
+		return
+	}
+	defer func() {
+		if err := remote.Close(); err != nil {
+			oktetoLog.Debugf("Error closing remote connection: %s", err)
+		}
+	}()
+
+	if err := writeSocksReply(local, socksReplySucceeded); err != nil {
+		oktetoLog.Infof("%s -> failed to write reply: %s", s.String(), err)
+		return
+	}
+
+	quit := make(chan struct{}, 1)
+	go s.transfer(remote, local, quit)
+	go s.transfer(local, remote, quit)
+
+	<-quit
+}
+
+func (s *socksProxy) String() string {
+	return fmt.Sprintf("ssh socks5 proxy %s", s.localAddress)
+}
+
+func (s *socksProxy) transfer(from io.Writer, to io.Reader, quit chan struct{}) {
+	_, err := io.Copy(from, to)
+	if err != nil {
+		if !oktetoErrors.IsClosedNetwork(err) {
+			oktetoLog.Infof("%s -> data transfer failed: %v", s.String(), err)
+		}
+	}
+
+	quit <- struct{}{}
+}
+
+// socksHandshake negotiates a SOCKS5 connection without authentication and returns the requested address
+func socksHandshake(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", fmt.Errorf("failed to read greeting: %w", err)
+	}
+	if header[0] != socksVersion {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", fmt.Errorf("failed to read authentication methods: %w", err)
+	}
+	if !bytes.Contains(methods, []byte{socksMethodNoAuth}) {
+		if _, err := conn.Write([]byte{socksVersion, socksMethodNoAcceptable}); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("the client doesn't support connections without authentication")
+	}
+	if _, err := conn.Write([]byte{socksVersion, socksMethodNoAuth}); err != nil {
+		return "", err
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", fmt.Errorf("failed to read request: %w", err)
+	}
+	if request[0] != socksVersion {
+		return "", fmt.Errorf("unsupported SOCKS version %d", request[0])
+	}
+	if request[1] != socksCmdConnect {
+		if err := writeSocksReply(conn, socksReplyCommandNotSupported); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddrIPv4, socksAddrIPv6:
+		size := net.IPv4len
+		if request[3] == socksAddrIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", fmt.Errorf("failed to read address: %w", err)
+		}
+		host = net.IP(ip).String()
+	case socksAddrDomain:
+		size := make([]byte, 1)
+		if _, err := io.ReadFull(conn, size); err != nil {
+			return "", fmt.Errorf("failed to read address: %w", err)
+		}
+		domain := make([]byte, size[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return "", fmt.Errorf("failed to read address: %w", err)
+		}
+		host = string(domain)
+	default:
+		if err := writeSocksReply(conn, socksReplyAddressNotSupported); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", fmt.Errorf("failed to read port: %w", err)
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// writeSocksReply writes a reply without a bound address. Clients only use it for the BIND command, which isn't supported
+func writeSocksReply(w io.Writer, reply byte) error {
+	_, err := w.Write([]byte{socksVersion, reply, 0x00, socksAddrIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
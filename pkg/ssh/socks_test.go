@@ -0,0 +1,201 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	forwardModel "github.com/okteto/okteto/pkg/model/forward"
+)
+
+// rwBuffer reads the client messages from in and writes the server messages into out
+type rwBuffer struct {
+	in  *bytes.Buffer
+	out *bytes.Buffer
+}
+
+func (b *rwBuffer) Read(p []byte) (int, error)  { return b.in.Read(p) }
+func (b *rwBuffer) Write(p []byte) (int, error) { return b.out.Write(p) }
+
+func socksRequest(atyp byte, addr []byte, port uint16) []byte {
+	request := []byte{socksVersion, 1, socksMethodNoAuth, socksVersion, socksCmdConnect, 0x00, atyp}
+	request = append(request, addr...)
+	return binary.BigEndian.AppendUint16(request, port)
+}
+
+func TestSocksHandshake(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		reply    []byte
+		request  []byte
+		wantErr  bool
+	}{
+		{
+			name:     "domain",
+			request:  socksRequest(socksAddrDomain, append([]byte{11}, "api.staging"...), 8080),
+			expected: "api.staging:8080",
+			reply:    []byte{socksVersion, socksMethodNoAuth},
+		},
+		{
+			name:     "ipv4",
+			request:  socksRequest(socksAddrIPv4, []byte{10, 0, 0, 1}, 5432),
+			expected: "10.0.0.1:5432",
+			reply:    []byte{socksVersion, socksMethodNoAuth},
+		},
+		{
+			name:     "ipv6",
+			request:  socksRequest(socksAddrIPv6, net.ParseIP("::1"), 80),
+			expected: "[::1]:80",
+			reply:    []byte{socksVersion, socksMethodNoAuth},
+		},
+		{
+			name:    "authentication required",
+			request: []byte{socksVersion, 1, 0x02},
+			reply:   []byte{socksVersion, socksMethodNoAcceptable},
+			wantErr: true,
+		},
+		{
+			name:    "bind command",
+			request: []byte{socksVersion, 1, socksMethodNoAuth, socksVersion, 0x02, 0x00, socksAddrIPv4, 10, 0, 0, 1, 0, 80},
+			reply:   []byte{socksVersion, socksMethodNoAuth, socksVersion, socksReplyCommandNotSupported, 0x00, socksAddrIPv4, 0, 0, 0, 0, 0, 0},
+			wantErr: true,
+		},
+		{
+			name:    "socks4",
+			request: []byte{0x04, 1, 0x00},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &rwBuffer{in: bytes.NewBuffer(tt.request), out: &bytes.Buffer{}}
+			address, err := socksHandshake(conn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("socksHandshake() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if address != tt.expected {
+				t.Errorf("got address '%s', expected '%s'", address, tt.expected)
+			}
+			if !bytes.Equal(conn.out.Bytes(), tt.reply) {
+				t.Errorf("got reply %v, expected %v", conn.out.Bytes(), tt.reply)
+			}
+		})
+	}
+}
+
+func TestSocksProxy(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sshPort, err := model.GetAvailablePort(model.Localhost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sshAddr := fmt.Sprintf("localhost:%d", sshPort)
+	ssh := testSSHHandler{}
+	go ssh.listenAndServe(sshAddr)
+	fm := NewForwardManager(ctx, sshAddr, model.Localhost, "0.0.0.0", nil, "")
+
+	remote, err := model.GetAvailablePort(model.Localhost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		server := &http.Server{
+			Addr:              net.JoinHostPort("", strconv.Itoa(remote)),
+			Handler:           &testHTTPHandler{message: "socks"},
+			ReadHeaderTimeout: 3 * time.Second,
+		}
+		if err := server.ListenAndServe(); err != nil {
+			oktetoLog.Infof("socks server failed: %s", err.Error())
+		}
+	}()
+
+	socksPort, err := model.GetAvailablePort(model.Localhost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := fm.AddSocks(socksPort); err != nil {
+		t.Fatal(err)
+	}
+	if err := fm.Add(forwardModel.Forward{Local: socksPort, Remote: remote}); err == nil {
+		t.Fatal("forward on the SOCKS5 port didn't return an error")
+	}
+
+	if err := fm.Start("", ""); err != nil {
+		t.Fatal(err)
+	}
+	defer fm.Stop()
+
+	var body string
+	for i := 0; i < 50; i++ {
+		body, err = getThroughSocks(net.JoinHostPort(model.Localhost, strconv.Itoa(socksPort)), "localhost", remote)
+		if err == nil {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if body != "socks" {
+		t.Fatalf("got '%s', expected 'socks'", body)
+	}
+}
+
+func getThroughSocks(proxy, host string, port int) (string, error) {
+	conn, err := net.Dial("tcp", proxy)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write(socksRequest(socksAddrDomain, append([]byte{byte(len(host))}, host...), uint16(port))); err != nil {
+		return "", err
+	}
+
+	reply := make([]byte, 12)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return "", err
+	}
+	if reply[3] != socksReplySucceeded {
+		return "", fmt.Errorf("socks connection failed with code %d", reply[3])
+	}
+
+	if _, err := fmt.Fprintf(conn, "GET / HTTP/1.0\r\nHost: %s\r\n\r\n", host); err != nil {
+		return "", err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
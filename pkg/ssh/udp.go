@@ -0,0 +1,414 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	oktetoErrors "github.com/okteto/okteto/pkg/errors"
+	oktetoLog "github.com/okteto/okteto/pkg/log"
+	"github.com/okteto/okteto/pkg/model"
+	forwardModel "github.com/okteto/okteto/pkg/model/forward"
+	"golang.org/x/crypto/ssh"
+)
+
+// The SSH protocol only tunnels streams, so UDP datagrams are sent over custom channels implemented by okteto-remote.
+// Each datagram is prefixed by its length to keep the datagram boundaries.
+const (
+	// directUDPChannel opens a UDP session from the local machine to an address reachable from the development container
+	directUDPChannel = "direct-udp@okteto.com"
+
+	// forwardedUDPChannel opens a UDP session from a port of the development container to the local machine
+	forwardedUDPChannel = "forwarded-udp@okteto.com"
+
+	// udpForwardRequest asks the development container to listen for UDP datagrams on a port
+	udpForwardRequest = "udp-forward@okteto.com"
+
+	// cancelUDPForwardRequest asks the development container to stop listening for UDP datagrams on a port
+	cancelUDPForwardRequest = "cancel-udp-forward@okteto.com"
+
+	maxDatagramSize   = 65535
+	udpSessionTimeout = 2 * time.Minute
+)
+
+// udpChannelMsg is the payload of the UDP channels. It has the same layout as the 'direct-tcpip' payload (RFC 4254 7.2)
+type udpChannelMsg struct {
+	DestAddr   string
+	DestPort   uint32
+	OriginAddr string
+	OriginPort uint32
+}
+
+// udpForwardMsg is the payload of the UDP forward requests. It has the same layout as the 'tcpip-forward' payload (RFC 4254 7.1)
+type udpForwardMsg struct {
+	BindAddr string
+	BindPort uint32
+}
+
+// writeDatagram writes a datagram prefixed by its length
+func writeDatagram(w io.Writer, datagram []byte) error {
+	if len(datagram) > maxDatagramSize {
+		return fmt.Errorf("datagram of %d bytes exceeds the maximum size", len(datagram))
+	}
+
+	frame := make([]byte, 2+len(datagram))
+	binary.BigEndian.PutUint16(frame, uint16(len(datagram)))
+	copy(frame[2:], datagram)
+	_, err := w.Write(frame)
+	return err
+}
+
+// readDatagram reads a datagram written by writeDatagram into buf
+func readDatagram(r io.Reader, buf []byte) (int, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, err
+	}
+
+	size := int(binary.BigEndian.Uint16(header[:]))
+	if size > len(buf) {
+		return 0, fmt.Errorf("datagram of %d bytes exceeds the buffer size", size)
+	}
+
+	return io.ReadFull(r, buf[:size])
+}
+
+// proxyDatagrams copies datagrams between a connected UDP socket and a channel until one of them is closed
+// or the session doesn't receive replies for udpSessionTimeout
+func proxyDatagrams(conn net.Conn, channel io.ReadWriter) error {
+	errs := make(chan error, 2)
+
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, err := readDatagram(channel, buf)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if _, err := conn.Write(buf[:n]); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			if err := conn.SetReadDeadline(time.Now().Add(udpSessionTimeout)); err != nil {
+				errs <- err
+				return
+			}
+			n, err := conn.Read(buf)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if err := writeDatagram(channel, buf[:n]); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	err := <-errs
+	if errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return nil
+	}
+
+	return err
+}
+
+func splitHostPort(address string) (string, uint32, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", 0, err
+	}
+
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid port in address '%s': %w", address, err)
+	}
+
+	return host, uint32(p), nil
+}
+
+func isUDPPortAvailable(iface string, port int) bool {
+	conn, err := net.ListenPacket("udp", net.JoinHostPort(iface, strconv.Itoa(port)))
+	if err != nil {
+		return false
+	}
+
+	if err := conn.Close(); err != nil {
+		oktetoLog.Debugf("Error closing UDP listener: %s", err)
+	}
+	return true
+}
+
+func (fm *ForwardManager) canAddUDP(localPort int, checkAvailable bool) error {
+	if _, ok := fm.udpReverses[localPort]; ok {
+		return fmt.Errorf("UDP port %d is listed multiple times, please check your reverse forwards configuration", localPort)
+	}
+
+	if _, ok := fm.udpForwards[localPort]; ok {
+		return fmt.Errorf("UDP port %d is listed multiple times, please check your forwards configuration", localPort)
+	}
+
+	if checkAvailable && !isUDPPortAvailable(fm.localInterface, localPort) {
+		return fmt.Errorf("local UDP port %d is already in-use in your local machine: %w", localPort, oktetoErrors.ErrPortAlreadyAllocated)
+	}
+
+	return nil
+}
+
+func (fm *ForwardManager) addUDP(f forwardModel.Forward) error {
+	if err := fm.canAddUDP(f.Local, true); err != nil {
+		return err
+	}
+
+	remoteHost := fm.remoteInterface
+	if f.Service {
+		remoteHost = f.ServiceName
+	}
+
+	fm.udpForwards[f.Local] = &udpForward{
+		forward: forward{
+			localAddress:  net.JoinHostPort(fm.localInterface, strconv.Itoa(f.Local)),
+			remoteAddress: net.JoinHostPort(remoteHost, strconv.Itoa(f.Remote)),
+		},
+		sessions: map[string]*udpSession{},
+	}
+
+	return nil
+}
+
+func (fm *ForwardManager) addUDPReverse(r model.Reverse) error {
+	if err := fm.canAddUDP(r.Local, false); err != nil {
+		return err
+	}
+
+	fm.udpReverses[r.Local] = &udpReverse{
+		forward: forward{
+			localAddress:  net.JoinHostPort(fm.localInterface, strconv.Itoa(r.Local)),
+			remoteAddress: net.JoinHostPort(fm.remoteInterface, strconv.Itoa(r.Remote)),
+		},
+	}
+
+	return nil
+}
+
+// udpForward sends the datagrams received on a local port to the development container.
+// Every local peer gets its own channel, so replies are sent back to the right peer.
+type udpForward struct {
+	sessions map[string]*udpSession
+	forward
+	sessionsLock sync.Mutex
+}
+
+type udpSession struct {
+	channel io.ReadWriteCloser
+	timer   *time.Timer
+}
+
+func (f *udpForward) start(ctx context.Context) {
+	conn, err := net.ListenPacket("udp", f.localAddress)
+	if err != nil {
+		oktetoLog.Infof("%s -> failed to listen: %s", f.String(), err)
+		return
+	}
+
+	go func() {
+		<-ctx.Done()
+		f.setDisconnected()
+		if err := conn.Close(); err != nil {
+			oktetoLog.Infof("%s -> failed to close: %s", f.String(), err)
+		}
+		f.closeSessions()
+		oktetoLog.Infof("%s -> done", f.String())
+	}()
+
+	f.setConnected()
+
+	tick := time.NewTicker(100 * time.Millisecond)
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if !f.connected() {
+				return
+			}
+
+			oktetoLog.Infof("%s -> failed to read datagram: %v", f.String(), err)
+			<-tick.C
+			continue
+		}
+
+		s, err := f.session(conn, addr)
+		if err != nil {
+			oktetoLog.Infof("%s -> failed to open remote session: %s", f.String(), err)
+			continue
+		}
+
+		if err := writeDatagram(s.channel, buf[:n]); err != nil {
+			oktetoLog.Infof("%s -> data transfer failed: %v", f.String(), err)
+			f.closeSession(addr.String(), s)
+		}
+	}
+}
+
+func (f *udpForward) session(conn net.PacketConn, addr net.Addr) (*udpSession, error) {
+	f.sessionsLock.Lock()
+	defer f.sessionsLock.Unlock()
+
+	key := addr.String()
+	if s, ok := f.sessions[key]; ok {
+		s.timer.Reset(udpSessionTimeout)
+		return s, nil
+	}
+
+	channel, err := f.pool.getUDP(f.remoteAddress, key)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &udpSession{channel: channel}
+	s.timer = time.AfterFunc(udpSessionTimeout, func() {
+		f.closeSession(key, s)
+	})
+	f.sessions[key] = s
+
+	go f.reply(conn, addr, s)
+	return s, nil
+}
+
+// reply sends the datagrams received from the development container back to the local peer
+func (f *udpForward) reply(conn net.PacketConn, addr net.Addr, s *udpSession) {
+	defer f.closeSession(addr.String(), s)
+
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, err := readDatagram(s.channel, buf)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !oktetoErrors.IsClosedNetwork(err) {
+				oktetoLog.Infof("%s -> data transfer failed: %v", f.String(), err)
+			}
+			return
+		}
+
+		s.timer.Reset(udpSessionTimeout)
+		if _, err := conn.WriteTo(buf[:n], addr); err != nil {
+			oktetoLog.Infof("%s -> failed to write datagram: %v", f.String(), err)
+			return
+		}
+	}
+}
+
+func (f *udpForward) closeSession(key string, s *udpSession) {
+	f.sessionsLock.Lock()
+	if f.sessions[key] == s {
+		delete(f.sessions, key)
+	}
+	f.sessionsLock.Unlock()
+
+	s.timer.Stop()
+	if err := s.channel.Close(); err != nil && !errors.Is(err, io.EOF) {
+		oktetoLog.Debugf("Error closing UDP session: %s", err)
+	}
+}
+
+func (f *udpForward) closeSessions() {
+	f.sessionsLock.Lock()
+	sessions := f.sessions
+	f.sessions = map[string]*udpSession{}
+	f.sessionsLock.Unlock()
+
+	for key, s := range sessions {
+		f.closeSession(key, s)
+	}
+}
+
+func (f *udpForward) String() string {
+	return fmt.Sprintf("ssh udp forward %s->%s", f.localAddress, f.remoteAddress)
+}
+
+// udpReverse sends the datagrams received on a port of the development container to a local port
+type udpReverse struct {
+	forward
+}
+
+func (r *udpReverse) start(ctx context.Context) {
+	cancel, err := r.pool.listenUDP(r.remoteAddress, func(newChannel ssh.NewChannel) {
+		r.handle(newChannel)
+	})
+	if err != nil {
+		oktetoLog.Infof("%s -> failed to listen on remote address: %v", r.String(), err)
+		return
+	}
+
+	r.setConnected()
+	<-ctx.Done()
+	r.setDisconnected()
+	cancel()
+	oktetoLog.Infof("%s -> done", r.String())
+}
+
+func (r *udpReverse) handle(newChannel ssh.NewChannel) {
+	local, err := net.Dial("udp", r.localAddress)
+	if err != nil {
+		oktetoLog.Infof("%s -> failed to dial local address: %v", r.String(), err)
+		if err := newChannel.Reject(ssh.ConnectionFailed, err.Error()); err != nil {
+			oktetoLog.Debugf("Error rejecting UDP channel: %s", err)
+		}
+		return
+	}
+	defer func() {
+		if err := local.Close(); err != nil {
+			oktetoLog.Debugf("Error closing local connection: %s", err)
+		}
+	}()
+
+	channel, reqs, err := newChannel.Accept()
+	if err != nil {
+		oktetoLog.Infof("%s -> failed to accept channel: %v", r.String(), err)
+		return
+	}
+	go ssh.DiscardRequests(reqs)
+	defer func() {
+		if err := channel.Close(); err != nil && !errors.Is(err, io.EOF) {
+			oktetoLog.Debugf("Error closing remote channel: %s", err)
+		}
+	}()
+
+	if err := proxyDatagrams(local, channel); err != nil {
+		oktetoLog.Infof("%s -> data transfer failed: %v", r.String(), err)
+	}
+}
+
+func (r *udpReverse) String() string {
+	return fmt.Sprintf("ssh udp reverse forward %s<-%s", r.localAddress, r.remoteAddress)
+}
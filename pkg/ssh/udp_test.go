@@ -0,0 +1,165 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestDatagramFraming(t *testing.T) {
+	var b bytes.Buffer
+	for _, d := range []string{"first", "", "third"} {
+		if err := writeDatagram(&b, []byte(d)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := make([]byte, maxDatagramSize)
+	for _, expected := range []string{"first", "", "third"} {
+		n, err := readDatagram(&b, buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf[:n]) != expected {
+			t.Errorf("got datagram '%s', expected '%s'", buf[:n], expected)
+		}
+	}
+
+	if err := writeDatagram(&b, make([]byte, maxDatagramSize+1)); err == nil {
+		t.Error("oversized datagram didn't return an error")
+	}
+}
+
+// udpWireFormat pins the bytes exchanged with okteto-remote. tools/remote/pkg/ssh has the same test, so update both when the protocol changes
+var udpWireFormat = []struct {
+	name     string
+	payload  func() []byte
+	expected []byte
+}{
+	{
+		name: "datagram",
+		payload: func() []byte {
+			var b bytes.Buffer
+			if err := writeDatagram(&b, []byte("ping")); err != nil {
+				panic(err)
+			}
+			return b.Bytes()
+		},
+		expected: []byte{0x00, 0x04, 'p', 'i', 'n', 'g'},
+	},
+	{
+		name: "empty datagram",
+		payload: func() []byte {
+			var b bytes.Buffer
+			if err := writeDatagram(&b, nil); err != nil {
+				panic(err)
+			}
+			return b.Bytes()
+		},
+		expected: []byte{0x00, 0x00},
+	},
+	{
+		name: "channel payload",
+		payload: func() []byte {
+			return ssh.Marshal(&udpChannelMsg{DestAddr: "localhost", DestPort: 53, OriginAddr: "127.0.0.1", OriginPort: 1234})
+		},
+		expected: []byte{
+			0x00, 0x00, 0x00, 0x09, 'l', 'o', 'c', 'a', 'l', 'h', 'o', 's', 't',
+			0x00, 0x00, 0x00, 0x35,
+			0x00, 0x00, 0x00, 0x09, '1', '2', '7', '.', '0', '.', '0', '.', '1',
+			0x00, 0x00, 0x04, 0xd2,
+		},
+	},
+	{
+		name: "forward request payload",
+		payload: func() []byte {
+			return ssh.Marshal(&udpForwardMsg{BindAddr: "0.0.0.0", BindPort: 5353})
+		},
+		expected: []byte{
+			0x00, 0x00, 0x00, 0x07, '0', '.', '0', '.', '0', '.', '0',
+			0x00, 0x00, 0x14, 0xe9,
+		},
+	},
+}
+
+func TestUDPWireFormat(t *testing.T) {
+	for _, tt := range udpWireFormat {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.payload(); !bytes.Equal(got, tt.expected) {
+				t.Errorf("got % x, expected % x", got, tt.expected)
+			}
+		})
+	}
+	if directUDPChannel != "direct-udp@okteto.com" || forwardedUDPChannel != "forwarded-udp@okteto.com" ||
+		udpForwardRequest != "udp-forward@okteto.com" || cancelUDPForwardRequest != "cancel-udp-forward@okteto.com" {
+		t.Error("the UDP channel and request names changed")
+	}
+}
+
+func TestProxyDatagrams(t *testing.T) {
+	echo, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer echo.Close()
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, addr, err := echo.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if _, err := echo.WriteTo(buf[:n], addr); err != nil {
+				return
+			}
+		}
+	}()
+
+	conn, err := net.Dial("udp", echo.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	local, remote := net.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- proxyDatagrams(conn, remote)
+	}()
+
+	if err := writeDatagram(local, []byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, maxDatagramSize)
+	if err := local.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	n, err := readDatagram(local, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != "hello" {
+		t.Errorf("got '%s', expected 'hello'", buf[:n])
+	}
+
+	local.Close()
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
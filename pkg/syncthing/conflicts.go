@@ -0,0 +1,136 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncthing
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+)
+
+const (
+	// syncthing names conflict copies '<name>.sync-conflict-<date>-<time>-<modifiedBy><ext>',
+	// where modifiedBy is the short ID of the device that modified the version kept in the copy
+	conflictTimeLayout = "20060102-150405"
+	shortDeviceIDSize  = 7
+)
+
+var conflictRegex = regexp.MustCompile(`^(.*)\.sync-conflict-(\d{8}-\d{6})-([A-Z0-9]{7})(\.[^.]*)?$`)
+
+// Conflict is a file modified locally and in the development container at the same time.
+// Syncthing keeps one of the versions in Path and the other one in a conflict copy
+type Conflict struct {
+	Time       time.Time
+	Folder     string
+	Path       string
+	CopyPath   string
+	ModifiedBy string
+}
+
+// ParseConflict returns the conflict of a conflict copy, and false if path is not a conflict copy
+func ParseConflict(folder, path string) (Conflict, bool) {
+	dir, name := filepath.Split(path)
+	m := conflictRegex.FindStringSubmatch(name)
+	if m == nil {
+		return Conflict{}, false
+	}
+
+	t, err := time.ParseInLocation(conflictTimeLayout, m[2], time.Local)
+	if err != nil {
+		return Conflict{}, false
+	}
+
+	return Conflict{
+		Folder:     folder,
+		Path:       filepath.Join(dir, m[1]+m[4]),
+		CopyPath:   path,
+		ModifiedBy: m[3],
+		Time:       t,
+	}, true
+}
+
+// LocalInCopy returns true if the conflict copy keeps the local version of the file
+func (c Conflict) LocalInCopy() bool {
+	return c.ModifiedBy == LocalDeviceID[:shortDeviceIDSize]
+}
+
+// RelativePath returns the path of the file relative to its sync folder
+func (c Conflict) RelativePath() string {
+	rel, err := filepath.Rel(c.Folder, c.Path)
+	if err != nil {
+		return c.Path
+	}
+	return rel
+}
+
+// KeepLocal resolves the conflict keeping the local version of the file. Syncthing sends it to the development container
+func (c Conflict) KeepLocal() error {
+	return c.keep(c.LocalInCopy())
+}
+
+// KeepRemote resolves the conflict keeping the version of the file in the development container
+func (c Conflict) KeepRemote() error {
+	return c.keep(!c.LocalInCopy())
+}
+
+func (c Conflict) keep(copyVersion bool) error {
+	if copyVersion {
+		if err := os.Rename(c.CopyPath, c.Path); err != nil {
+			return fmt.Errorf("failed to restore '%s': %w", c.RelativePath(), err)
+		}
+		return nil
+	}
+
+	if err := os.Remove(c.CopyPath); err != nil {
+		return fmt.Errorf("failed to remove the conflict copy of '%s': %w", c.RelativePath(), err)
+	}
+	return nil
+}
+
+// FindConflicts returns the conflicts of the local sync folders, sorted by path
+func FindConflicts(folders []string) ([]Conflict, error) {
+	result := []Conflict{}
+	for _, folder := range folders {
+		err := filepath.WalkDir(folder, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if d.Name() == ".stversions" || d.Name() == ".git" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if c, ok := ParseConflict(folder, path); ok {
+				result = append(result, c)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to look for conflicts in '%s': %w", folder, err)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Path == result[j].Path {
+			return result[i].CopyPath < result[j].CopyPath
+		}
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncthing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConflict(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		expectedPath string
+		modifiedBy   string
+		ok           bool
+	}{
+		{
+			name:         "with-extension",
+			path:         "/app/src/main.sync-conflict-20260102-150405-ABKAVQF.go",
+			expectedPath: "/app/src/main.go",
+			modifiedBy:   "ABKAVQF",
+			ok:           true,
+		},
+		{
+			name:         "without-extension",
+			path:         "/app/Makefile.sync-conflict-20260102-150405-ATOPHFJ",
+			expectedPath: "/app/Makefile",
+			modifiedBy:   "ATOPHFJ",
+			ok:           true,
+		},
+		{
+			name:         "multiple-extensions",
+			path:         "/app/dist.tar.sync-conflict-20260102-150405-ATOPHFJ.gz",
+			expectedPath: "/app/dist.tar.gz",
+			modifiedBy:   "ATOPHFJ",
+			ok:           true,
+		},
+		{
+			name: "regular-file",
+			path: "/app/src/main.go",
+		},
+		{
+			name: "invalid-date",
+			path: "/app/main.sync-conflict-2026-150405-ABKAVQF.go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, ok := ParseConflict("/app", filepath.FromSlash(tt.path))
+			assert.Equal(t, tt.ok, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, filepath.FromSlash(tt.expectedPath), c.Path)
+			assert.Equal(t, tt.modifiedBy, c.ModifiedBy)
+			assert.Equal(t, 2026, c.Time.Year())
+		})
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestFindAndResolveConflicts(t *testing.T) {
+	dir := t.TempDir()
+	localCopy := filepath.Join(dir, "src", "main.sync-conflict-20260102-150405-ABKAVQF.go")
+	remoteCopy := filepath.Join(dir, "README.sync-conflict-20260102-150405-ATOPHFJ.md")
+	writeFile(t, filepath.Join(dir, "src", "main.go"), "remote")
+	writeFile(t, localCopy, "local")
+	writeFile(t, filepath.Join(dir, "README.md"), "local")
+	writeFile(t, remoteCopy, "remote")
+	writeFile(t, filepath.Join(dir, ".git", "index.sync-conflict-20260102-150405-ABKAVQF"), "ignored")
+
+	conflicts, err := FindConflicts([]string{dir})
+	require.NoError(t, err)
+	require.Len(t, conflicts, 2)
+	assert.Equal(t, "README.md", conflicts[0].RelativePath())
+	assert.False(t, conflicts[0].LocalInCopy())
+	assert.Equal(t, filepath.Join("src", "main.go"), conflicts[1].RelativePath())
+	assert.True(t, conflicts[1].LocalInCopy())
+
+	// the local version of README.md is in the file, the local version of main.go is in the copy
+	require.NoError(t, conflicts[0].KeepLocal())
+	assert.Equal(t, "local", readFile(t, filepath.Join(dir, "README.md")))
+	assert.NoFileExists(t, remoteCopy)
+
+	require.NoError(t, conflicts[1].KeepLocal())
+	assert.Equal(t, "local", readFile(t, filepath.Join(dir, "src", "main.go")))
+	assert.NoFileExists(t, localCopy)
+
+	conflicts, err = FindConflicts([]string{dir})
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+}
+
+func TestKeepRemote(t *testing.T) {
+	dir := t.TempDir()
+	localCopy := filepath.Join(dir, "main.sync-conflict-20260102-150405-ABKAVQF.go")
+	writeFile(t, filepath.Join(dir, "main.go"), "remote")
+	writeFile(t, localCopy, "local")
+
+	c, ok := ParseConflict(dir, localCopy)
+	require.True(t, ok)
+	require.NoError(t, c.KeepRemote())
+	assert.Equal(t, "remote", readFile(t, filepath.Join(dir, "main.go")))
+	assert.NoFileExists(t, localCopy)
+}
+
+func TestGetNewConflicts(t *testing.T) {
+	events := `[
+  {"id": 3, "type": "LocalChangeDetected", "data": {"action": "added", "folderID": "okteto-1", "path": "src/main.sync-conflict-20260102-150405-ABKAVQF.go", "type": "file"}},
+  {"id": 4, "type": "RemoteChangeDetected", "data": {"action": "modified", "folderID": "okteto-1", "path": "src/main.go", "type": "file"}},
+  {"id": 5, "type": "RemoteChangeDetected", "data": {"action": "added", "folderID": "okteto-2", "path": "a.sync-conflict-20260102-150405-ATOPHFJ.txt", "type": "file"}},
+  {"id": 6, "type": "LocalChangeDetected", "data": {"action": "deleted", "folderID": "okteto-1", "path": "b.sync-conflict-20260102-150405-ATOPHFJ.txt", "type": "file"}}
+]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/events/disk", r.URL.Path)
+		assert.Equal(t, "2", r.URL.Query().Get("since"))
+		_, _ = w.Write([]byte(events))
+	}))
+	defer server.Close()
+
+	s := &Syncthing{
+		Client:     NewAPIClient(),
+		GUIAddress: strings.TrimPrefix(server.URL, "http://"),
+		Folders: []*Folder{
+			{Name: "1", LocalPath: filepath.FromSlash("/app")},
+			{Name: "2", LocalPath: filepath.FromSlash("/data")},
+		},
+	}
+
+	conflicts, last, err := s.getNewConflicts(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), last)
+	require.Len(t, conflicts, 2)
+	assert.Equal(t, filepath.FromSlash("/app/src/main.go"), conflicts[0].Path)
+	assert.Equal(t, filepath.FromSlash("/data/a.txt"), conflicts[1].Path)
+}
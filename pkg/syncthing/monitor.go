@@ -15,6 +15,10 @@ package syncthing
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strconv"
 	"time"
 
 	oktetoErrors "github.com/okteto/okteto/pkg/errors"
@@ -79,3 +83,87 @@ func (s *Syncthing) checkLocalAndRemoteStatus(ctx context.Context) error {
 	}
 	return s.IsHealthy(ctx, false, maxRetries)
 }
+
+// ChangeDetectedEvent represents a file change detected by syncthing, locally or in a remote device
+type ChangeDetectedEvent struct {
+	Type string                  `json:"type"`
+	Data DataChangeDetectedEvent `json:"data"`
+	ID   int64                   `json:"id"`
+}
+
+// DataChangeDetectedEvent represents the data of a change detected by syncthing
+type DataChangeDetectedEvent struct {
+	Action   string `json:"action"`
+	FolderID string `json:"folderID"`
+	Path     string `json:"path"`
+	Type     string `json:"type"`
+}
+
+// MonitorConflicts calls report with every conflict copy created while synchronizing the files
+func (s *Syncthing) MonitorConflicts(ctx context.Context, report func(Conflict)) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	var since int64
+	reported := map[string]bool{}
+	for {
+		conflicts, last, err := s.getNewConflicts(ctx, since)
+		if err != nil {
+			oktetoLog.Infof("error checking syncthing conflicts: %s", err)
+		} else {
+			since = last
+			for _, c := range conflicts {
+				if reported[c.CopyPath] {
+					continue
+				}
+				reported[c.CopyPath] = true
+				report(c)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// getNewConflicts returns the conflict copies detected by the local syncthing after the event since, and the last event ID
+func (s *Syncthing) getNewConflicts(ctx context.Context, since int64) ([]Conflict, int64, error) {
+	params := map[string]string{
+		"since":   strconv.FormatInt(since, 10),
+		"timeout": "0",
+	}
+	body, err := s.APICall(ctx, "rest/events/disk", "GET", http.StatusOK, params, true, nil, true, 0)
+	if err != nil {
+		return nil, since, err
+	}
+
+	events := []ChangeDetectedEvent{}
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, since, err
+	}
+
+	folders := map[string]string{}
+	for _, f := range s.Folders {
+		folders[GetFolderName(f)] = f.LocalPath
+	}
+
+	result := []Conflict{}
+	for _, e := range events {
+		if e.ID > since {
+			since = e.ID
+		}
+		if e.Data.Action == "deleted" || e.Data.Type == "dir" {
+			continue
+		}
+		folder, ok := folders[e.Data.FolderID]
+		if !ok {
+			continue
+		}
+		if c, ok := ParseConflict(folder, filepath.Join(folder, filepath.FromSlash(e.Data.Path))); ok {
+			result = append(result, c)
+		}
+	}
+	return result, since, nil
+}
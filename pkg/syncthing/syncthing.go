@@ -64,6 +64,9 @@ const (
 	// DefaultFileWatcherDelay how much to wait before starting a sync after a file change
 	DefaultFileWatcherDelay = 5
 
+	// keptConflicts is the number of conflict copies kept per file when 'sync.keepConflicts' is enabled
+	keptConflicts = 10
+
 	// ClusterPort is the port used by syncthing in the cluster
 	ClusterPort = 22000
 
@@ -104,6 +107,7 @@ type Syncthing struct {
 	FileWatcherDelay int           `yaml:"-"`
 	RemoteGUIPort    int           `yaml:"-"`
 	RemotePort       int           `yaml:"-"`
+	MaxConflicts     int           `yaml:"-"`
 	LocalGUIPort     int           `yaml:"-"`
 	LocalPort        int           `yaml:"-"`
 	pid              int           `yaml:"-"`
@@ -235,6 +239,11 @@ func New(dev *model.Dev, namespace string, fs afero.Fs) (*Syncthing, error) {
 	if dev.Sync.Compression {
 		compression = "always"
 	}
+
+	maxConflicts := 0
+	if dev.Sync.KeepConflicts {
+		maxConflicts = keptConflicts
+	}
 	s := &Syncthing{
 		APIKey:           "cnd",
 		GUIPassword:      pwd,
@@ -259,6 +268,7 @@ func New(dev *model.Dev, namespace string, fs afero.Fs) (*Syncthing, error) {
 		Folders:          []*Folder{},
 		RescanInterval:   strconv.Itoa(dev.Sync.RescanInterval),
 		Compression:      compression,
+		MaxConflicts:     maxConflicts,
 		timeout:          dev.Timeout.Default,
 		Fs:               fs,
 	}
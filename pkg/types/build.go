@@ -51,10 +51,12 @@ type BuildOptions struct {
 	Secrets            []string
 	ExportCache        []string
 	// CommandArgs comes from the user input on the command
-	CommandArgs  []string
-	SshSessions  []BuildSshSession
-	ExtraHosts   []HostMap
-	CacheFrom    []string
+	CommandArgs []string
+	SshSessions []BuildSshSession
+	ExtraHosts  []HostMap
+	CacheFrom   []string
+	// Parallelism is the maximum number of services built at the same time
+	Parallelism  int
 	NoCache      bool
 	EnableStages bool
 }
@@ -265,13 +265,15 @@ func (srv *Server) ListenAndServe() error {
 
 func (srv *Server) getServer() *ssh.Server {
 	forwardHandler := &ssh.ForwardedTCPHandler{}
+	udpForwardHandler := &forwardedUDPHandler{}
 
 	server := &ssh.Server{
 		Addr:    fmt.Sprintf(":%d", srv.Port),
 		Handler: srv.connectionHandler,
 		ChannelHandlers: map[string]ssh.ChannelHandler{
-			"direct-tcpip": ssh.DirectTCPIPHandler,
-			"session":      ssh.DefaultSessionHandler,
+			"direct-tcpip":   ssh.DirectTCPIPHandler,
+			directUDPChannel: directUDPHandler,
+			"session":        ssh.DefaultSessionHandler,
 		},
 		LocalPortForwardingCallback: ssh.LocalPortForwardingCallback(func(ctx ssh.Context, dhost string, dport uint32) bool {
 			log.Println("Accepted forward", dhost, dport)
@@ -282,8 +284,10 @@ func (srv *Server) getServer() *ssh.Server {
 			return true
 		}),
 		RequestHandlers: map[string]ssh.RequestHandler{
-			"tcpip-forward":        forwardHandler.HandleSSHRequest,
-			"cancel-tcpip-forward": forwardHandler.HandleSSHRequest,
+			"tcpip-forward":         forwardHandler.HandleSSHRequest,
+			"cancel-tcpip-forward":  forwardHandler.HandleSSHRequest,
+			udpForwardRequest:       udpForwardHandler.HandleSSHRequest,
+			cancelUDPForwardRequest: udpForwardHandler.HandleSSHRequest,
 		},
 		SubsystemHandlers: map[string]ssh.SubsystemHandler{
 			"sftp": sftpHandler,
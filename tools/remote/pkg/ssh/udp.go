@@ -0,0 +1,305 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	log "github.com/sirupsen/logrus"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// UDP datagrams are tunneled over custom channels, prefixing each datagram by its length.
+// The okteto CLI implements the client side of these channels in pkg/ssh
+const (
+	directUDPChannel        = "direct-udp@okteto.com"
+	forwardedUDPChannel     = "forwarded-udp@okteto.com"
+	udpForwardRequest       = "udp-forward@okteto.com"
+	cancelUDPForwardRequest = "cancel-udp-forward@okteto.com"
+
+	maxDatagramSize   = 65535
+	udpSessionTimeout = 2 * time.Minute
+)
+
+// udpChannelData has the same layout as the direct-tcpip payload of RFC4254, Section 7.2
+type udpChannelData struct {
+	DestAddr   string
+	DestPort   uint32
+	OriginAddr string
+	OriginPort uint32
+}
+
+// udpForwardRequestData has the same layout as the tcpip-forward payload of RFC4254, Section 7.1
+type udpForwardRequestData struct {
+	BindAddr string
+	BindPort uint32
+}
+
+func writeDatagram(w io.Writer, datagram []byte) error {
+	if len(datagram) > maxDatagramSize {
+		return fmt.Errorf("datagram of %d bytes exceeds the maximum size", len(datagram))
+	}
+
+	frame := make([]byte, 2+len(datagram))
+	binary.BigEndian.PutUint16(frame, uint16(len(datagram)))
+	copy(frame[2:], datagram)
+	_, err := w.Write(frame)
+	return err
+}
+
+func readDatagram(r io.Reader, buf []byte) (int, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, err
+	}
+
+	size := int(binary.BigEndian.Uint16(header[:]))
+	if size > len(buf) {
+		return 0, fmt.Errorf("datagram of %d bytes exceeds the buffer size", size)
+	}
+
+	return io.ReadFull(r, buf[:size])
+}
+
+// directUDPHandler sends the datagrams of a direct-udp channel to the requested address and the replies back to the channel
+func directUDPHandler(srv *ssh.Server, _ *gossh.ServerConn, newChan gossh.NewChannel, ctx ssh.Context) {
+	d := udpChannelData{}
+	if err := gossh.Unmarshal(newChan.ExtraData(), &d); err != nil {
+		newChan.Reject(gossh.ConnectionFailed, "error parsing forward data: "+err.Error())
+		return
+	}
+
+	if srv.LocalPortForwardingCallback == nil || !srv.LocalPortForwardingCallback(ctx, d.DestAddr, d.DestPort) {
+		newChan.Reject(gossh.Prohibited, "port forwarding is disabled")
+		return
+	}
+
+	dest := net.JoinHostPort(d.DestAddr, strconv.FormatInt(int64(d.DestPort), 10))
+	var dialer net.Dialer
+	dconn, err := dialer.DialContext(ctx, "udp", dest)
+	if err != nil {
+		newChan.Reject(gossh.ConnectionFailed, err.Error())
+		return
+	}
+
+	ch, reqs, err := newChan.Accept()
+	if err != nil {
+		dconn.Close()
+		return
+	}
+	go gossh.DiscardRequests(reqs)
+
+	go func() {
+		defer ch.Close()
+		defer dconn.Close()
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, err := readDatagram(ch, buf)
+			if err != nil {
+				return
+			}
+			if _, err := dconn.Write(buf[:n]); err != nil {
+				log.Printf("failed to send datagram to %s: %s", dest, err)
+				return
+			}
+		}
+	}()
+	go func() {
+		defer ch.Close()
+		defer dconn.Close()
+		buf := make([]byte, maxDatagramSize)
+		for {
+			if err := dconn.SetReadDeadline(time.Now().Add(udpSessionTimeout)); err != nil {
+				return
+			}
+			n, err := dconn.Read(buf)
+			if err != nil {
+				return
+			}
+			if err := writeDatagram(ch, buf[:n]); err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// forwardedUDPHandler listens for UDP datagrams and sends them to the client over forwarded-udp channels, one per peer.
+// It can be enabled by adding the HandleSSHRequest callback to the server's RequestHandlers under
+// udp-forward@okteto.com and cancel-udp-forward@okteto.com
+type forwardedUDPHandler struct {
+	forwards map[string]net.PacketConn
+	sync.Mutex
+}
+
+// udpPeer is the channel of a peer that sent datagrams to a forwarded UDP port
+type udpPeer struct {
+	ch    gossh.Channel
+	timer *time.Timer
+}
+
+func (h *forwardedUDPHandler) HandleSSHRequest(ctx ssh.Context, srv *ssh.Server, req *gossh.Request) (bool, []byte) {
+	h.Lock()
+	if h.forwards == nil {
+		h.forwards = make(map[string]net.PacketConn)
+	}
+	h.Unlock()
+
+	var reqPayload udpForwardRequestData
+	if err := gossh.Unmarshal(req.Payload, &reqPayload); err != nil {
+		log.Printf("failed to parse UDP forward request: %s", err)
+		return false, []byte{}
+	}
+	addr := net.JoinHostPort(reqPayload.BindAddr, strconv.Itoa(int(reqPayload.BindPort)))
+
+	switch req.Type {
+	case udpForwardRequest:
+		if srv.ReversePortForwardingCallback == nil || !srv.ReversePortForwardingCallback(ctx, reqPayload.BindAddr, reqPayload.BindPort) {
+			return false, []byte("port forwarding is disabled")
+		}
+		pc, err := net.ListenPacket("udp", addr)
+		if err != nil {
+			log.Printf("failed to listen for UDP datagrams on %s: %s", addr, err)
+			return false, []byte{}
+		}
+		h.Lock()
+		h.forwards[addr] = pc
+		h.Unlock()
+
+		go func() {
+			<-ctx.Done()
+			h.close(addr)
+		}()
+
+		conn := ctx.Value(ssh.ContextKeyConn).(*gossh.ServerConn)
+		go h.serve(conn, pc, reqPayload)
+		return true, nil
+
+	case cancelUDPForwardRequest:
+		h.close(addr)
+		return true, nil
+
+	default:
+		return false, nil
+	}
+}
+
+func (h *forwardedUDPHandler) close(addr string) {
+	h.Lock()
+	pc, ok := h.forwards[addr]
+	delete(h.forwards, addr)
+	h.Unlock()
+	if ok {
+		pc.Close()
+	}
+}
+
+func (h *forwardedUDPHandler) serve(conn *gossh.ServerConn, pc net.PacketConn, reqPayload udpForwardRequestData) {
+	var lock sync.Mutex
+	peers := map[string]*udpPeer{}
+	closePeer := func(key string, p *udpPeer) {
+		lock.Lock()
+		if peers[key] == p {
+			delete(peers, key)
+		}
+		lock.Unlock()
+		p.timer.Stop()
+		p.ch.Close()
+	}
+	defer func() {
+		lock.Lock()
+		open := peers
+		peers = map[string]*udpPeer{}
+		lock.Unlock()
+		for key, p := range open {
+			closePeer(key, p)
+		}
+	}()
+
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Printf("failed to read UDP datagram: %s", err)
+			}
+			return
+		}
+
+		key := addr.String()
+		lock.Lock()
+		p, ok := peers[key]
+		lock.Unlock()
+		if !ok {
+			p, err = openUDPPeer(conn, pc, addr, reqPayload)
+			if err != nil {
+				log.Printf("failed to open UDP channel for %s: %s", key, err)
+				continue
+			}
+			p.timer = time.AfterFunc(udpSessionTimeout, func() { closePeer(key, p) })
+			lock.Lock()
+			peers[key] = p
+			lock.Unlock()
+			go func() {
+				defer closePeer(key, p)
+				reply := make([]byte, maxDatagramSize)
+				for {
+					n, err := readDatagram(p.ch, reply)
+					if err != nil {
+						return
+					}
+					p.timer.Reset(udpSessionTimeout)
+					if _, err := pc.WriteTo(reply[:n], addr); err != nil {
+						return
+					}
+				}
+			}()
+		}
+
+		p.timer.Reset(udpSessionTimeout)
+		if err := writeDatagram(p.ch, buf[:n]); err != nil {
+			closePeer(key, p)
+		}
+	}
+}
+
+func openUDPPeer(conn *gossh.ServerConn, pc net.PacketConn, addr net.Addr, reqPayload udpForwardRequestData) (*udpPeer, error) {
+	originAddr, originPortStr, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return nil, err
+	}
+	originPort, _ := strconv.Atoi(originPortStr)
+	_, destPortStr, _ := net.SplitHostPort(pc.LocalAddr().String())
+	destPort, _ := strconv.Atoi(destPortStr)
+
+	payload := gossh.Marshal(&udpChannelData{
+		DestAddr:   reqPayload.BindAddr,
+		DestPort:   uint32(destPort),
+		OriginAddr: originAddr,
+		OriginPort: uint32(originPort),
+	})
+	ch, reqs, err := conn.OpenChannel(forwardedUDPChannel, payload)
+	if err != nil {
+		return nil, err
+	}
+	go gossh.DiscardRequests(reqs)
+	return &udpPeer{ch: ch}, nil
+}
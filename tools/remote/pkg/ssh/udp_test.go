@@ -0,0 +1,238 @@
+// Copyright 2026 The Okteto Authors
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"bytes"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func newUDPTestClient(t *testing.T) *gossh.Client {
+	l := newLocalListener()
+	s := &Server{}
+	srv := s.getServer()
+	go srv.Serve(l)
+	t.Cleanup(func() { srv.Close() })
+
+	client, err := gossh.Dial("tcp", l.Addr().String(), &gossh.ClientConfig{HostKeyCallback: gossh.InsecureIgnoreHostKey()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestDatagramFraming(t *testing.T) {
+	var b bytes.Buffer
+	for _, d := range []string{"first", "", "third"} {
+		if err := writeDatagram(&b, []byte(d)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	buf := make([]byte, maxDatagramSize)
+	for _, expected := range []string{"first", "", "third"} {
+		n, err := readDatagram(&b, buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(buf[:n]) != expected {
+			t.Errorf("got datagram '%s', expected '%s'", buf[:n], expected)
+		}
+	}
+}
+
+// udpWireFormat pins the bytes exchanged with the okteto CLI. pkg/ssh has the same test, so update both when the protocol changes
+var udpWireFormat = []struct {
+	name     string
+	payload  func() []byte
+	expected []byte
+}{
+	{
+		name: "datagram",
+		payload: func() []byte {
+			var b bytes.Buffer
+			if err := writeDatagram(&b, []byte("ping")); err != nil {
+				panic(err)
+			}
+			return b.Bytes()
+		},
+		expected: []byte{0x00, 0x04, 'p', 'i', 'n', 'g'},
+	},
+	{
+		name: "empty datagram",
+		payload: func() []byte {
+			var b bytes.Buffer
+			if err := writeDatagram(&b, nil); err != nil {
+				panic(err)
+			}
+			return b.Bytes()
+		},
+		expected: []byte{0x00, 0x00},
+	},
+	{
+		name: "channel payload",
+		payload: func() []byte {
+			return gossh.Marshal(&udpChannelData{DestAddr: "localhost", DestPort: 53, OriginAddr: "127.0.0.1", OriginPort: 1234})
+		},
+		expected: []byte{
+			0x00, 0x00, 0x00, 0x09, 'l', 'o', 'c', 'a', 'l', 'h', 'o', 's', 't',
+			0x00, 0x00, 0x00, 0x35,
+			0x00, 0x00, 0x00, 0x09, '1', '2', '7', '.', '0', '.', '0', '.', '1',
+			0x00, 0x00, 0x04, 0xd2,
+		},
+	},
+	{
+		name: "forward request payload",
+		payload: func() []byte {
+			return gossh.Marshal(&udpForwardRequestData{BindAddr: "0.0.0.0", BindPort: 5353})
+		},
+		expected: []byte{
+			0x00, 0x00, 0x00, 0x07, '0', '.', '0', '.', '0', '.', '0',
+			0x00, 0x00, 0x14, 0xe9,
+		},
+	},
+}
+
+func TestUDPWireFormat(t *testing.T) {
+	for _, tt := range udpWireFormat {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.payload(); !bytes.Equal(got, tt.expected) {
+				t.Errorf("got % x, expected % x", got, tt.expected)
+			}
+		})
+	}
+	if directUDPChannel != "direct-udp@okteto.com" || forwardedUDPChannel != "forwarded-udp@okteto.com" ||
+		udpForwardRequest != "udp-forward@okteto.com" || cancelUDPForwardRequest != "cancel-udp-forward@okteto.com" {
+		t.Error("the UDP channel and request names changed")
+	}
+}
+
+func TestDirectUDP(t *testing.T) {
+	echo, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer echo.Close()
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, addr, err := echo.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			echo.WriteTo(append([]byte("echo "), buf[:n]...), addr)
+		}
+	}()
+
+	client := newUDPTestClient(t)
+	port := echo.LocalAddr().(*net.UDPAddr).Port
+	ch, reqs, err := client.OpenChannel(directUDPChannel, gossh.Marshal(&udpChannelData{DestAddr: "127.0.0.1", DestPort: uint32(port), OriginAddr: "127.0.0.1", OriginPort: 1234}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	go gossh.DiscardRequests(reqs)
+	defer ch.Close()
+
+	if err := writeDatagram(ch, []byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, maxDatagramSize)
+	n, err := readDatagram(ch, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != "echo hello" {
+		t.Errorf("got '%s', expected 'echo hello'", buf[:n])
+	}
+}
+
+func TestForwardedUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := pc.LocalAddr().(*net.UDPAddr).Port
+	pc.Close()
+
+	client := newUDPTestClient(t)
+	chans := client.HandleChannelOpen(forwardedUDPChannel)
+	ok, _, err := client.SendRequest(udpForwardRequest, true, gossh.Marshal(&udpForwardRequestData{BindAddr: "127.0.0.1", BindPort: uint32(port)}))
+	if err != nil || !ok {
+		t.Fatalf("udp forward request failed: %v", err)
+	}
+
+	peer, err := net.Dial("udp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer peer.Close()
+	if _, err := peer.Write([]byte("ping")); err != nil {
+		t.Fatal(err)
+	}
+
+	var newChannel gossh.NewChannel
+	select {
+	case newChannel = <-chans:
+	case <-time.After(5 * time.Second):
+		t.Fatal("forwarded UDP channel wasn't opened")
+	}
+	var d udpChannelData
+	if err := gossh.Unmarshal(newChannel.ExtraData(), &d); err != nil {
+		t.Fatal(err)
+	}
+	if d.DestPort != uint32(port) {
+		t.Errorf("got dest port %d, expected %d", d.DestPort, port)
+	}
+
+	ch, reqs, err := newChannel.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	go gossh.DiscardRequests(reqs)
+	defer ch.Close()
+
+	buf := make([]byte, maxDatagramSize)
+	n, err := readDatagram(ch, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != "ping" {
+		t.Errorf("got '%s', expected 'ping'", buf[:n])
+	}
+
+	if err := writeDatagram(ch, []byte("pong")); err != nil {
+		t.Fatal(err)
+	}
+	if err := peer.SetReadDeadline(time.Now().Add(5 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	n, err = peer.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf[:n]) != "pong" {
+		t.Errorf("got '%s', expected 'pong'", buf[:n])
+	}
+
+	ok, _, err = client.SendRequest(cancelUDPForwardRequest, true, gossh.Marshal(&udpForwardRequestData{BindAddr: "127.0.0.1", BindPort: uint32(port)}))
+	if err != nil || !ok {
+		t.Fatalf("cancel udp forward request failed: %v", err)
+	}
+}